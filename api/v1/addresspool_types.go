@@ -7,6 +7,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// AddressAllocation defines an address drawn from the pool for a host
+// interface.
+type AddressAllocation struct {
+	// Host defines the name of the host resource to which the address is
+	// allocated.
+	Host string `json:"host"`
+
+	// Interface defines the name of the host interface to which the address
+	// is allocated.
+	Interface string `json:"interface"`
+
+	// Address defines the allocated IPv4 or IPv6 address.
+	Address string `json:"address"`
+}
+
 // AddressPoolStatus defines the observed state of AddressPool
 type AddressPoolStatus struct {
 	// ID defines the system assigned unique identifier.  This will only exist
@@ -36,6 +51,53 @@ type AddressPoolStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// Allocations defines the addresses drawn from the pool for host
+	// interfaces that reference it.  They are released when the host is
+	// deleted or no longer references the pool.
+	// +optional
+	Allocations []AddressAllocation `json:"allocations,omitempty"`
+
+	// Consumers defines the host interfaces which use the address pool,
+	// either because their configuration references it or because the
+	// system reports an address from the pool configured on them.
+	// +optional
+	Consumers ResourceConsumerList `json:"consumers,omitempty"`
+
+	// AllocatedAddresses defines the number of addresses of the allocation
+	// ranges which are in use, including the gateway, floating and
+	// controller addresses.
+	// +optional
+	AllocatedAddresses *int64 `json:"allocatedAddresses,omitempty"`
+
+	// FreeAddresses defines the number of addresses of the allocation ranges
+	// which are still available.  It saturates at the largest int64 value
+	// for large IPv6 pools.
+	// +optional
+	FreeAddresses *int64 `json:"freeAddresses,omitempty"`
+}
+
+func (a *AddressPool) GetPlan() *PlanStatus {
+	return a.Status.Plan
+}
+
+func (a *AddressPool) SetPlan(plan *PlanStatus) {
+	a.Status.Plan = plan
+}
+
+// FindAllocation returns the address allocated to a host interface, if any.
+func (a *AddressPool) FindAllocation(host, iface string) (string, bool) {
+	for _, alloc := range a.Status.Allocations {
+		if alloc.Host == host && alloc.Interface == iface {
+			return alloc.Address, true
+		}
+	}
+	return "", false
 }
 
 // AllocationRange defines the start and end address for an allocation range
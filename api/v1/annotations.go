@@ -0,0 +1,82 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Defines annotation keys that control how resources are reconciled.
+	PlanModeAnnotation        = "deployment-manager/plan-mode"
+	AllowDeleteAnnotation     = "deployment-manager/allow-delete"
+	DeletionPolicyAnnotation  = "deployment-manager/deletion-policy"
+	AdoptModeAnnotation       = "deployment-manager/adopt"
+	DeploymentScopeAnnotation = "deployment-manager/deployment-scope"
+)
+
+const (
+	// Defines the supported values of the deletion policy annotation.
+	DeletionPolicyDelete = "delete"
+	DeletionPolicyOrphan = "orphan"
+)
+
+const (
+	// Defines the supported values of the deployment scope.
+	ScopeBootstrap = "bootstrap"
+	ScopePrincipal = "principal"
+)
+
+// IsOrphanDeletion determines whether a resource must be released without
+// removing its counterpart from the system when it is deleted.  A resource
+// with an unconfirmed adoption never owned its system counterpart so it is
+// always orphaned.
+func IsOrphanDeletion(instance metav1.Object) bool {
+	policy := instance.GetAnnotations()[DeletionPolicyAnnotation]
+	return strings.EqualFold(policy, DeletionPolicyOrphan) || IsAdoptionPending(instance)
+}
+
+// IsDeletionAllowed determines whether the operator has explicitly accepted
+// the deletion of a resource regardless of whether it is still in use.
+func IsDeletionAllowed(instance metav1.Object) bool {
+	value := instance.GetAnnotations()[AllowDeleteAnnotation]
+	return strings.EqualFold(value, "true")
+}
+
+// IsAdoptionPending determines whether a resource is in adopt mode and is
+// therefore only allowed to bind to an existing system resource without
+// modifying it.  The adoption is confirmed by removing the annotation.
+func IsAdoptionPending(instance metav1.Object) bool {
+	value := instance.GetAnnotations()[AdoptModeAnnotation]
+	return strings.EqualFold(value, "true")
+}
+
+// ParseDeploymentScope converts a deployment scope to its canonical form.  The
+// bootstrap scope is returned along with an error if the scope is not
+// supported.
+func ParseDeploymentScope(scope string) (string, error) {
+	switch strings.ToLower(scope) {
+	case ScopeBootstrap:
+		return ScopeBootstrap, nil
+	case ScopePrincipal:
+		return ScopePrincipal, nil
+	default:
+		return ScopeBootstrap, fmt.Errorf("unsupported DeploymentScope: %s", scope)
+	}
+}
+
+// ValidateDeploymentScopeAnnotation verifies that the deployment scope
+// annotation of a resource, if present, holds a supported value.
+func ValidateDeploymentScopeAnnotation(instance metav1.Object) error {
+	scope, ok := instance.GetAnnotations()[DeploymentScopeAnnotation]
+	if !ok {
+		return nil
+	}
+
+	_, err := ParseDeploymentScope(scope)
+	return err
+}
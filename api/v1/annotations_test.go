@@ -0,0 +1,67 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Annotations", func() {
+	newDataNetwork := func(annotations map[string]string) *DataNetwork {
+		return &DataNetwork{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "group0-data0",
+				Namespace:   "deployment",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	Describe("Deletion and adoption", func() {
+		It("should only orphan resources when requested", func() {
+			Expect(IsOrphanDeletion(newDataNetwork(nil))).To(BeFalse())
+			Expect(IsOrphanDeletion(newDataNetwork(map[string]string{
+				DeletionPolicyAnnotation: DeletionPolicyDelete}))).To(BeFalse())
+			Expect(IsOrphanDeletion(newDataNetwork(map[string]string{
+				DeletionPolicyAnnotation: "Orphan"}))).To(BeTrue())
+		})
+
+		It("should always orphan resources with a pending adoption", func() {
+			instance := newDataNetwork(map[string]string{AdoptModeAnnotation: "true"})
+			Expect(IsAdoptionPending(instance)).To(BeTrue())
+			Expect(IsOrphanDeletion(instance)).To(BeTrue())
+
+			instance.Annotations[AdoptModeAnnotation] = "false"
+			Expect(IsAdoptionPending(instance)).To(BeFalse())
+			Expect(IsOrphanDeletion(instance)).To(BeFalse())
+		})
+
+		It("should only allow deletion when requested", func() {
+			Expect(IsDeletionAllowed(newDataNetwork(nil))).To(BeFalse())
+			Expect(IsDeletionAllowed(newDataNetwork(map[string]string{
+				AllowDeleteAnnotation: "true"}))).To(BeTrue())
+		})
+	})
+
+	Describe("Deployment scope", func() {
+		It("should parse the supported scopes regardless of case", func() {
+			Expect(ParseDeploymentScope("Principal")).To(Equal(ScopePrincipal))
+			Expect(ParseDeploymentScope("bootstrap")).To(Equal(ScopeBootstrap))
+
+			scope, err := ParseDeploymentScope("day2")
+			Expect(err).To(HaveOccurred())
+			Expect(scope).To(Equal(ScopeBootstrap))
+		})
+
+		It("should only reject an annotation with an unsupported value", func() {
+			Expect(ValidateDeploymentScopeAnnotation(newDataNetwork(nil))).To(Succeed())
+			Expect(ValidateDeploymentScopeAnnotation(newDataNetwork(map[string]string{
+				DeploymentScopeAnnotation: "principal"}))).To(Succeed())
+			Expect(ValidateDeploymentScopeAnnotation(newDataNetwork(map[string]string{
+				DeploymentScopeAnnotation: ""}))).ToNot(Succeed())
+		})
+	})
+})
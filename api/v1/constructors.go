@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -13,6 +13,7 @@ import (
 	"github.com/alecthomas/units"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/certificates"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/clusters"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/controllerFilesystems"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/cpus"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/datanetworks"
@@ -27,6 +28,7 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ptpinterfaces"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/serviceparameters"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagebackends"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagetiers"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/volumegroups"
 	common "github.com/wind-river/cloud-platform-deployment-manager/common"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
@@ -498,6 +500,59 @@ func parseRouteInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
 	return nil
 }
 
+// parseDeviceInfo is a utility which parses the PCI device data as it is
+// presented by the system API and stores the data in the form required by a
+// profile spec.  Only devices which have been configured are included.
+func parseDeviceInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
+	result := make([]PCIDeviceInfo, 0)
+
+	for _, d := range host.PCIDevices {
+		configured := !d.Enabled
+		if d.Driver != nil && *d.Driver != "" {
+			configured = true
+		}
+		if d.VFCount != nil && *d.VFCount > 0 {
+			configured = true
+		}
+
+		if !configured {
+			continue
+		}
+
+		address := d.Address
+		deviceID := d.DeviceID
+		enabled := d.Enabled
+		device := PCIDeviceInfo{
+			Address:  &address,
+			DeviceID: &deviceID,
+			Enabled:  &enabled,
+		}
+
+		if d.Driver != nil && *d.Driver != "" {
+			driver := *d.Driver
+			device.Driver = &driver
+		}
+
+		if d.VFCount != nil && *d.VFCount > 0 {
+			count := *d.VFCount
+			device.VFCount = &count
+		}
+
+		if d.VFDriver != nil && *d.VFDriver != "" {
+			driver := *d.VFDriver
+			device.VFDriver = &driver
+		}
+
+		result = append(result, device)
+	}
+
+	if len(result) > 0 {
+		profile.Devices = result
+	}
+
+	return nil
+}
+
 // parsePhysicalVolumeInfo is a utility which parses the physical volume data as
 // it is presented by the system API and stores the data in the form required by
 // a profile spec.
@@ -587,6 +642,11 @@ func parseOSDInfo(profile *HostProfileSpec, host v1info.HostInfo) error {
 		clusterName, found := host.FindClusterNameByTier(o.TierUUID)
 		if found {
 			osd.ClusterName = &clusterName
+		} else if clusterName, tierName, found := host.FindStorageTierByID(o.TierUUID); found {
+			osd.ClusterName = &clusterName
+			if tierName != storagetiers.StorageTierName {
+				osd.Tier = &tierName
+			}
 		}
 
 		disk, _ := host.FindDisk(o.DiskID)
@@ -911,6 +971,12 @@ func NewHostProfileSpec(host v1info.HostInfo) (*HostProfileSpec, error) {
 		return nil, err
 	}
 
+	// Fill-in PCI device attributes
+	err = parseDeviceInfo(&spec, host)
+	if err != nil {
+		return nil, err
+	}
+
 	return &spec, nil
 }
 
@@ -1038,6 +1104,46 @@ func parseStorageBackendInfo(spec *SystemSpec, storageBackends []storagebackends
 	return nil
 }
 
+func parseStorageTierInfo(spec *SystemSpec, systemInfo v1info.SystemInfo) error {
+	result := make([]StorageTierInfo, 0)
+
+	for _, t := range systemInfo.StorageTiers {
+		if t.Name == storagetiers.StorageTierName {
+			// The default tier is created by the system along with the
+			// cluster so there is no need to declare it.
+			continue
+		}
+
+		info := StorageTierInfo{
+			Name: t.Name,
+		}
+
+		for _, c := range systemInfo.Clusters {
+			if c.ID == t.ClusterID {
+				if c.Name != clusters.CephClusterName {
+					clusterName := c.Name
+					info.ClusterName = &clusterName
+				}
+				break
+			}
+		}
+
+		result = append(result, info)
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	if spec.Storage == nil {
+		spec.Storage = &SystemStorageInfo{}
+	}
+
+	spec.Storage.Tiers = StorageTierList(result)
+
+	return nil
+}
+
 func parseLicenseInfo(spec *SystemSpec, license *licenses.License) error {
 	if license != nil {
 		// Populate a Secret name reference but for now don't bother trying
@@ -1122,6 +1228,17 @@ func NewSystemSpec(systemInfo v1info.SystemInfo) (*SystemSpec, error) {
 		}
 	}
 
+	if systemInfo.RemoteLogging != nil && systemInfo.RemoteLogging.IPAddress != "" {
+		// An unconfigured system reports an empty server address therefore
+		// there is nothing meaningful to export.
+		spec.RemoteLogging = &RemoteLoggingInfo{
+			Enabled:   &systemInfo.RemoteLogging.Enabled,
+			Server:    &systemInfo.RemoteLogging.IPAddress,
+			Transport: &systemInfo.RemoteLogging.Transport,
+			Port:      &systemInfo.RemoteLogging.Port,
+		}
+	}
+
 	if len(systemInfo.Certificates) > 0 {
 		err := parseCertificateInfo(&spec, systemInfo.Certificates)
 		if err != nil {
@@ -1150,6 +1267,13 @@ func NewSystemSpec(systemInfo v1info.SystemInfo) (*SystemSpec, error) {
 		}
 	}
 
+	if len(systemInfo.StorageTiers) > 0 {
+		err := parseStorageTierInfo(&spec, systemInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if systemInfo.License != nil {
 		err := parseLicenseInfo(&spec, systemInfo.License)
 		if err != nil {
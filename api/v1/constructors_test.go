@@ -12,6 +12,7 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/cephmonitors"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/certificates"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/clusters"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/controllerFilesystems"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/cpus"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/datanetworks"
@@ -43,6 +44,8 @@ import (
 	. "github.com/onsi/gomega"
 	common "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/remotelogging"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -361,6 +364,64 @@ var _ = Describe("Constructor utils for kind", func() {
 		})
 	})
 
+	Describe("Test parseDeviceInfo", func() {
+		Context("when host PCI device data is present", func() {
+			It("should only include the configured devices", func() {
+				profile := &HostProfileSpec{}
+				driver := "vfio-pci"
+				vfCount := 8
+				vfDriver := "vfio"
+				none := ""
+				zero := 0
+				host := platform.HostInfo{
+					PCIDevices: []pcidevices.PCIDevice{
+						{
+							Address:  "0000:b3:00.0",
+							DeviceID: "0d5c",
+							Enabled:  true,
+							Driver:   &driver,
+							VFCount:  &vfCount,
+							VFDriver: &vfDriver,
+						},
+						{
+							Address:  "0000:00:1f.3",
+							DeviceID: "a171",
+							Enabled:  true,
+							Driver:   &none,
+							VFCount:  &zero,
+						},
+						{
+							Address:  "0000:3b:00.0",
+							DeviceID: "1d93",
+							Enabled:  false,
+						},
+					},
+				}
+				err := parseDeviceInfo(profile, host)
+				Expect(err).ToNot(HaveOccurred())
+
+				enabled := true
+				disabled := false
+				expected := PCIDeviceList{
+					{
+						Address:  &host.PCIDevices[0].Address,
+						DeviceID: &host.PCIDevices[0].DeviceID,
+						Enabled:  &enabled,
+						Driver:   &driver,
+						VFCount:  &vfCount,
+						VFDriver: &vfDriver,
+					},
+					{
+						Address:  &host.PCIDevices[2].Address,
+						DeviceID: &host.PCIDevices[2].DeviceID,
+						Enabled:  &disabled,
+					},
+				}
+				Expect(profile.Devices).To(Equal(expected))
+			})
+		})
+	})
+
 	Describe("Test parseProcessorInfo", func() {
 		//TBD: When cpu function is application
 		Context("when the cpu function is not application", func() {
@@ -1065,6 +1126,51 @@ var _ = Describe("Constructor utils for kind", func() {
 		})
 	})
 
+	Describe("Test parseStorageTierInfo", func() {
+		Context("when additional storage tiers are present", func() {
+			It("should add only the additional tiers to the spec", func() {
+				spec := &SystemSpec{}
+				clusterName := "other_cluster"
+				systemInfo := platform.SystemInfo{
+					Clusters: []clusters.Cluster{
+						{ID: "c1", Name: clusters.CephClusterName},
+						{ID: "c2", Name: clusterName},
+					},
+					StorageTiers: []storagetiers.StorageTier{
+						{ID: "t1", Name: storagetiers.StorageTierName, ClusterID: "c1"},
+						{ID: "t2", Name: "gold", ClusterID: "c1"},
+						{ID: "t3", Name: "silver", ClusterID: "c2"},
+					},
+				}
+
+				want := StorageTierList{
+					{Name: "gold"},
+					{Name: "silver", ClusterName: &clusterName},
+				}
+				err := parseStorageTierInfo(spec, systemInfo)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spec.Storage.Tiers).To(Equal(want))
+			})
+		})
+		Context("when only default storage tiers are present", func() {
+			It("should leave the spec untouched", func() {
+				spec := &SystemSpec{}
+				systemInfo := platform.SystemInfo{
+					Clusters: []clusters.Cluster{
+						{ID: "c1", Name: clusters.CephClusterName},
+					},
+					StorageTiers: []storagetiers.StorageTier{
+						{ID: "t1", Name: storagetiers.StorageTierName, ClusterID: "c1"},
+					},
+				}
+
+				err := parseStorageTierInfo(spec, systemInfo)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spec.Storage).To(BeNil())
+			})
+		})
+	})
+
 	Describe("Test parseServiceParameterInfo", func() {
 		Context("when non-empty ServiceParameterInfo is given", func() {
 			It("should populate the spec with ServiceParameters", func() {
@@ -1789,6 +1895,10 @@ var _ = Describe("Constructor utils for kind", func() {
 				transport := "duplex"
 				mechanism := "PTPMechanism"
 				repFactor := 1
+				rlEnabled := true
+				rlServer := "10.10.10.1"
+				rlTransport := "tcp"
+				rlPort := 514
 				sysInfo := platform.SystemInfo{
 					DRBD: &drbd.DRBD{
 						LinkUtilization: 27,
@@ -1804,6 +1914,12 @@ var _ = Describe("Constructor utils for kind", func() {
 						Transport: transport,
 						Mechanism: mechanism,
 					},
+					RemoteLogging: &remotelogging.RemoteLogging{
+						IPAddress: rlServer,
+						Enabled:   rlEnabled,
+						Transport: rlTransport,
+						Port:      rlPort,
+					},
 					Certificates: []certificates.Certificate{
 						{
 							Type:      "T1",
@@ -1856,6 +1972,12 @@ var _ = Describe("Constructor utils for kind", func() {
 						Transport: &transport,
 						Mechanism: &mechanism,
 					},
+					RemoteLogging: &RemoteLoggingInfo{
+						Enabled:   &rlEnabled,
+						Server:    &rlServer,
+						Transport: &rlTransport,
+						Port:      &rlPort,
+					},
 					Certificates: []CertificateInfo{
 						{
 							Type:      "T1",
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022, 2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -102,6 +102,17 @@ type DataNetworkStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// Consumers defines the host interfaces which use the data network,
+	// either because their configuration references it or because the
+	// system reports it as assigned to them.
+	// +optional
+	Consumers ResourceConsumerList `json:"consumers,omitempty"`
 }
 
 func (d *DataNetwork) GetStrategyRequired() string {
@@ -120,6 +131,14 @@ func (d *DataNetwork) SetDeploymentScope(scope string) {
 	d.Status.DeploymentScope = scope
 }
 
+func (d *DataNetwork) GetPlan() *PlanStatus {
+	return d.Status.Plan
+}
+
+func (d *DataNetwork) SetPlan(plan *PlanStatus) {
+	d.Status.Plan = plan
+}
+
 func (d *DataNetwork) GetAnnotations() map[string]string {
 	return d.Annotations
 }
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022, 2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -59,6 +59,110 @@ type MatchInfo struct {
 	DMI *MatchDMIInfo `json:"dmi,omitempty"`
 }
 
+// Defines the one-shot actions that can be requested on a host.
+const (
+	HostActionReinstall     = "reinstall"
+	HostActionWipeReinstall = "wipe-reinstall"
+	HostActionPowerCycle    = "power-cycle"
+)
+
+// Defines the phases of a host action.
+const (
+	HostActionPhaseLocking    = "locking"
+	HostActionPhaseExecuting  = "executing"
+	HostActionPhaseRecovering = "recovering"
+	HostActionPhaseCompleted  = "completed"
+	HostActionPhaseFailed     = "failed"
+)
+
+// HostActionInfo defines a one-shot action to be run on a host.  The action
+// is run once for each distinct request value.
+type HostActionInfo struct {
+	// Type defines the action to be run.  The "wipe-reinstall" action wipes
+	// every disk other than the root disk before reinstalling the host, and
+	// the "power-cycle" action resets the host through its board management
+	// controller.
+	// +kubebuilder:validation:Enum=reinstall;wipe-reinstall;power-cycle
+	Type string `json:"type"`
+
+	// Request defines an arbitrary value (e.g., a timestamp or a counter)
+	// which requests the action to be run whenever it is changed.
+	// +kubebuilder:validation:MinLength=1
+	Request string `json:"request"`
+}
+
+// HostActionStatus defines the progress and outcome of the last action
+// requested on a host.
+type HostActionStatus struct {
+	// Type defines the action that was run.
+	Type string `json:"type"`
+
+	// Request defines the request value of the action.
+	Request string `json:"request"`
+
+	// Phase defines the current phase of the action.
+	// +kubebuilder:validation:Enum=locking;executing;recovering;completed;failed
+	Phase string `json:"phase"`
+
+	// Reason defines why the action failed.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
+
+	// StartTime defines when the action was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// SentTime defines when the action was sent to the host.  The action
+	// fails if the host shows no sign of running it in time.
+	// +optional
+	SentTime *metav1.Time `json:"sentTime,omitempty"`
+
+	// CompletionTime defines when the action completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// Finished determines whether the action has either completed or failed.
+func (in *HostActionStatus) Finished() bool {
+	return in.Phase == HostActionPhaseCompleted || in.Phase == HostActionPhaseFailed
+}
+
+// HostMaintenanceInfo defines the attributes of a host under hardware
+// maintenance.
+type HostMaintenanceInfo struct {
+	// Lock determines whether the host is locked when it is put under
+	// maintenance.
+	// +optional
+	Lock bool `json:"lock,omitempty"`
+
+	// Reason defines a free-form description of the maintenance (e.g., a
+	// work order number) which is recorded in the events of the host.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
+}
+
+// BMStatus defines the observed state of the board management controller of
+// a host.
+type BMStatus struct {
+	// Provisioned defines whether board management is configured on the host.
+	Provisioned bool `json:"provisioned"`
+
+	// Secret defines the name of the credentials secret last applied to the
+	// host.
+	// +optional
+	Secret string `json:"secret,omitempty"`
+
+	// SecretVersion defines the resource version of the credentials secret
+	// last applied to the host.  The credentials are applied again whenever
+	// the secret is changed.
+	// +optional
+	SecretVersion string `json:"secretVersion,omitempty"`
+
+	// Error defines why the system rejected the last credentials applied.
+	// +optional
+	Error *string `json:"error,omitempty"`
+}
+
 // HostSpec defines the desired state of Host
 type HostSpec struct {
 	// Profile defines the name of the HostProfile to use as a configuration
@@ -88,6 +192,39 @@ type HostSpec struct {
 	// "profile" attribute.
 	// +optional
 	Overrides *HostProfileSpec `json:"overrides,omitempty"`
+
+	// Action defines a one-shot action to be run on the host.  The host is
+	// locked before the action is run and the configuration is only
+	// reconciled again once the action has completed.
+	// +optional
+	Action *HostActionInfo `json:"action,omitempty"`
+
+	// Maintenance puts the host under hardware maintenance.  The host is not
+	// reconciled, nor included in strategies, until this attribute is
+	// removed, at which point its full configuration is checked again.
+	// +optional
+	Maintenance *HostMaintenanceInfo `json:"maintenance,omitempty"`
+}
+
+// HostPtpStatus defines the operational state of a ptp instance on the host,
+// as reported by the PTP notification service.
+type HostPtpStatus struct {
+	// Instance defines the name of the ptp instance.
+	Instance string `json:"instance"`
+
+	// Locked defines whether the clock or GNSS receiver driven by the
+	// instance is locked to its time source.
+	Locked bool `json:"locked"`
+
+	// LockState defines the synchronization state of the clock driven by the
+	// instance (e.g., LOCKED, HOLDOVER, FREERUN).
+	// +optional
+	LockState string `json:"lockState,omitempty"`
+
+	// GNSSStatus defines the synchronization state of the GNSS receiver
+	// driven by the instance (e.g., SYNCHRONIZED, ANTENNA-DISCONNECTED).
+	// +optional
+	GNSSStatus string `json:"gnssStatus,omitempty"`
 }
 
 // HostStatus defines the observed state of Host
@@ -153,6 +290,41 @@ type HostStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// Processors defines the core allocations resolved from the processor
+	// allocation policies of the profile.
+	// +optional
+	Processors ProcessorNodeList `json:"processors,omitempty"`
+
+	// Memory defines the page allocations resolved from the memory allocation
+	// policies of the profile.
+	// +optional
+	Memory MemoryNodeList `json:"memory,omitempty"`
+
+	// Action defines the progress and outcome of the last action requested
+	// on the host.
+	// +optional
+	Action *HostActionStatus `json:"action,omitempty"`
+
+	// Maintenance defines whether the host is currently under maintenance.
+	// +optional
+	Maintenance bool `json:"maintenance,omitempty"`
+
+	// BoardManagement defines the observed state of the board management
+	// controller of the host.
+	// +optional
+	BoardManagement *BMStatus `json:"boardManagement,omitempty"`
+
+	// Ptp defines the operational state of the ptp instances assigned to the
+	// host.  It is only populated when the PTP notification service of the
+	// system is configured.
+	// +optional
+	Ptp []HostPtpStatus `json:"ptp,omitempty"`
 }
 
 func (h *Host) SetStatusDelta(delta string) {
@@ -183,6 +355,14 @@ func (h *Host) SetDeploymentScope(scope string) {
 	h.Status.DeploymentScope = scope
 }
 
+func (h *Host) GetPlan() *PlanStatus {
+	return h.Status.Plan
+}
+
+func (h *Host) SetPlan(plan *PlanStatus) {
+	h.Status.Plan = plan
+}
+
 func (h *Host) GetAnnotations() map[string]string {
 	return h.Annotations
 }
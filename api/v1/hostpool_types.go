@@ -0,0 +1,181 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HostPoolLabel is the label applied to the host resources created by a host
+// pool.  Its value is the name of the pool.
+const HostPoolLabel = "starlingx.windriver.com/hostpool"
+
+// HostPoolBootMACLabel is the label applied to the host resources created by a
+// host pool to claim the boot MAC address of the enrolled host.  Its value is
+// the boot MAC address with dashes in place of colons since colons are not
+// allowed in label values.
+const HostPoolBootMACLabel = "starlingx.windriver.com/hostpool-boot-mac"
+
+// HostPoolSelector defines the inventory attributes that an unprovisioned
+// host must have to be enrolled in a pool.  All of the attributes specified
+// must match.  Only the attributes reported by the system before a host is
+// installed can be used; processor, memory, and product attributes are only
+// inventoried once a host has been installed with a personality.
+// +kubebuilder:validation:MinProperties=1
+type HostPoolSelector struct {
+	// BootMACPrefixes defines the list of MAC address prefixes (e.g., the
+	// vendor OUI of the boot NIC) of which one must match the boot MAC
+	// address of the host.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	BootMACPrefixes []string `json:"bootMACPrefixes,omitempty"`
+
+	// SerialNumber defines a regular expression which must match the board
+	// serial number stored in the DMI block.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	SerialNumber *string `json:"serialNumber,omitempty"`
+
+	// AssetTag defines a regular expression which must match the board asset
+	// tag stored in the DMI block.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	AssetTag *string `json:"assetTag,omitempty"`
+
+	// BoardManagementType defines the board management type which must be
+	// reported for the host.
+	// +kubebuilder:validation:Enum=none;bmc;dynamic;ipmi;redfish
+	// +optional
+	BoardManagementType *string `json:"boardManagementType,omitempty"`
+}
+
+// HostnameAllocationInfo defines how hostnames are allocated to the hosts
+// enrolled in a pool.  Hostnames are the prefix followed by the lowest
+// unused index (e.g., worker-0, worker-1).
+type HostnameAllocationInfo struct {
+	// Prefix defines the leading portion of the allocated hostnames.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*)?$`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=245
+	Prefix string `json:"prefix"`
+
+	// Start defines the first index to be allocated.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Start *int `json:"start,omitempty"`
+}
+
+// Hostname returns the hostname which corresponds to an allocation index.
+func (in *HostnameAllocationInfo) Hostname(index int) string {
+	return fmt.Sprintf("%s%d", in.Prefix, index)
+}
+
+// FirstIndex returns the first allocation index taking into account the
+// default value.
+func (in *HostnameAllocationInfo) FirstIndex() int {
+	if in.Start != nil {
+		return *in.Start
+	}
+	return 0
+}
+
+// HostPoolSpec defines the desired state of HostPool
+type HostPoolSpec struct {
+	// Profile defines the name of the host profile bound to the hosts
+	// enrolled in the pool.  The profile must specify the personality of the
+	// hosts.
+	// +kubebuilder:validation:MinLength=1
+	Profile string `json:"profile"`
+
+	// Hostnames defines how hostnames are allocated to enrolled hosts.
+	Hostnames HostnameAllocationInfo `json:"hostnames"`
+
+	// Selector defines the attributes an unprovisioned host must have to be
+	// enrolled in the pool.
+	Selector HostPoolSelector `json:"selector"`
+
+	// MaxHosts defines the maximum number of hosts enrolled in the pool.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxHosts *int `json:"maxHosts,omitempty"`
+}
+
+// HostPoolMember defines a host enrolled in a pool.
+type HostPoolMember struct {
+	// Name defines the name of the host resource created for the host.
+	Name string `json:"name"`
+
+	// BootMAC defines the boot MAC address used to match the host.
+	BootMAC string `json:"bootMAC"`
+}
+
+// HostPoolStatus defines the observed state of HostPool
+type HostPoolStatus struct {
+	// Members defines the hosts enrolled in the pool.
+	// +optional
+	Members []HostPoolMember `json:"members,omitempty"`
+
+	// Reconciled defines whether the pool has been successfully reconciled
+	// at least once.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether every matching host has been enrolled.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// HostPool defines the attributes used to automatically enroll unprovisioned
+// hosts.  A host resource bound to the pool profile is created, with an
+// allocated hostname, for each unprovisioned host which matches the pool
+// selector.  The host resources are not deleted with the pool.
+//
+//	https://docs.starlingx.io/api-ref/config/api-ref-sysinv-v1-config.html
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="profile",type="string",JSONPath=".spec.profile",description="The configuration profile of the enrolled hosts."
+// +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type HostPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HostPoolSpec   `json:"spec,omitempty"`
+	Status HostPoolStatus `json:"status,omitempty"`
+}
+
+func (in *HostPool) GetPlan() *PlanStatus {
+	return in.Status.Plan
+}
+
+func (in *HostPool) SetPlan(plan *PlanStatus) {
+	in.Status.Plan = plan
+}
+
+// +kubebuilder:object:root=true
+// HostPoolList contains a list of HostPool
+// +deepequal-gen=false
+type HostPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HostPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HostPool{}, &HostPoolList{})
+}
@@ -4,8 +4,12 @@
 package v1
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/clusters"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagetiers"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -67,6 +71,50 @@ type BMInfo struct {
 	Credentials *BMCredentials `json:"credentials,omitempty"`
 }
 
+// ProcessorAllocationPolicy defines the number of cores to assign to a
+// function relative to the physical cores of a NUMA node/socket so that a
+// single profile can be used on servers with different core counts.  Exactly
+// one attribute must be specified.  Policies are resolved at reconcile time.
+// +deepequal-gen:ignore-nil-fields=true
+type ProcessorAllocationPolicy struct {
+	// Percent defines the number of cores as a percentage of the physical
+	// cores of the node.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *int `json:"percent,omitempty"`
+
+	// Remaining defines that all cores of the node that are not allocated to
+	// any other function must be allocated to this function.
+	// +optional
+	Remaining *bool `json:"remaining,omitempty"`
+
+	// PerSocketLessPlatform defines a number of cores from which the cores
+	// allocated to the platform function on the same node are subtracted.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1024
+	// +optional
+	PerSocketLessPlatform *int `json:"perSocketLessPlatform,omitempty"`
+}
+
+// Resolve determines the number of cores to allocate based on the number of
+// physical cores of the node, the number of cores allocated to the other
+// functions of the node, and the number of cores allocated to the platform
+// function of the node.  The result may be negative if the node does not have
+// enough cores to satisfy the policy.
+func (in *ProcessorAllocationPolicy) Resolve(total int, allocated int, platform int) int {
+	switch {
+	case in.Percent != nil:
+		return total * *in.Percent / 100
+	case in.Remaining != nil && *in.Remaining:
+		return total - allocated
+	case in.PerSocketLessPlatform != nil:
+		return *in.PerSocketLessPlatform - platform
+	}
+
+	return 0
+}
+
 // ProcessorFunctionInfo defines the number of cores to assign to a
 // specific function.
 type ProcessorFunctionInfo struct {
@@ -75,9 +123,16 @@ type ProcessorFunctionInfo struct {
 	Function string `json:"function"`
 
 	// Count defines the number of cores to allocate to a specific function.
+	// It is ignored if an allocation policy is specified.
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=1024
+	// +optional
 	Count int `json:"count"`
+
+	// Policy defines the number of cores to allocate to a specific function
+	// relative to the physical cores of the node.
+	// +optional
+	Policy *ProcessorAllocationPolicy `json:"policy,omitempty"`
 }
 
 // ProcessorFunctionList defines a type to represent a slice of processor
@@ -103,6 +158,38 @@ type ProcessorInfo struct {
 // +deepequal-gen:unordered-array=true
 type ProcessorNodeList []ProcessorInfo
 
+// MemoryAllocationPolicy defines the number of huge pages to assign to the
+// vm function relative to the number of pages of the same size that the memory
+// of a NUMA node/socket can hold.  Exactly one attribute must be specified.
+// Policies are resolved at reconcile time.
+// +deepequal-gen:ignore-nil-fields=true
+type MemoryAllocationPolicy struct {
+	// Percent defines the number of pages as a percentage of the pages that
+	// the node can hold.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *int `json:"percent,omitempty"`
+
+	// Remaining defines that all of the pages that the node can hold must be
+	// allocated.
+	// +optional
+	Remaining *bool `json:"remaining,omitempty"`
+}
+
+// Resolve determines the number of pages to allocate based on the number of
+// pages that the node can hold.
+func (in *MemoryAllocationPolicy) Resolve(possible int) int {
+	switch {
+	case in.Percent != nil:
+		return possible * *in.Percent / 100
+	case in.Remaining != nil && *in.Remaining:
+		return possible
+	}
+
+	return 0
+}
+
 // MemoryFunctionInfo defines the amount of memory to assign to a
 // specific function.
 type MemoryFunctionInfo struct {
@@ -117,7 +204,14 @@ type MemoryFunctionInfo struct {
 	PageSize string `json:"pageSize"`
 
 	// PageCount defines the number of pages to allocate to a specific function.
+	// It is ignored if an allocation policy is specified.
+	// +optional
 	PageCount int `json:"pageCount"`
+
+	// Policy defines the number of pages to allocate to a specific function
+	// relative to the memory of the node.
+	// +optional
+	Policy *MemoryAllocationPolicy `json:"policy,omitempty"`
 }
 
 // MemoryFunctionList defines a type to represent a slice of memory function
@@ -143,6 +237,75 @@ type MemoryNodeInfo struct {
 // +deepequal-gen:unordered-array=true
 type MemoryNodeList []MemoryNodeInfo
 
+// DiskSelector defines a set of disk attributes used to select a host disk
+// rather than referring to it by its device path.  All specified attributes
+// must match and a selector is expected to match exactly one of the disks
+// reported by the host inventory.  Selectors are resolved to device paths at
+// reconcile time.
+// +deepequal-gen:ignore-nil-fields=true
+type DiskSelector struct {
+	// MinSize defines the minimum size of the disk in GiB.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinSize *int `json:"minSize,omitempty"`
+
+	// MaxSize defines the maximum size of the disk in GiB.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxSize *int `json:"maxSize,omitempty"`
+
+	// Type defines the disk technology.
+	// +kubebuilder:validation:Enum=HDD;SSD;NVME
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Model defines the model number reported by the disk.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Model *string `json:"model,omitempty"`
+
+	// Serial defines the serial number reported by the disk.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Serial *string `json:"serial,omitempty"`
+
+	// WWN defines the world wide name reported by the disk.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	WWN *string `json:"wwn,omitempty"`
+}
+
+// Matches determines whether a host disk with the given attributes is
+// selected by this disk selector.  The size is expected in GiB.
+func (in *DiskSelector) Matches(size int, diskType, model, serial, wwn string) bool {
+	if in.MinSize != nil && size < *in.MinSize {
+		return false
+	}
+	if in.MaxSize != nil && size > *in.MaxSize {
+		return false
+	}
+	if in.Type != nil && !strings.EqualFold(*in.Type, diskType) {
+		return false
+	}
+	if in.Model != nil && strings.TrimSpace(*in.Model) != strings.TrimSpace(model) {
+		return false
+	}
+	if in.Serial != nil && !strings.EqualFold(*in.Serial, serial) {
+		return false
+	}
+	if in.WWN != nil && !strings.EqualFold(*in.WWN, wwn) {
+		return false
+	}
+	return true
+}
+
+// IsEmpty determines whether no attributes are specified in this disk
+// selector, in which case it would match any disk.
+func (in *DiskSelector) IsEmpty() bool {
+	return in.MinSize == nil && in.MaxSize == nil && in.Type == nil &&
+		in.Model == nil && in.Serial == nil && in.WWN == nil
+}
+
 // JournalInfo defines attributes of an OSD journal device.
 type JournalInfo struct {
 	// Location defines the OSD device path to be used as the Journal OSD for
@@ -163,9 +326,16 @@ type OSDInfo struct {
 	Function string `json:"function"`
 
 	// Path defines the disk device path to use as backing for the OSD device.
+	// Either the path or a disk selector must be specified.
 	// +kubebuilder:validation:MaxLength=4095
 	// +kubebuilder:validation:Pattern=^/dev/.+$
-	Path string `json:"path"`
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Selector defines the attributes of the disk to use as backing for the
+	// OSD device.  It is resolved to a disk device path at reconcile time.
+	// +optional
+	Selector *DiskSelector `json:"selector,omitempty"`
 
 	// ClusterName defines the storage cluster to which the OSD device should
 	// be assigned.  By default this is the "ceph_cluster".
@@ -173,6 +343,14 @@ type OSDInfo struct {
 	// +optional
 	ClusterName *string `json:"cluster,omitempty"`
 
+	// Tier defines the storage tier, within the storage cluster, to which
+	// the OSD device should be bound.  By default this is the "storage" tier
+	// created by the system along with the cluster.
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_]+$
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Tier *string `json:"tier,omitempty"`
+
 	// Journal defines another OSD device to be used as the journal for this
 	// OSD device.
 	// +optional
@@ -195,6 +373,15 @@ func (in *OSDInfo) GetClusterName() string {
 	return *in.ClusterName
 }
 
+// GetTierName returns the configured storage tier name or the default if it
+// wasn't specified.
+func (in *OSDInfo) GetTierName() string {
+	if in.Tier == nil {
+		return storagetiers.StorageTierName
+	}
+	return *in.Tier
+}
+
 // PhysicalVolumeInfo defines attributes of a physical volume.
 // +deepequal-gen:ignore-nil-fields=true
 type PhysicalVolumeInfo struct {
@@ -205,9 +392,16 @@ type PhysicalVolumeInfo struct {
 	// Path defines the device path backing the physical volume.  If 'Type' is
 	// set as disk then this attribute refers to the absolute path of a disk
 	// device.  If 'Type' is set as partition then it refers to the device path
-	// of the disk onto which this partition will be created.
+	// of the disk onto which this partition will be created.  Either the path
+	// or a disk selector must be specified.
 	// +kubebuilder:validation:MaxLength=255
-	Path string `json:"path"`
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Selector defines the attributes of the disk backing the physical volume.
+	// It is resolved to a disk device path at reconcile time.
+	// +optional
+	Selector *DiskSelector `json:"selector,omitempty"`
 
 	// Size defines the size of the disk partition in gibibytes.  This should be
 	// omitted if the path refers to a disk.
@@ -268,6 +462,66 @@ type MonitorInfo struct {
 	Size *int `json:"size,omitempty"`
 }
 
+// FileSystemSizePolicy defines a filesystem size relative to the space that
+// is available to it in the cgts-vg volume group.  The available space is the
+// current size of the filesystem plus the unallocated space of the volume
+// group.  Policies are resolved at reconcile time and, since filesystems
+// cannot be shrunk, a resolved size smaller than the current size is ignored.
+type FileSystemSizePolicy struct {
+	// Percent defines the size as a percentage of the available space.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *int `json:"percent,omitempty"`
+
+	// MaxAvailable defines that the filesystem must use all of the available
+	// space.
+	// +optional
+	MaxAvailable *bool `json:"maxAvailable,omitempty"`
+
+	// Min defines the lower bound of the resolved size in GiB.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Min *int `json:"min,omitempty"`
+
+	// Max defines the upper bound of the resolved size in GiB.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Max *int `json:"max,omitempty"`
+}
+
+// Resolve determines the absolute size in GiB of a filesystem based on its
+// explicit size, its current size, and the unallocated space of its volume
+// group.  The explicit size is only used when neither a percentage nor the
+// maximum available space is requested.
+func (in *FileSystemSizePolicy) Resolve(size int, current int, available int) int {
+	total := current + available
+
+	result := size
+	if in.MaxAvailable != nil && *in.MaxAvailable {
+		result = total
+	} else if in.Percent != nil {
+		result = total * *in.Percent / 100
+	} else if result == 0 {
+		result = current
+	}
+
+	if in.Max != nil && result > *in.Max {
+		result = *in.Max
+	}
+
+	if in.Min != nil && result < *in.Min {
+		result = *in.Min
+	}
+
+	if result < current {
+		// Filesystems can only grow.
+		result = current
+	}
+
+	return result
+}
+
 // FileSystemInfo defines the attributes of a single host filesystem resource.
 type FileSystemInfo struct {
 	// Name defines the system defined name of the filesystem resource.  Each
@@ -276,9 +530,17 @@ type FileSystemInfo struct {
 	// +kubebuilder:validation:Enum=backup;docker;scratch;kubelet;log;root;var;image-conversion;instances;ceph
 	Name string `json:"name"`
 
+	// Size defines the absolute size of the filesystem in GiB.  It may be
+	// omitted if a size policy is specified.
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:ExclusiveMinimum=false
-	Size int `json:"size"`
+	// +optional
+	Size int `json:"size,omitempty"`
+
+	// SizePolicy defines the size of the filesystem relative to the space
+	// available in the cgts-vg volume group of the host.
+	// +optional
+	SizePolicy *FileSystemSizePolicy `json:"sizePolicy,omitempty"`
 }
 
 // FileSystemList defines a type to represent a slice of host filesystem
@@ -311,13 +573,95 @@ type ProfileStorageInfo struct {
 	FileSystems FileSystemList `json:"filesystems,omitempty"`
 }
 
+// PortSelector defines a set of port attributes used to select a host
+// Ethernet port rather than referring to it by its kernel name, which may vary
+// between hardware revisions and BIOS settings.  All specified attributes
+// must match and a selector is expected to match exactly one of the ports
+// reported by the host inventory.  Selectors are resolved to port names at
+// reconcile time.
+// +kubebuilder:validation:MinProperties=1
+type PortSelector struct {
+	// PCIAddress defines the PCI address of the port (e.g., 0000:18:00.0).
+	// +kubebuilder:validation:Pattern=^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$
+	// +optional
+	PCIAddress *string `json:"pciAddress,omitempty"`
+
+	// MAC defines a regular expression matched against the MAC address of
+	// the port (e.g., ^3c:fd:fe:).  The match is case-insensitive.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	MAC *string `json:"mac,omitempty"`
+
+	// VendorID defines the PCI vendor ID of the port (e.g., 8086).
+	// +kubebuilder:validation:Pattern=^[0-9a-fA-F]{4}$
+	// +optional
+	VendorID *string `json:"vendorID,omitempty"`
+
+	// DeviceID defines the PCI device ID of the port (e.g., 1572).
+	// +kubebuilder:validation:Pattern=^[0-9a-fA-F]{4}$
+	// +optional
+	DeviceID *string `json:"deviceID,omitempty"`
+
+	// Driver defines the driver bound to the port (e.g., i40e).
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Driver *string `json:"driver,omitempty"`
+
+	// NUMANode defines the NUMA node to which the port is attached.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	NUMANode *int `json:"numaNode,omitempty"`
+}
+
+// IsEmpty determines whether no attributes are specified in this port
+// selector, in which case it would match any port.
+func (in *PortSelector) IsEmpty() bool {
+	return in.PCIAddress == nil && in.MAC == nil && in.VendorID == nil &&
+		in.DeviceID == nil && in.Driver == nil && in.NUMANode == nil
+}
+
+// Matches determines whether a host port with the given attributes is
+// selected by this port selector.  A nil NUMA node never matches a selector
+// which specifies one.  A MAC expression that does not compile never matches.
+func (in *PortSelector) Matches(pciAddress, mac, vendorID, deviceID, driver string, numaNode *int) bool {
+	if in.PCIAddress != nil && !strings.EqualFold(*in.PCIAddress, pciAddress) {
+		return false
+	}
+	if in.MAC != nil {
+		re, err := regexp.Compile("(?i)" + *in.MAC)
+		if err != nil || !re.MatchString(mac) {
+			return false
+		}
+	}
+	if in.VendorID != nil && !strings.EqualFold(*in.VendorID, vendorID) {
+		return false
+	}
+	if in.DeviceID != nil && !strings.EqualFold(*in.DeviceID, deviceID) {
+		return false
+	}
+	if in.Driver != nil && *in.Driver != driver {
+		return false
+	}
+	if in.NUMANode != nil && (numaNode == nil || *in.NUMANode != *numaNode) {
+		return false
+	}
+	return true
+}
+
 // EthernetPortInfo defines the attributes specific to a single
 // Ethernet port.
 type EthernetPortInfo struct {
-	// SystemName defines the device name of the Ethernet port.
+	// SystemName defines the device name of the Ethernet port.  Either the
+	// name or a port selector must be specified.
 	// +kubebuilder:validation:MaxLength=255
 	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_]+$
-	Name string `json:"name"`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Selector defines the attributes of the Ethernet port.  It is resolved
+	// to a port name at reconcile time.
+	// +optional
+	Selector *PortSelector `json:"selector,omitempty"`
 }
 
 // TODO(wasnio): remove this type once deepequal-gen can generate deepequal code
@@ -344,6 +688,12 @@ type DataNetworkItemList []string
 // +deepequal-gen:unordered-array=true
 type PtpInterfaceItemList []string
 
+// AddressPoolItemList defines a type to represent a slice of AddressPool names.
+// +kubebuilder:validation:items:MaxLength=255
+// +kubebuilder:validation:items:Pattern=^[a-zA-Z0-9\-_]+$
+// +deepequal-gen:unordered-array=true
+type AddressPoolItemList []string
+
 // CommonInterfaceInfo defines the attributes common to all interface
 // types.  They are defined once, here,
 // and inlined within each of the different interface type structures.
@@ -390,6 +740,14 @@ type CommonInterfaceInfo struct {
 	// +nullable
 	PtpInterfaces PtpInterfaceItemList `json:"ptpInterfaces"`
 
+	// AddressPools defines the list of address pools from which a static
+	// address is allocated for this interface.  Allocations are recorded in
+	// the status of each address pool so that the interface keeps the same
+	// address across reconciles.  Only applicable to data and platform
+	// interfaces.
+	// +optional
+	AddressPools AddressPoolItemList `json:"addressPools,omitempty"`
+
 	// MaxTxRate defines the maximum tx rate of interfaces
 	// for rate limiting.
 	// 1. Applicable if the interface class is set to "platform" and
@@ -595,6 +953,60 @@ type RouteInfo struct {
 // +deepequal-gen:unordered-array=true
 type RouteList []RouteInfo
 
+// PCIDeviceInfo defines the configurable attributes of a host PCI device.  A
+// device is selected either by its PCI address or by its PCI device ID.  When
+// selected by device ID the attributes apply to every matching device on the
+// host.
+// +deepequal-gen:ignore-nil-fields=true
+type PCIDeviceInfo struct {
+	// Address defines the PCI address of the device (e.g., 0000:b3:00.0).
+	// +kubebuilder:validation:Pattern=^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$
+	// +optional
+	Address *string `json:"address,omitempty"`
+
+	// DeviceID defines the PCI device ID of the device (e.g., 0d5c).
+	// +kubebuilder:validation:Pattern=^[0-9a-fA-F]{4}$
+	// +optional
+	DeviceID *string `json:"deviceID,omitempty"`
+
+	// Enabled defines whether the device is available for use.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Driver defines the device driver to be bound to the physical function.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Driver *string `json:"driver,omitempty"`
+
+	// VFCount defines the number of SRIOV virtual functions to be created on
+	// the device.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	VFCount *int `json:"vfCount,omitempty"`
+
+	// VFDriver defines the device driver to be bound to each individual
+	// virtual function.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	VFDriver *string `json:"vfDriver,omitempty"`
+}
+
+// Matches determines whether a host device with the given PCI address and PCI
+// device ID is selected by this PCI device info.
+func (in *PCIDeviceInfo) Matches(address string, deviceID string) bool {
+	if in.Address != nil && !strings.EqualFold(*in.Address, address) {
+		return false
+	}
+	if in.DeviceID != nil && !strings.EqualFold(*in.DeviceID, deviceID) {
+		return false
+	}
+	return in.Address != nil || in.DeviceID != nil
+}
+
+// PCIDeviceList defines a type to represent a slice of PCI devices.
+// +deepequal-gen:unordered-array=true
+type PCIDeviceList []PCIDeviceInfo
+
 // IsKeyEqual compares two processor info array elements and determines if they
 // refer to the same instance.  All other attributes will be merged during
 // profile merging.
@@ -627,6 +1039,10 @@ func (in MemoryFunctionInfo) IsKeyEqual(x MemoryFunctionInfo) bool {
 // they refer to the same instance.  All other attributes will be merged during
 // profile merging.
 func (in OSDInfo) IsKeyEqual(x OSDInfo) bool {
+	if in.Selector != nil || x.Selector != nil {
+		// OSDs selected by disk attributes have no path until resolved.
+		return in.Selector != nil && in.Selector.DeepEqual(x.Selector)
+	}
 	return in.Path == x.Path
 }
 
@@ -648,6 +1064,10 @@ func (in FileSystemInfo) IsKeyEqual(x FileSystemInfo) bool {
 // they refer to the same instance.  All other attributes will be merged during
 // profile merging.
 func (in EthernetInfo) IsKeyEqual(x EthernetInfo) bool {
+	if in.Port.Selector != nil || x.Port.Selector != nil {
+		// Ports selected by their attributes have no name until resolved.
+		return in.Port.Selector != nil && in.Port.Selector.DeepEqual(x.Port.Selector)
+	}
 	// Ethernet interfaces can be renamed but only a single interface can refer
 	// to a unique port name
 	return in.Port.Name == x.Port.Name
@@ -684,6 +1104,22 @@ func (in AddressInfo) IsKeyEqual(x AddressInfo) bool {
 	return in.Address == x.Address
 }
 
+// IsKeyEqual compares two PCI device array elements and determines if they
+// refer to the same instance.  All other attributes will be merged during
+// profile merging.
+func (in PCIDeviceInfo) IsKeyEqual(x PCIDeviceInfo) bool {
+	if (in.Address == nil) != (x.Address == nil) || (in.DeviceID == nil) != (x.DeviceID == nil) {
+		return false
+	}
+	if in.Address != nil && !strings.EqualFold(*in.Address, *x.Address) {
+		return false
+	}
+	if in.DeviceID != nil && !strings.EqualFold(*in.DeviceID, *x.DeviceID) {
+		return false
+	}
+	return true
+}
+
 // IsKeyEqual compares two interface route array elements and determines if
 // they refer to the same instance.  All other attributes will be merged during
 // profile merging.
@@ -754,6 +1190,13 @@ type ProfileBaseAttributes struct {
 	// +optional
 	BootDevice *string `json:"bootDevice,omitempty"`
 
+	// BootDeviceSelector defines the attributes of the device to be used for
+	// installation.  Since disk attributes are only known once the host is
+	// present in inventory, the selector is resolved to a device path when
+	// the host is reconciled and cannot influence the initial installation.
+	// +optional
+	BootDeviceSelector *DiskSelector `json:"bootDeviceSelector,omitempty"`
+
 	// PowerOn defines the initial power state of the node if static
 	// provisioning is being used.
 	// +optional
@@ -789,6 +1232,12 @@ type ProfileBaseAttributes struct {
 	// +optional
 	RootDevice *string `json:"rootDevice,omitempty"`
 
+	// RootDeviceSelector defines the attributes of the device to be used as
+	// the root file system.  Like the boot device selector, it is resolved to
+	// a device path when the host is reconciled.
+	// +optional
+	RootDeviceSelector *DiskSelector `json:"rootDeviceSelector,omitempty"`
+
 	// ClockSynchronization defines the clock synchronization source of the host
 	// resource.
 	// +kubebuilder:validation:Enum=ntp;ptp
@@ -932,6 +1381,14 @@ type HostProfileSpec struct {
 	// therefore the host must be configured with valid addresses or configured
 	// to for automatic address assignment from a platform network.
 	Routes RouteList `json:"routes,omitempty"`
+
+	// Devices defines the list of PCI devices to be configured against this
+	// host (e.g., FEC accelerators or QAT/GPU devices used for pass-through).
+	// Only the listed devices are configured; all other devices are left
+	// untouched.  The system only allows configuring devices while the host
+	// is locked.
+	// +optional
+	Devices PCIDeviceList `json:"devices,omitempty"`
 }
 
 // HasWorkerSubfunction is a utility function that returns true if a profile
@@ -0,0 +1,213 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the phases reported by a network reconfiguration.
+const (
+	ReconfigurationPhasePending   = "pending"
+	ReconfigurationPhaseBlocked   = "blocked"
+	ReconfigurationPhasePaused    = "paused"
+	ReconfigurationPhaseApplying  = "applying"
+	ReconfigurationPhaseCompleted = "completed"
+)
+
+// Defines the states reported by each step of a network reconfiguration.
+const (
+	ReconfigurationStepPending    = "pending"
+	ReconfigurationStepInProgress = "in-progress"
+	ReconfigurationStepCompleted  = "completed"
+)
+
+// Defines the steps of a network reconfiguration.
+const (
+	ReconfigurationStepLockRequired    = "mark-lock-required"
+	ReconfigurationStepLockHosts       = "lock-hosts"
+	ReconfigurationStepUpdatePools     = "update-address-pools"
+	ReconfigurationStepUnlockHosts     = "unlock-hosts"
+	ReconfigurationStepLockUnlockHosts = "lock-unlock-hosts"
+)
+
+// NetworkReconfigurationSpec defines the desired state of
+// NetworkReconfiguration
+type NetworkReconfigurationSpec struct {
+	// PlatformNetwork defines the name of the PlatformNetwork resource being
+	// reconfigured.  Only oam, mgmt and admin networks are supported.
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_]+$
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="platformNetwork is immutable"
+	PlatformNetwork string `json:"platformNetwork"`
+
+	// Paused defines whether the reconfiguration is held before the system
+	// address pools are updated.  Hosts which have already been locked stay
+	// locked.  Clearing the attribute resumes the reconfiguration from the
+	// step where it was held.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ReconfigurationCheck defines the result of a check evaluated before a
+// network reconfiguration is allowed to start.
+type ReconfigurationCheck struct {
+	// Name defines the name of the check.
+	Name string `json:"name"`
+
+	// Passed defines whether the check succeeded.
+	Passed bool `json:"passed"`
+
+	// Message defines a human readable explanation of the result.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ReconfigurationStep defines a step of a network reconfiguration.
+type ReconfigurationStep struct {
+	// Name defines the name of the step.
+	Name string `json:"name"`
+
+	// Description defines a human readable description of the step.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Hosts defines the hosts affected by the step, in the order in which
+	// they are handled.
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+
+	// State defines the state of the step (e.g., pending, in-progress,
+	// completed).
+	State string `json:"state"`
+}
+
+// AddressPoolSnapshot defines the system configuration of an address pool
+// before it was reconfigured.
+type AddressPoolSnapshot struct {
+	// Name defines the name of the address pool.
+	Name string `json:"name"`
+
+	// Subnet defines the network address of the address pool.
+	Subnet string `json:"subnet"`
+
+	// Prefix defines the network prefix length of the address pool.
+	Prefix int `json:"prefix"`
+
+	// FloatingAddress defines the floating address of the address pool.
+	// +optional
+	FloatingAddress string `json:"floatingAddress,omitempty"`
+
+	// Controller0Address defines the controller-0 address of the address
+	// pool.
+	// +optional
+	Controller0Address string `json:"controller0Address,omitempty"`
+
+	// Controller1Address defines the controller-1 address of the address
+	// pool.
+	// +optional
+	Controller1Address string `json:"controller1Address,omitempty"`
+
+	// Gateway defines the gateway address of the address pool.
+	// +optional
+	Gateway *string `json:"gateway,omitempty"`
+}
+
+// NetworkReconfigurationStatus defines the observed state of
+// NetworkReconfiguration
+type NetworkReconfigurationStatus struct {
+	// Phase defines the current phase of the reconfiguration (e.g., pending,
+	// blocked, paused, applying, completed).
+	// +optional
+	Phase *string `json:"phase,omitempty"`
+
+	// NetworkType defines the type of the platform network being
+	// reconfigured.
+	// +optional
+	NetworkType string `json:"networkType,omitempty"`
+
+	// Checks defines the result of the checks evaluated before the system
+	// address pools are updated.  A failed check blocks the reconfiguration.
+	// +optional
+	Checks []ReconfigurationCheck `json:"checks,omitempty"`
+
+	// Steps defines the ordered steps of the reconfiguration, including the
+	// host lock and unlock sequence.
+	// +optional
+	Steps []ReconfigurationStep `json:"steps,omitempty"`
+
+	// CurrentStep defines the name of the first step which has not been
+	// completed.
+	// +optional
+	CurrentStep *string `json:"currentStep,omitempty"`
+
+	// Previous defines the system configuration of the address pools of the
+	// network recorded before they were updated.
+	// +optional
+	Previous []AddressPoolSnapshot `json:"previous,omitempty"`
+
+	// Rollback defines the actions required to return the network to its
+	// previous configuration.
+	// +optional
+	Rollback []string `json:"rollback,omitempty"`
+
+	// Defines whether the reconfiguration has been completed.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reconciled defines whether the reconfiguration has been completed at
+	// least once.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+}
+
+// +kubebuilder:object:root=true
+// NetworkReconfiguration defines an explicit workflow which tracks the
+// reconfiguration of the subnet of the oam, mgmt or admin platform network of
+// a StarlingX system.  The reconfiguration itself is still performed by the
+// host controller; this resource reports the checks, steps and host lock and
+// unlock sequence of the reconfiguration and can hold it before the system is
+// modified.
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="network",type="string",JSONPath=".spec.platformNetwork",description="The platform network being reconfigured."
+// +kubebuilder:printcolumn:name="type",type="string",JSONPath=".status.networkType",description="The platform network type."
+// +kubebuilder:printcolumn:name="phase",type="string",JSONPath=".status.phase",description="The current reconfiguration phase."
+// +kubebuilder:printcolumn:name="step",type="string",JSONPath=".status.currentStep",description="The current reconfiguration step."
+// +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
+type NetworkReconfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkReconfigurationSpec   `json:"spec,omitempty"`
+	Status NetworkReconfigurationStatus `json:"status,omitempty"`
+}
+
+// IsHolding returns whether the reconfiguration prevents the system address
+// pools of its network from being updated, either because it was paused or
+// because one of its checks failed.
+func (in *NetworkReconfiguration) IsHolding() bool {
+	if in.Spec.Paused {
+		return true
+	}
+	return in.Status.Phase != nil && *in.Status.Phase == ReconfigurationPhaseBlocked
+}
+
+// +kubebuilder:object:root=true
+// NetworkReconfigurationList contains a list of NetworkReconfiguration
+// +deepequal-gen=false
+type NetworkReconfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkReconfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NetworkReconfiguration{}, &NetworkReconfigurationList{})
+}
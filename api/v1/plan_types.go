@@ -0,0 +1,38 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+// PlannedOperation defines a single system API request that a reconciler
+// would have issued if the resource was not in plan mode.
+type PlannedOperation struct {
+	// Method defines the HTTP method of the request (e.g., POST, PATCH,
+	// DELETE).
+	Method string `json:"method"`
+
+	// Path defines the request path relative to the system API endpoint.
+	Path string `json:"path"`
+
+	// Body defines the request body, if any, that would have been sent.
+	// +optional
+	Body *string `json:"body,omitempty"`
+}
+
+// PlanStatus defines the ordered list of system API requests computed by a
+// reconciler while the resource, or its namespace, is in plan mode.
+type PlanStatus struct {
+	// ObservedGeneration defines the resource generation against which the
+	// plan was computed.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Operations defines the ordered list of requests that would be issued
+	// to the system API.
+	// +optional
+	Operations []PlannedOperation `json:"operations,omitempty"`
+
+	// Message defines the reason planning stopped before the resource could
+	// be fully reconciled (e.g., a lock action that must complete before any
+	// further changes can be computed).
+	// +optional
+	Message string `json:"message,omitempty"`
+}
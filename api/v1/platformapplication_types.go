@@ -0,0 +1,148 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the desired states of a platform application.
+const (
+	ApplicationStateUploaded = "uploaded"
+	ApplicationStateApplied  = "applied"
+	ApplicationStateRemoved  = "removed"
+)
+
+// HelmOverrideInfo defines the user Helm override values of a single chart
+// of a platform application.
+type HelmOverrideInfo struct {
+	// Chart defines the name of the Helm chart to which the values apply.
+	Chart string `json:"chart"`
+
+	// Namespace defines the namespace in which the chart is deployed.
+	Namespace string `json:"namespace"`
+
+	// Values defines the user override values in YAML format.  They replace
+	// any user override values previously set on the chart.
+	Values string `json:"values"`
+}
+
+// PlatformApplicationSpec defines the desired state of PlatformApplication
+type PlatformApplicationSpec struct {
+	// Tarball defines the path, on the active controller, of the application
+	// tarball.  It is only required to upload an application that is not yet
+	// known to the system, or to update an application to a new version.
+	// +optional
+	Tarball *string `json:"tarball,omitempty"`
+
+	// Version defines the expected application version.  If the version
+	// known to the system differs then the application is updated using the
+	// tarball.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// State defines the desired application state.
+	// +kubebuilder:validation:Enum=uploaded;applied;removed
+	// +optional
+	// +kubebuilder:default:=applied
+	State string `json:"state,omitempty"`
+
+	// Overrides defines the user Helm override values to be set on the
+	// application charts before the application is applied.
+	// +optional
+	Overrides []HelmOverrideInfo `json:"overrides,omitempty"`
+}
+
+// PlatformApplicationStatus defines the observed state of PlatformApplication
+type PlatformApplicationStatus struct {
+	// Status defines the application status last reported by the system
+	// (e.g., uploaded, applying, applied, apply-failed).
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// Version defines the application version last reported by the system.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// Progress defines the progress message of the last lifecycle operation
+	// reported by the system.
+	// +optional
+	Progress *string `json:"progress,omitempty"`
+
+	// Reconciled defines whether the application has been successfully
+	// reconciled at least once.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the application has reached its desired state on the
+	// target system.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Delta between the desired state and the current application state.
+	// +optional
+	Delta string `json:"delta"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// PlatformApplication defines the attributes that represent the lifecycle of
+// a StarlingX platform application (e.g., cert-manager, rook-ceph).  This is
+// a composition of the following StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/config/api-ref-sysinv-v1-config.html#applications
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="state",type="string",JSONPath=".spec.state",description="The desired application state."
+// +kubebuilder:printcolumn:name="status",type="string",JSONPath=".status.status",description="The application status reported by the system."
+// +kubebuilder:printcolumn:name="version",type="string",JSONPath=".status.version",description="The application version reported by the system."
+// +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type PlatformApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PlatformApplicationSpec   `json:"spec,omitempty"`
+	Status PlatformApplicationStatus `json:"status,omitempty"`
+}
+
+func (in *PlatformApplication) GetPlan() *PlanStatus {
+	return in.Status.Plan
+}
+
+func (in *PlatformApplication) SetPlan(plan *PlanStatus) {
+	in.Status.Plan = plan
+}
+
+// DesiredState returns the desired application state taking into account
+// the default value.
+func (in *PlatformApplication) DesiredState() string {
+	if in.Spec.State == "" {
+		return ApplicationStateApplied
+	}
+	return in.Spec.State
+}
+
+// +kubebuilder:object:root=true
+// PlatformApplicationList contains a list of PlatformApplication
+// +deepequal-gen=false
+type PlatformApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PlatformApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PlatformApplication{}, &PlatformApplicationList{})
+}
@@ -58,6 +58,11 @@ type PlatformNetworkStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
 }
 
 func (p *PlatformNetwork) GetStrategyRequired() string {
@@ -76,6 +81,14 @@ func (p *PlatformNetwork) SetDeploymentScope(scope string) {
 	p.Status.DeploymentScope = scope
 }
 
+func (p *PlatformNetwork) GetPlan() *PlanStatus {
+	return p.Status.Plan
+}
+
+func (p *PlatformNetwork) SetPlan(plan *PlanStatus) {
+	p.Status.Plan = plan
+}
+
 func (p *PlatformNetwork) GetAnnotations() map[string]string {
 	return p.Annotations
 }
@@ -0,0 +1,201 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the phases reported by a platform upgrade.
+const (
+	UpgradePhaseBuilding  = "building"
+	UpgradePhaseApplying  = "applying"
+	UpgradePhaseCompleted = "completed"
+	UpgradePhaseFailed    = "failed"
+)
+
+// UpgradeStrategyInfo defines the attributes of the VIM orchestration
+// strategy used to upgrade the hosts of the system.  Any attribute that is
+// not specified is left to the default value chosen by the operator.
+type UpgradeStrategyInfo struct {
+	// ControllerApplyType defines how the upgrade is applied to the controller
+	// hosts.  It is ignored by Kubernetes upgrades.
+	// +kubebuilder:validation:Enum=serial;ignore
+	// +optional
+	ControllerApplyType *string `json:"controllerApplyType,omitempty"`
+
+	// StorageApplyType defines how the upgrade is applied to the storage
+	// hosts.
+	// +kubebuilder:validation:Enum=serial;parallel;ignore
+	// +optional
+	StorageApplyType *string `json:"storageApplyType,omitempty"`
+
+	// WorkerApplyType defines how the upgrade is applied to the worker hosts.
+	// +kubebuilder:validation:Enum=serial;parallel;ignore
+	// +optional
+	WorkerApplyType *string `json:"workerApplyType,omitempty"`
+
+	// MaxParallelWorkers defines the maximum number of worker hosts upgraded
+	// at the same time when the worker apply type is parallel.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxParallelWorkers *int `json:"maxParallelWorkers,omitempty"`
+
+	// DefaultInstanceAction defines the action taken on the instances running
+	// on a host before it is upgraded.
+	// +kubebuilder:validation:Enum=stop-start;migrate
+	// +optional
+	DefaultInstanceAction *string `json:"defaultInstanceAction,omitempty"`
+
+	// AlarmRestrictions defines whether the upgrade is allowed to proceed
+	// while minor or management affecting alarms are raised.
+	// +kubebuilder:validation:Enum=strict;relaxed
+	// +optional
+	AlarmRestrictions *string `json:"alarmRestrictions,omitempty"`
+}
+
+// PlatformUpgradeSpec defines the desired state of PlatformUpgrade
+// +kubebuilder:validation:XValidation:rule="has(self.release) != has(self.kubernetesVersion)",message="exactly one of release or kubernetesVersion must be specified"
+type PlatformUpgradeSpec struct {
+	// Release defines the software release to be deployed (e.g.,
+	// starlingx-10.0.1).  The release must already be uploaded to the
+	// system.  A software deploy strategy is used to deploy it.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Release *string `json:"release,omitempty"`
+
+	// KubernetesVersion defines the Kubernetes version to upgrade to (e.g.,
+	// v1.29.2).  A Kubernetes upgrade strategy is used to upgrade to it.
+	// +kubebuilder:validation:Pattern=^v[0-9]+\.[0-9]+\.[0-9]+$
+	// +optional
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+
+	// Strategy defines the attributes of the orchestration strategy.
+	// +optional
+	Strategy *UpgradeStrategyInfo `json:"strategy,omitempty"`
+}
+
+// PlatformUpgradeStatus defines the observed state of PlatformUpgrade
+type PlatformUpgradeStatus struct {
+	// Phase defines the current phase of the upgrade (e.g., building,
+	// applying, completed, failed).
+	// +optional
+	Phase *string `json:"phase,omitempty"`
+
+	// Target defines the release or Kubernetes version targeted by the
+	// current phase.
+	// +optional
+	Target *string `json:"target,omitempty"`
+
+	// StrategyType defines the type of the orchestration strategy created for
+	// the current target.  It is recorded so that the strategy is tracked to
+	// completion even if the spec is changed while it is running.
+	// +optional
+	StrategyType *string `json:"strategyType,omitempty"`
+
+	// StrategyState defines the state of the orchestration strategy last
+	// reported by the VIM.
+	// +optional
+	StrategyState *string `json:"strategyState,omitempty"`
+
+	// CurrentStage defines the index of the strategy stage being executed.
+	// +optional
+	CurrentStage int `json:"currentStage,omitempty"`
+
+	// TotalStages defines the number of stages of the current strategy
+	// phase.
+	// +optional
+	TotalStages int `json:"totalStages,omitempty"`
+
+	// Percentage defines the completion percentage of the current strategy
+	// phase.
+	// +optional
+	Percentage int `json:"percentage,omitempty"`
+
+	// Reason defines the failure reason reported by the VIM.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
+
+	// InProgress defines whether the upgrade is currently being orchestrated.
+	// The reconciliation of all other resources of the namespace is blocked
+	// while an upgrade is in progress.
+	// +optional
+	InProgress bool `json:"inProgress"`
+
+	// Reconciled defines whether the upgrade has been successfully completed
+	// at least once.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the system has reached the desired release or
+	// Kubernetes version.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// PlatformUpgrade defines the attributes that represent the upgrade of a
+// StarlingX system to a new software release or Kubernetes version.  The
+// upgrade is orchestrated across all hosts thru the following StarlingX VIM
+// API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/nfv/api-ref-nfv-vim-v1.html
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="target",type="string",JSONPath=".status.target",description="The release or Kubernetes version being upgraded to."
+// +kubebuilder:printcolumn:name="phase",type="string",JSONPath=".status.phase",description="The current upgrade phase."
+// +kubebuilder:printcolumn:name="percentage",type="integer",JSONPath=".status.percentage",description="The completion percentage of the current phase."
+// +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type PlatformUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PlatformUpgradeSpec   `json:"spec,omitempty"`
+	Status PlatformUpgradeStatus `json:"status,omitempty"`
+}
+
+func (in *PlatformUpgrade) GetPlan() *PlanStatus {
+	return in.Status.Plan
+}
+
+func (in *PlatformUpgrade) SetPlan(plan *PlanStatus) {
+	in.Status.Plan = plan
+}
+
+// Target returns the release or Kubernetes version requested by the upgrade.
+func (in *PlatformUpgrade) Target() string {
+	if in.Spec.Release != nil {
+		return *in.Spec.Release
+	}
+	if in.Spec.KubernetesVersion != nil {
+		return *in.Spec.KubernetesVersion
+	}
+	return ""
+}
+
+// +kubebuilder:object:root=true
+// PlatformUpgradeList contains a list of PlatformUpgrade
+// +deepequal-gen=false
+type PlatformUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PlatformUpgrade `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PlatformUpgrade{}, &PlatformUpgradeList{})
+}
@@ -0,0 +1,218 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"strconv"
+
+	common "github.com/wind-river/cloud-platform-deployment-manager/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PlatformUserPasswordKey is the key of the password within the secret
+// referenced by a keystone user.
+const PlatformUserPasswordKey = "password"
+
+// DefaultKeystoneDomain is the keystone domain used when none is specified.
+const DefaultKeystoneDomain = "default"
+
+// SysadminInfo defines the attributes of the sysadmin account.
+type SysadminInfo struct {
+	// PasswordExpiryDays defines the number of days after which the sysadmin
+	// password must be changed.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99999
+	// +optional
+	PasswordExpiryDays *int `json:"passwordExpiryDays,omitempty"`
+}
+
+// SecurityComplianceInfo defines the keystone account lockout policy.
+type SecurityComplianceInfo struct {
+	// LockoutSeconds defines the number of seconds during which an account
+	// remains locked after too many failed authentication attempts.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	LockoutSeconds *int `json:"lockoutSeconds,omitempty"`
+
+	// LockoutRetries defines the number of failed authentication attempts
+	// after which an account is locked.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	LockoutRetries *int `json:"lockoutRetries,omitempty"`
+}
+
+// KeystoneProjectInfo defines the attributes of a keystone project.
+type KeystoneProjectInfo struct {
+	// Name defines the name of the project.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Name string `json:"name"`
+
+	// Description defines a free form description of the project.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Enabled defines whether the project can be used.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// KeystoneUserInfo defines the attributes of a keystone user.
+type KeystoneUserInfo struct {
+	// Name defines the name of the user.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Project defines the name of the default project of the user.  It is
+	// required if any roles are specified.
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// Email defines the email address of the user.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Email *string `json:"email,omitempty"`
+
+	// Enabled defines whether the user can authenticate.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Roles defines the names of the roles granted to the user on its
+	// default project.  Roles are never revoked once granted.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// Secret defines the name of the secret which holds the password of the
+	// user in the "password" key.  The password is only sent to keystone
+	// when the user is created or when the secret is changed.
+	Secret string `json:"secret"`
+}
+
+// PlatformUsersSpec defines the desired state of PlatformUsers
+type PlatformUsersSpec struct {
+	// Sysadmin defines the attributes of the sysadmin account.
+	// +optional
+	Sysadmin *SysadminInfo `json:"sysadmin,omitempty"`
+
+	// SecurityCompliance defines the keystone account lockout policy.  The
+	// identity service parameters which implement it are owned by this
+	// resource once declared; the same parameters declared on the system
+	// resource are then ignored.
+	// +optional
+	SecurityCompliance *SecurityComplianceInfo `json:"securityCompliance,omitempty"`
+
+	// Domain defines the keystone domain of the projects and users.
+	// +optional
+	Domain *string `json:"domain,omitempty"`
+
+	// Projects defines the keystone projects to be created.  Projects are
+	// never deleted.
+	// +optional
+	Projects []KeystoneProjectInfo `json:"projects,omitempty"`
+
+	// Users defines the keystone users to be created.  Users are never
+	// deleted.
+	// +optional
+	Users []KeystoneUserInfo `json:"users,omitempty"`
+}
+
+// PlatformUsersStatus defines the observed state of PlatformUsers
+type PlatformUsersStatus struct {
+	// PasswordVersions defines, for each user, the resource version of the
+	// password secret last sent to keystone.  It is used to detect password
+	// changes without ever reading passwords back from the system.
+	// +optional
+	PasswordVersions map[string]string `json:"passwordVersions,omitempty"`
+
+	// Reconciled defines whether the users have been successfully reconciled
+	// at least once.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the users have reached their desired state.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// PlatformUsers defines the attributes that represent the local and keystone
+// accounts of a system.  The resource is a composition of the following
+// StarlingX and OpenStack API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/config/api-ref-sysinv-v1-config.html
+//	https://docs.openstack.org/api-ref/identity/v3/
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type PlatformUsers struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PlatformUsersSpec   `json:"spec,omitempty"`
+	Status PlatformUsersStatus `json:"status,omitempty"`
+}
+
+func (in *PlatformUsers) GetPlan() *PlanStatus {
+	return in.Status.Plan
+}
+
+func (in *PlatformUsers) SetPlan(plan *PlanStatus) {
+	in.Status.Plan = plan
+}
+
+// KeystoneDomain returns the keystone domain of the projects and users
+// taking into account the default value.
+func (in *PlatformUsers) KeystoneDomain() string {
+	if in.Spec.Domain != nil {
+		return *in.Spec.Domain
+	}
+	return DefaultKeystoneDomain
+}
+
+// SecurityComplianceParameters returns the identity service parameters which
+// implement the declared account lockout policy, keyed by parameter name.
+func (in *PlatformUsers) SecurityComplianceParameters() map[string]string {
+	result := make(map[string]string)
+
+	spec := in.Spec.SecurityCompliance
+	if spec == nil {
+		return result
+	}
+
+	if spec.LockoutSeconds != nil {
+		result[common.ServiceParamNameSecurityComplianceLockoutDuration] = strconv.Itoa(*spec.LockoutSeconds)
+	}
+	if spec.LockoutRetries != nil {
+		result[common.ServiceParamNameSecurityComplianceLockoutFailureAttempts] = strconv.Itoa(*spec.LockoutRetries)
+	}
+
+	return result
+}
+
+// +kubebuilder:object:root=true
+// PlatformUsersList contains a list of PlatformUsers
+// +deepequal-gen=false
+type PlatformUsersList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PlatformUsers `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PlatformUsers{}, &PlatformUsersList{})
+}
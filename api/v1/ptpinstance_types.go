@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022 Wind River Systems, Inc. */
+/* Copyright(c) 2022, 2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -72,6 +72,41 @@ func (p *PtpInstanceSpec) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Defines the condition types reported by a PtpInstance.
+const (
+	// PtpConditionLocked reports whether every host to which the instance
+	// is assigned is locked to its time source.
+	PtpConditionLocked = "Locked"
+)
+
+// Defines the reasons of the PtpConditionLocked condition.
+const (
+	PtpReasonLocked   = "Locked"
+	PtpReasonLockLost = "LockLost"
+	PtpReasonNoHosts  = "NoHosts"
+)
+
+// PtpHostStatus defines the operational state of a ptp instance on a host to
+// which it is assigned, as reported by the PTP notification service.
+type PtpHostStatus struct {
+	// Host defines the name of the host to which the instance is assigned.
+	Host string `json:"host"`
+
+	// LockState defines the synchronization state of the clock driven by the
+	// instance (e.g., LOCKED, HOLDOVER, FREERUN).
+	// +optional
+	LockState string `json:"lockState,omitempty"`
+
+	// ClockClass defines the clock class of the instance.
+	// +optional
+	ClockClass *int `json:"clockClass,omitempty"`
+
+	// GNSSStatus defines the synchronization state of the GNSS receiver
+	// driven by the instance (e.g., SYNCHRONIZED, ANTENNA-DISCONNECTED).
+	// +optional
+	GNSSStatus string `json:"gnssStatus,omitempty"`
+}
+
 // PtpInstanceStatus defines the observed state of PtpInstance
 type PtpInstanceStatus struct {
 	// ID defines the system assigned unique identifier.  This will only exist
@@ -114,6 +149,23 @@ type PtpInstanceStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// Hosts defines the hosts to which the instance is assigned along with
+	// its operational state on each of them.  It is only populated when the
+	// PTP notification service of the system is reachable.
+	// +optional
+	Hosts []PtpHostStatus `json:"hosts,omitempty"`
+
+	// Conditions defines the operational conditions of the instance.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 func (p *PtpInstance) GetStrategyRequired() string {
@@ -132,6 +184,14 @@ func (p *PtpInstance) SetDeploymentScope(scope string) {
 	p.Status.DeploymentScope = scope
 }
 
+func (p *PtpInstance) GetPlan() *PlanStatus {
+	return p.Status.Plan
+}
+
+func (p *PtpInstance) SetPlan(plan *PlanStatus) {
+	p.Status.Plan = plan
+}
+
 func (p *PtpInstance) GetAnnotations() map[string]string {
 	return p.Annotations
 }
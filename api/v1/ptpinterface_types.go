@@ -61,6 +61,11 @@ type PtpInterfaceStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
 }
 
 func (p *PtpInterface) GetStrategyRequired() string {
@@ -79,6 +84,14 @@ func (p *PtpInterface) SetDeploymentScope(scope string) {
 	p.Status.DeploymentScope = scope
 }
 
+func (p *PtpInterface) GetPlan() *PlanStatus {
+	return p.Status.Plan
+}
+
+func (p *PtpInterface) SetPlan(plan *PlanStatus) {
+	p.Status.Plan = plan
+}
+
 func (p *PtpInterface) GetAnnotations() map[string]string {
 	return p.Annotations
 }
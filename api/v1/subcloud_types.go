@@ -0,0 +1,268 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the desired management states of a subcloud.
+const (
+	SubcloudManaged   = "managed"
+	SubcloudUnmanaged = "unmanaged"
+)
+
+// Defines the keys of the subcloud credentials secret.
+const (
+	SubcloudSysadminPasswordKey = "sysadmin_password"
+	SubcloudBMCPasswordKey      = "bmc_password"
+)
+
+// DefaultSubcloudBundleKey is the key of the deployment bundle within its
+// secret when none is specified.
+const DefaultSubcloudBundleKey = "deployment.yaml"
+
+// SubcloudCredentialsInfo defines the secret which holds the credentials
+// required to deploy and back up a subcloud.
+type SubcloudCredentialsInfo struct {
+	// Secret defines the name of the secret which holds the sysadmin password
+	// in the "sysadmin_password" key and, if the subcloud is remotely
+	// installed, the BMC password in the "bmc_password" key.
+	Secret string `json:"secret"`
+}
+
+// SubcloudBackupInfo defines the attributes of subcloud backups.
+type SubcloudBackupInfo struct {
+	// LocalOnly defines whether the backup is only stored on the subcloud
+	// rather than transferred to the system controller.
+	// +optional
+	LocalOnly *bool `json:"localOnly,omitempty"`
+
+	// RegistryImages defines whether the container images of the local
+	// registry are included in the backup.
+	// +optional
+	RegistryImages *bool `json:"registryImages,omitempty"`
+
+	// Values defines the backup override values in YAML format.
+	// +optional
+	Values *string `json:"values,omitempty"`
+
+	// Request defines an arbitrary value (e.g., a timestamp) which requests a
+	// new backup whenever it is changed.
+	// +optional
+	Request *string `json:"request,omitempty"`
+}
+
+// SubcloudBundleInfo defines the deployment bundle to be propagated once the
+// subcloud is online.  The bundle is the set of resources produced by the
+// deployctl build command for the subcloud.
+type SubcloudBundleInfo struct {
+	// Secret defines the name of the secret which holds the deployment
+	// bundle.  A secret is used since bundles usually include credentials.
+	Secret string `json:"secret"`
+
+	// Key defines the key of the deployment bundle within the secret.
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// Namespace defines the namespace into which the bundle resources are
+	// created.  Each namespace represents a single system therefore it
+	// defaults to the subcloud name.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// SubcloudSpec defines the desired state of Subcloud
+type SubcloudSpec struct {
+	// BootstrapAddress defines the IP address used to reach the subcloud
+	// during its bootstrap.
+	BootstrapAddress string `json:"bootstrapAddress"`
+
+	// BootstrapValues defines the subcloud bootstrap values in YAML format.
+	BootstrapValues string `json:"bootstrapValues"`
+
+	// InstallValues defines the subcloud remote install values in YAML
+	// format.  If omitted the subcloud must already be installed.
+	// +optional
+	InstallValues *string `json:"installValues,omitempty"`
+
+	// DeployConfig defines the subcloud deploy configuration in YAML format.
+	// Changing it re-runs the deploy configure phase of the subcloud.
+	// +optional
+	DeployConfig *string `json:"deployConfig,omitempty"`
+
+	// Credentials defines the secret which holds the subcloud credentials.
+	Credentials SubcloudCredentialsInfo `json:"credentials"`
+
+	// Group defines the name of the subcloud group.
+	// +optional
+	Group *string `json:"group,omitempty"`
+
+	// Release defines the software release to be installed on the subcloud.
+	// +optional
+	Release *string `json:"release,omitempty"`
+
+	// Description defines a free form description of the subcloud.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Location defines a free form location of the subcloud.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// Management defines whether the subcloud should be managed by the
+	// system controller once it is online.
+	// +kubebuilder:validation:Enum=managed;unmanaged
+	// +optional
+	// +kubebuilder:default:=managed
+	Management string `json:"management,omitempty"`
+
+	// Backup defines the attributes of subcloud backups.
+	// +optional
+	Backup *SubcloudBackupInfo `json:"backup,omitempty"`
+
+	// Bundle defines the deployment bundle to be propagated once the
+	// subcloud is online.
+	// +optional
+	Bundle *SubcloudBundleInfo `json:"bundle,omitempty"`
+}
+
+// SubcloudStatus defines the observed state of Subcloud
+type SubcloudStatus struct {
+	// ID defines the unique identifier assigned to the subcloud by the system
+	// controller.
+	// +optional
+	ID *int `json:"id,omitempty"`
+
+	// DeployStatus defines the deployment status last reported by the system
+	// controller.
+	// +optional
+	DeployStatus *string `json:"deployStatus,omitempty"`
+
+	// AvailabilityStatus defines the availability last reported by the
+	// system controller.
+	// +optional
+	AvailabilityStatus *string `json:"availabilityStatus,omitempty"`
+
+	// ManagementState defines the management state last reported by the
+	// system controller.
+	// +optional
+	ManagementState *string `json:"managementState,omitempty"`
+
+	// BackupStatus defines the status of the last backup reported by the
+	// system controller.
+	// +optional
+	BackupStatus *string `json:"backupStatus,omitempty"`
+
+	// SoftwareVersion defines the software release last reported by the
+	// system controller.
+	// +optional
+	SoftwareVersion *string `json:"softwareVersion,omitempty"`
+
+	// DeployConfigHash defines the hash of the deploy configuration last
+	// sent to the system controller.
+	// +optional
+	DeployConfigHash *string `json:"deployConfigHash,omitempty"`
+
+	// BackupRequest defines the value of the last backup request sent to the
+	// system controller.
+	// +optional
+	BackupRequest *string `json:"backupRequest,omitempty"`
+
+	// BundleHash defines the hash of the deployment bundle last propagated.
+	// +optional
+	BundleHash *string `json:"bundleHash,omitempty"`
+
+	// Reconciled defines whether the subcloud has been successfully
+	// reconciled at least once.
+	// +optional
+	Reconciled bool `json:"reconciled"`
+
+	// Defines whether the subcloud has reached its desired state.
+	// +optional
+	InSync bool `json:"inSync"`
+
+	// Reflect value of configuration generation.
+	// The value will be set when configuration generation is updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// Subcloud defines the attributes that represent a subcloud of a distributed
+// cloud system controller.  The resource is created on the system controller
+// namespace and is a composition of the following StarlingX API endpoints.
+//
+//	https://docs.starlingx.io/api-ref/distcloud/api-ref-dcmanager-v1.html
+//
+// +deepequal-gen=false
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="deploy",type="string",JSONPath=".status.deployStatus",description="The deployment status reported by the system controller."
+// +kubebuilder:printcolumn:name="availability",type="string",JSONPath=".status.availabilityStatus",description="The availability reported by the system controller."
+// +kubebuilder:printcolumn:name="management",type="string",JSONPath=".status.managementState",description="The management state reported by the system controller."
+// +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
+// +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+type Subcloud struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubcloudSpec   `json:"spec,omitempty"`
+	Status SubcloudStatus `json:"status,omitempty"`
+}
+
+func (in *Subcloud) GetPlan() *PlanStatus {
+	return in.Status.Plan
+}
+
+func (in *Subcloud) SetPlan(plan *PlanStatus) {
+	in.Status.Plan = plan
+}
+
+// DesiredManagement returns the desired management state taking into account
+// the default value.
+func (in *Subcloud) DesiredManagement() string {
+	if in.Spec.Management == "" {
+		return SubcloudManaged
+	}
+	return in.Spec.Management
+}
+
+// BundleNamespace returns the namespace into which the deployment bundle is
+// propagated taking into account the default value.
+func (in *Subcloud) BundleNamespace() string {
+	if in.Spec.Bundle != nil && in.Spec.Bundle.Namespace != nil {
+		return *in.Spec.Bundle.Namespace
+	}
+	return in.Name
+}
+
+// BundleKey returns the key of the deployment bundle within its secret
+// taking into account the default value.
+func (in *Subcloud) BundleKey() string {
+	if in.Spec.Bundle != nil && in.Spec.Bundle.Key != nil {
+		return *in.Spec.Bundle.Key
+	}
+	return DefaultSubcloudBundleKey
+}
+
+// +kubebuilder:object:root=true
+// SubcloudList contains a list of Subcloud
+// +deepequal-gen=false
+type SubcloudList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subcloud `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Subcloud{}, &SubcloudList{})
+}
@@ -1,9 +1,10 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package v1
 
 import (
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/clusters"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -215,9 +216,17 @@ type ControllerFileSystemInfo struct {
 	// Name defines the system defined name of the filesystem resource.
 	Name string `json:"name"`
 
+	// Size defines the absolute size of the filesystem in GiB.  It may be
+	// omitted if a size policy is specified.
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:ExclusiveMinimum=false
-	Size int `json:"size"`
+	// +optional
+	Size int `json:"size,omitempty"`
+
+	// SizePolicy defines the size of the filesystem relative to the space
+	// available in the cgts-vg volume group of the controllers.
+	// +optional
+	SizePolicy *FileSystemSizePolicy `json:"sizePolicy,omitempty"`
 }
 
 // ControllerFileSystemList defines a type to represent a slice of controller filesystem
@@ -225,6 +234,33 @@ type ControllerFileSystemInfo struct {
 // +deepequal-gen:unordered-array=true
 type ControllerFileSystemList []ControllerFileSystemInfo
 
+// StorageTierInfo defines the attributes of a single Ceph storage tier.
+type StorageTierInfo struct {
+	// Name uniquely identifies the storage tier within its cluster.
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9\-_]+$
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// ClusterName defines the storage cluster to which the tier belongs.  By
+	// default this is the "ceph_cluster".
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	ClusterName *string `json:"cluster,omitempty"`
+}
+
+// GetClusterName returns the configured cluster name or the default if it
+// wasn't specified.
+func (in *StorageTierInfo) GetClusterName() string {
+	if in.ClusterName == nil {
+		return clusters.CephClusterName
+	}
+	return *in.ClusterName
+}
+
+// StorageTierList defines a type to represent a slice of storage tiers.
+// +deepequal-gen:unordered-array=true
+type StorageTierList []StorageTierInfo
+
 // SystemStorageInfo defines the system level storage attributes that are
 // configurable.
 // +deepequal-gen:ignore-nil-fields=true
@@ -239,6 +275,13 @@ type SystemStorageInfo struct {
 	// Filesystems defines the set of controller file system definitions.
 	// +nullable
 	FileSystems ControllerFileSystemList `json:"filesystems,omitempty"`
+
+	// Tiers defines the set of additional Ceph storage tiers.  The default
+	// "storage" tier is created by the system along with each cluster and
+	// does not need to be listed.  Tiers not listed are deleted once no OSD
+	// is bound to them.
+	// +nullable
+	Tiers StorageTierList `json:"tiers,omitempty"`
 }
 
 // PTPInfo defines the system level precision time protocol attributes that are
@@ -263,6 +306,81 @@ type PTPInfo struct {
 	Mechanism *string `json:"mechanism,omitempty"`
 }
 
+// RemoteLoggingInfo defines the system level remote logging (syslog
+// forwarding) attributes that are configurable.
+// +deepequal-gen=false
+type RemoteLoggingInfo struct {
+	// Enabled defines whether the platform logs are forwarded to the remote
+	// server.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Server defines the remote syslog server.  It can be specified as either
+	// an IPv4 or IPv6 address, or a FQDN hostname.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Server *string `json:"server,omitempty"`
+
+	// Transport defines the protocol used to forward the logs.
+	// +kubebuilder:validation:Enum=udp;tcp;tls
+	// +optional
+	Transport *string `json:"transport,omitempty"`
+
+	// Port defines the port on which the remote server receives the logs.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int `json:"port,omitempty"`
+
+	// Certificate is the name of the Secret of the ssl_ca certificate, listed
+	// in the system certificates, used to authenticate the remote server.  It
+	// is only applicable to the tls transport.
+	// +optional
+	Certificate *string `json:"certificate,omitempty"`
+}
+
+// DeepEqual is written by hand, rather than generated, so that every
+// attribute is compared in both directions: an attribute set on one side only
+// is a difference.  Attributes which are not managed by a spec, including the
+// certificate reference which the system API does not report, must be aligned
+// by the caller before comparing a spec with the running configuration.
+func (in *RemoteLoggingInfo) DeepEqual(other *RemoteLoggingInfo) bool {
+	if other == nil {
+		return in == nil
+	}
+
+	if in == nil {
+		return false
+	}
+
+	if (in.Enabled == nil) != (other.Enabled == nil) ||
+		(in.Enabled != nil && *in.Enabled != *other.Enabled) {
+		return false
+	}
+
+	if (in.Server == nil) != (other.Server == nil) ||
+		(in.Server != nil && *in.Server != *other.Server) {
+		return false
+	}
+
+	if (in.Transport == nil) != (other.Transport == nil) ||
+		(in.Transport != nil && *in.Transport != *other.Transport) {
+		return false
+	}
+
+	if (in.Port == nil) != (other.Port == nil) ||
+		(in.Port != nil && *in.Port != *other.Port) {
+		return false
+	}
+
+	if (in.Certificate == nil) != (other.Certificate == nil) ||
+		(in.Certificate != nil && *in.Certificate != *other.Certificate) {
+		return false
+	}
+
+	return true
+}
+
 // DNSServerList defines a type to represent a slice of DNSServer objects.
 // +deepequal-gen:unordered-array=true
 type DNSServerList []string
@@ -324,6 +442,11 @@ type SystemSpec struct {
 	// PTP defines the Precision Time Protocol configuration for the system.
 	PTP *PTPInfo `json:"ptp,omitempty"`
 
+	// RemoteLogging defines the remote logging (syslog forwarding)
+	// configuration for the system.
+	// +optional
+	RemoteLogging *RemoteLoggingInfo `json:"remoteLogging,omitempty"`
+
 	// Certificates is a list of references to certificates that must be
 	// installed.
 	// +nullable
@@ -438,6 +561,11 @@ type SystemStatus struct {
 	// Strategy monitor retry count for Day 2 operation
 	// +optional
 	StrategyRetryCount int `json:"strategyRetryCount"`
+
+	// Plan defines the system API requests computed while the resource is in
+	// plan mode.  It is only populated while plan mode is enabled.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
 }
 
 func (i *System) GetStrategyRequired() string {
@@ -456,6 +584,14 @@ func (i *System) SetDeploymentScope(scope string) {
 	i.Status.DeploymentScope = scope
 }
 
+func (i *System) GetPlan() *PlanStatus {
+	return i.Status.Plan
+}
+
+func (i *System) SetPlan(plan *PlanStatus) {
+	i.Status.Plan = plan
+}
+
 func (s *System) GetAnnotations() map[string]string {
 	return s.Annotations
 }
@@ -70,6 +70,41 @@ var _ = Describe("RouteInfo", func() {
 	})
 })
 
+var _ = Describe("PCIDeviceInfo", func() {
+	address := "0000:b3:00.0"
+	deviceID := "0d5c"
+
+	Describe("IsKeyEqual", func() {
+		It("should return true when addresses match regardless of case", func() {
+			upper := "0000:B3:00.0"
+			vfCount := 8
+			a := PCIDeviceInfo{Address: &address}
+			b := PCIDeviceInfo{Address: &upper, VFCount: &vfCount}
+			Expect(a.IsKeyEqual(b)).To(BeTrue())
+		})
+
+		It("should return false when one device is selected by address and the other by device ID", func() {
+			a := PCIDeviceInfo{Address: &address}
+			b := PCIDeviceInfo{DeviceID: &deviceID}
+			Expect(a.IsKeyEqual(b)).To(BeFalse())
+		})
+	})
+
+	Describe("Matches", func() {
+		It("should match every device with the same device ID", func() {
+			a := PCIDeviceInfo{DeviceID: &deviceID}
+			Expect(a.Matches("0000:b3:00.0", "0D5C")).To(BeTrue())
+			Expect(a.Matches("0000:b4:00.0", "0d5c")).To(BeTrue())
+			Expect(a.Matches("0000:b3:00.0", "0d5d")).To(BeFalse())
+		})
+
+		It("should not match anything without an address or device ID", func() {
+			a := PCIDeviceInfo{}
+			Expect(a.Matches("0000:b3:00.0", "0d5c")).To(BeFalse())
+		})
+	})
+})
+
 var _ = Describe("HostProfileSpec", func() {
 	Describe("HasWorkerSubFunction", func() {
 		It("should return true when subfunctions include worker", func() {
@@ -117,6 +152,115 @@ var _ = Describe("OSDInfo", func() {
 			Expect(info.GetClusterName()).To(Equal(clusters.CephClusterName))
 		})
 	})
+
+	Describe("IsKeyEqual", func() {
+		It("should compare selected OSDs by their selector", func() {
+			ssd, nvme := "SSD", "NVME"
+			a := OSDInfo{Function: "osd", Selector: &DiskSelector{Type: &ssd}}
+			b := OSDInfo{Function: "osd", Selector: &DiskSelector{Type: &nvme}}
+			c := OSDInfo{Function: "osd", Selector: &DiskSelector{Type: &ssd}}
+			Expect(a.IsKeyEqual(b)).To(BeFalse())
+			Expect(a.IsKeyEqual(c)).To(BeTrue())
+			Expect(a.IsKeyEqual(OSDInfo{Function: "osd"})).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("DiskSelector", func() {
+	Describe("Matches", func() {
+		It("should match when all specified attributes match", func() {
+			diskType, model, minSize, maxSize := "ssd", "Samsung SSD 860", 400, 500
+			a := &DiskSelector{Type: &diskType, Model: &model, MinSize: &minSize, MaxSize: &maxSize}
+			Expect(a.Matches(476, "SSD", "Samsung SSD 860  ", "S3Z9", "")).To(BeTrue())
+			Expect(a.Matches(931, "SSD", "Samsung SSD 860", "S3Z9", "")).To(BeFalse())
+			Expect(a.Matches(476, "HDD", "Samsung SSD 860", "S3Z9", "")).To(BeFalse())
+			Expect(a.Matches(476, "SSD", "Samsung SSD 870", "S3Z9", "")).To(BeFalse())
+		})
+
+		It("should match on the serial number and world wide name", func() {
+			serial, wwn := "S3Z9", "0x5002538E"
+			a := &DiskSelector{Serial: &serial, WWN: &wwn}
+			Expect(a.Matches(476, "SSD", "", "S3Z9", "0x5002538e")).To(BeTrue())
+			Expect(a.Matches(476, "SSD", "", "S3Z8", "0x5002538e")).To(BeFalse())
+			Expect(a.IsEmpty()).To(BeFalse())
+			Expect((&DiskSelector{}).IsEmpty()).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("PortSelector", func() {
+	Describe("Matches", func() {
+		It("should match when all specified attributes match", func() {
+			address, vendorID, driver := "0000:18:00.0", "8086", "i40e"
+			a := &PortSelector{PCIAddress: &address, VendorID: &vendorID, Driver: &driver}
+			Expect(a.Matches("0000:18:00.0", "3c:fd:fe:a0:00:01", "8086", "1583", "i40e", nil)).To(BeTrue())
+			Expect(a.Matches("0000:18:00.1", "3c:fd:fe:a0:00:01", "8086", "1583", "i40e", nil)).To(BeFalse())
+			Expect(a.Matches("0000:18:00.0", "3c:fd:fe:a0:00:01", "15b3", "1583", "i40e", nil)).To(BeFalse())
+			Expect(a.Matches("0000:18:00.0", "3c:fd:fe:a0:00:01", "8086", "1583", "ice", nil)).To(BeFalse())
+		})
+
+		It("should match the MAC expression and NUMA node", func() {
+			mac, numa0, numa1 := "^3C:FD:FE:", 0, 1
+			a := &PortSelector{MAC: &mac, NUMANode: &numa0}
+			Expect(a.Matches("", "3c:fd:fe:a0:00:01", "", "", "", &numa0)).To(BeTrue())
+			Expect(a.Matches("", "3c:fd:fe:a0:00:01", "", "", "", &numa1)).To(BeFalse())
+			Expect(a.Matches("", "3c:fd:fe:a0:00:01", "", "", "", nil)).To(BeFalse())
+			Expect(a.Matches("", "00:3c:fd:fe:00:01", "", "", "", &numa0)).To(BeFalse())
+			Expect(a.IsEmpty()).To(BeFalse())
+			Expect((&PortSelector{}).IsEmpty()).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("EthernetInfo", func() {
+	Describe("IsKeyEqual", func() {
+		It("should compare selected ports by their selector", func() {
+			driverA, driverB := "i40e", "ice"
+			a := EthernetInfo{Port: EthernetPortInfo{Selector: &PortSelector{Driver: &driverA}}}
+			b := EthernetInfo{Port: EthernetPortInfo{Selector: &PortSelector{Driver: &driverB}}}
+			c := EthernetInfo{Port: EthernetPortInfo{Selector: &PortSelector{Driver: &driverA}}}
+			Expect(a.IsKeyEqual(b)).To(BeFalse())
+			Expect(a.IsKeyEqual(c)).To(BeTrue())
+			Expect(a.IsKeyEqual(EthernetInfo{Port: EthernetPortInfo{Name: "ens1f0"}})).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("FileSystemSizePolicy", func() {
+	Describe("Resolve", func() {
+		It("should resolve a percentage of the available space", func() {
+			percent := 50
+			policy := &FileSystemSizePolicy{Percent: &percent}
+			Expect(policy.Resolve(0, 20, 80)).To(Equal(50))
+		})
+
+		It("should resolve all of the available space", func() {
+			maxAvailable := true
+			policy := &FileSystemSizePolicy{MaxAvailable: &maxAvailable}
+			Expect(policy.Resolve(30, 20, 80)).To(Equal(100))
+		})
+
+		It("should apply the bounds", func() {
+			percent, low, high := 50, 60, 40
+			policy := &FileSystemSizePolicy{Percent: &percent, Max: &high}
+			Expect(policy.Resolve(0, 10, 190)).To(Equal(40))
+			policy = &FileSystemSizePolicy{Percent: &percent, Min: &low}
+			Expect(policy.Resolve(0, 10, 90)).To(Equal(60))
+		})
+
+		It("should use the explicit size without a percentage", func() {
+			low := 10
+			policy := &FileSystemSizePolicy{Min: &low}
+			Expect(policy.Resolve(30, 20, 80)).To(Equal(30))
+			Expect(policy.Resolve(0, 5, 80)).To(Equal(10))
+		})
+
+		It("should never shrink the filesystem", func() {
+			percent := 10
+			policy := &FileSystemSizePolicy{Percent: &percent}
+			Expect(policy.Resolve(0, 30, 70)).To(Equal(30))
+		})
+	})
 })
 
 var _ = Describe("SubFunctionFromString", func() {
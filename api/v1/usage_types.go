@@ -0,0 +1,29 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+// ResourceConsumer defines a host interface which uses a network resource
+// such as a data network or an address pool.
+type ResourceConsumer struct {
+	// Host defines the name of the host.  For interfaces that are only
+	// reported by the system this is the system hostname.
+	Host string `json:"host"`
+
+	// Interface defines the name of the host interface.
+	Interface string `json:"interface"`
+
+	// Configured defines whether the interface references the resource in
+	// the host profile or the overrides of the host.
+	// +optional
+	Configured bool `json:"configured,omitempty"`
+
+	// Provisioned defines whether the system reports that the interface
+	// uses the resource.
+	// +optional
+	Provisioned bool `json:"provisioned,omitempty"`
+}
+
+// ResourceConsumerList defines a type to represent a slice of resource
+// consumers.
+type ResourceConsumerList []ResourceConsumer
@@ -8,9 +8,25 @@
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressAllocation) DeepCopyInto(out *AddressAllocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressAllocation.
+func (in *AddressAllocation) DeepCopy() *AddressAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressInfo) DeepCopyInto(out *AddressInfo) {
 	*out = *in
@@ -72,6 +88,25 @@ func (in *AddressPool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in AddressPoolItemList) DeepCopyInto(out *AddressPoolItemList) {
+	{
+		in := &in
+		*out = make(AddressPoolItemList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressPoolItemList.
+func (in AddressPoolItemList) DeepCopy() AddressPoolItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressPoolItemList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressPoolList) DeepCopyInto(out *AddressPoolList) {
 	*out = *in
@@ -104,6 +139,26 @@ func (in *AddressPoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressPoolSnapshot) DeepCopyInto(out *AddressPoolSnapshot) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressPoolSnapshot.
+func (in *AddressPoolSnapshot) DeepCopy() *AddressPoolSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressPoolSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressPoolSpec) DeepCopyInto(out *AddressPoolSpec) {
 	*out = *in
@@ -148,6 +203,31 @@ func (in *AddressPoolStatus) DeepCopyInto(out *AddressPoolStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Allocations != nil {
+		in, out := &in.Allocations, &out.Allocations
+		*out = make([]AddressAllocation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make(ResourceConsumerList, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllocatedAddresses != nil {
+		in, out := &in.AllocatedAddresses, &out.AllocatedAddresses
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FreeAddresses != nil {
+		in, out := &in.FreeAddresses, &out.FreeAddresses
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressPoolStatus.
@@ -265,6 +345,26 @@ func (in *BMPasswordInfo) DeepCopy() *BMPasswordInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BMStatus) DeepCopyInto(out *BMStatus) {
+	*out = *in
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMStatus.
+func (in *BMStatus) DeepCopy() *BMStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BMStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BondInfo) DeepCopyInto(out *BondInfo) {
 	*out = *in
@@ -379,6 +479,11 @@ func (in *CommonInterfaceInfo) DeepCopyInto(out *CommonInterfaceInfo) {
 		*out = make(PtpInterfaceItemList, len(*in))
 		copy(*out, *in)
 	}
+	if in.AddressPools != nil {
+		in, out := &in.AddressPools, &out.AddressPools
+		*out = make(AddressPoolItemList, len(*in))
+		copy(*out, *in)
+	}
 	if in.MaxTxRate != nil {
 		in, out := &in.MaxTxRate, &out.MaxTxRate
 		*out = new(int)
@@ -404,6 +509,11 @@ func (in *CommonInterfaceInfo) DeepCopy() *CommonInterfaceInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerFileSystemInfo) DeepCopyInto(out *ControllerFileSystemInfo) {
 	*out = *in
+	if in.SizePolicy != nil {
+		in, out := &in.SizePolicy, &out.SizePolicy
+		*out = new(FileSystemSizePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerFileSystemInfo.
@@ -421,7 +531,9 @@ func (in ControllerFileSystemList) DeepCopyInto(out *ControllerFileSystemList) {
 	{
 		in := &in
 		*out = make(ControllerFileSystemList, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -585,6 +697,16 @@ func (in *DataNetworkStatus) DeepCopyInto(out *DataNetworkStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make(ResourceConsumerList, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkStatus.
@@ -597,6 +719,51 @@ func (in *DataNetworkStatus) DeepCopy() *DataNetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSelector) DeepCopyInto(out *DiskSelector) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(string)
+		**out = **in
+	}
+	if in.Serial != nil {
+		in, out := &in.Serial, &out.Serial
+		*out = new(string)
+		**out = **in
+	}
+	if in.WWN != nil {
+		in, out := &in.WWN, &out.WWN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSelector.
+func (in *DiskSelector) DeepCopy() *DiskSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ErrMissingSystemResource) DeepCopyInto(out *ErrMissingSystemResource) {
 	*out = *in
@@ -626,7 +793,7 @@ func (in *EthernetInfo) DeepCopyInto(out *EthernetInfo) {
 		*out = new(string)
 		**out = **in
 	}
-	out.Port = in.Port
+	in.Port.DeepCopyInto(&out.Port)
 	if in.OVSAccess != nil {
 		in, out := &in.OVSAccess, &out.OVSAccess
 		*out = new(bool)
@@ -668,6 +835,11 @@ func (in EthernetList) DeepCopy() EthernetList {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EthernetPortInfo) DeepCopyInto(out *EthernetPortInfo) {
 	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(PortSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthernetPortInfo.
@@ -683,6 +855,11 @@ func (in *EthernetPortInfo) DeepCopy() *EthernetPortInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileSystemInfo) DeepCopyInto(out *FileSystemInfo) {
 	*out = *in
+	if in.SizePolicy != nil {
+		in, out := &in.SizePolicy, &out.SizePolicy
+		*out = new(FileSystemSizePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemInfo.
@@ -700,7 +877,9 @@ func (in FileSystemList) DeepCopyInto(out *FileSystemList) {
 	{
 		in := &in
 		*out = make(FileSystemList, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -714,6 +893,56 @@ func (in FileSystemList) DeepCopy() FileSystemList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSystemSizePolicy) DeepCopyInto(out *FileSystemSizePolicy) {
+	*out = *in
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxAvailable != nil {
+		in, out := &in.MaxAvailable, &out.MaxAvailable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemSizePolicy.
+func (in *FileSystemSizePolicy) DeepCopy() *FileSystemSizePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSystemSizePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmOverrideInfo) DeepCopyInto(out *HelmOverrideInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmOverrideInfo.
+func (in *HelmOverrideInfo) DeepCopy() *HelmOverrideInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmOverrideInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Host) DeepCopyInto(out *Host) {
 	*out = *in
@@ -741,6 +970,53 @@ func (in *Host) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostActionInfo) DeepCopyInto(out *HostActionInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostActionInfo.
+func (in *HostActionInfo) DeepCopy() *HostActionInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(HostActionInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostActionStatus) DeepCopyInto(out *HostActionStatus) {
+	*out = *in
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SentTime != nil {
+		in, out := &in.SentTime, &out.SentTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostActionStatus.
+func (in *HostActionStatus) DeepCopy() *HostActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostList) DeepCopyInto(out *HostList) {
 	*out = *in
@@ -774,25 +1050,46 @@ func (in *HostList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostProfile) DeepCopyInto(out *HostProfile) {
+func (in *HostMaintenanceInfo) DeepCopyInto(out *HostMaintenanceInfo) {
+	*out = *in
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostMaintenanceInfo.
+func (in *HostMaintenanceInfo) DeepCopy() *HostMaintenanceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(HostMaintenanceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPool) DeepCopyInto(out *HostPool) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfile.
-func (in *HostProfile) DeepCopy() *HostProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPool.
+func (in *HostPool) DeepCopy() *HostPool {
 	if in == nil {
 		return nil
 	}
-	out := new(HostProfile)
+	out := new(HostPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HostProfile) DeepCopyObject() runtime.Object {
+func (in *HostPool) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -800,31 +1097,31 @@ func (in *HostProfile) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostProfileList) DeepCopyInto(out *HostProfileList) {
+func (in *HostPoolList) DeepCopyInto(out *HostPoolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]HostProfile, len(*in))
+		*out = make([]HostPool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileList.
-func (in *HostProfileList) DeepCopy() *HostProfileList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPoolList.
+func (in *HostPoolList) DeepCopy() *HostPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(HostProfileList)
+	out := new(HostPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HostProfileList) DeepCopyObject() runtime.Object {
+func (in *HostPoolList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -832,69 +1129,246 @@ func (in *HostProfileList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostProfileSpec) DeepCopyInto(out *HostProfileSpec) {
+func (in *HostPoolMember) DeepCopyInto(out *HostPoolMember) {
 	*out = *in
-	if in.Base != nil {
-		in, out := &in.Base, &out.Base
-		*out = new(string)
-		**out = **in
-	}
-	in.ProfileBaseAttributes.DeepCopyInto(&out.ProfileBaseAttributes)
-	if in.BoardManagement != nil {
-		in, out := &in.BoardManagement, &out.BoardManagement
-		*out = new(BMInfo)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Processors != nil {
-		in, out := &in.Processors, &out.Processors
-		*out = make(ProcessorNodeList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
-		*out = make(MemoryNodeList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(ProfileStorageInfo)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
-		*out = new(InterfaceInfo)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make(AddressList, len(*in))
-		copy(*out, *in)
-	}
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = make(RouteList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileSpec.
-func (in *HostProfileSpec) DeepCopy() *HostProfileSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPoolMember.
+func (in *HostPoolMember) DeepCopy() *HostPoolMember {
 	if in == nil {
 		return nil
 	}
-	out := new(HostProfileSpec)
+	out := new(HostPoolMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostSpec) DeepCopyInto(out *HostSpec) {
+func (in *HostPoolSelector) DeepCopyInto(out *HostPoolSelector) {
+	*out = *in
+	if in.BootMACPrefixes != nil {
+		in, out := &in.BootMACPrefixes, &out.BootMACPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SerialNumber != nil {
+		in, out := &in.SerialNumber, &out.SerialNumber
+		*out = new(string)
+		**out = **in
+	}
+	if in.AssetTag != nil {
+		in, out := &in.AssetTag, &out.AssetTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.BoardManagementType != nil {
+		in, out := &in.BoardManagementType, &out.BoardManagementType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPoolSelector.
+func (in *HostPoolSelector) DeepCopy() *HostPoolSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPoolSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPoolSpec) DeepCopyInto(out *HostPoolSpec) {
+	*out = *in
+	in.Hostnames.DeepCopyInto(&out.Hostnames)
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.MaxHosts != nil {
+		in, out := &in.MaxHosts, &out.MaxHosts
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPoolSpec.
+func (in *HostPoolSpec) DeepCopy() *HostPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPoolStatus) DeepCopyInto(out *HostPoolStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]HostPoolMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPoolStatus.
+func (in *HostPoolStatus) DeepCopy() *HostPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProfile) DeepCopyInto(out *HostProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfile.
+func (in *HostProfile) DeepCopy() *HostProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(HostProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProfileList) DeepCopyInto(out *HostProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HostProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileList.
+func (in *HostProfileList) DeepCopy() *HostProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(HostProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProfileSpec) DeepCopyInto(out *HostProfileSpec) {
+	*out = *in
+	if in.Base != nil {
+		in, out := &in.Base, &out.Base
+		*out = new(string)
+		**out = **in
+	}
+	in.ProfileBaseAttributes.DeepCopyInto(&out.ProfileBaseAttributes)
+	if in.BoardManagement != nil {
+		in, out := &in.BoardManagement, &out.BoardManagement
+		*out = new(BMInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Processors != nil {
+		in, out := &in.Processors, &out.Processors
+		*out = make(ProcessorNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = make(MemoryNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(ProfileStorageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = new(InterfaceInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make(AddressList, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make(RouteList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make(PCIDeviceList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileSpec.
+func (in *HostProfileSpec) DeepCopy() *HostProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HostProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPtpStatus) DeepCopyInto(out *HostPtpStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPtpStatus.
+func (in *HostPtpStatus) DeepCopy() *HostPtpStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPtpStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostSpec) DeepCopyInto(out *HostSpec) {
 	*out = *in
 	if in.Match != nil {
 		in, out := &in.Match, &out.Match
@@ -906,6 +1380,16 @@ func (in *HostSpec) DeepCopyInto(out *HostSpec) {
 		*out = new(HostProfileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(HostActionInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(HostMaintenanceInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostSpec.
@@ -946,6 +1430,40 @@ func (in *HostStatus) DeepCopyInto(out *HostStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Processors != nil {
+		in, out := &in.Processors, &out.Processors
+		*out = make(ProcessorNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = make(MemoryNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(HostActionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BoardManagement != nil {
+		in, out := &in.BoardManagement, &out.BoardManagement
+		*out = new(BMStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ptp != nil {
+		in, out := &in.Ptp, &out.Ptp
+		*out = make([]HostPtpStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostStatus.
@@ -958,6 +1476,26 @@ func (in *HostStatus) DeepCopy() *HostStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameAllocationInfo) DeepCopyInto(out *HostnameAllocationInfo) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameAllocationInfo.
+func (in *HostnameAllocationInfo) DeepCopy() *HostnameAllocationInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameAllocationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceInfo) DeepCopyInto(out *InterfaceInfo) {
 	*out = *in
@@ -1017,36 +1555,96 @@ func (in *JournalInfo) DeepCopy() *JournalInfo {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LicenseInfo) DeepCopyInto(out *LicenseInfo) {
+func (in *KeystoneProjectInfo) DeepCopyInto(out *KeystoneProjectInfo) {
 	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseInfo.
-func (in *LicenseInfo) DeepCopy() *LicenseInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeystoneProjectInfo.
+func (in *KeystoneProjectInfo) DeepCopy() *KeystoneProjectInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(LicenseInfo)
+	out := new(KeystoneProjectInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MatchBMInfo) DeepCopyInto(out *MatchBMInfo) {
+func (in *KeystoneUserInfo) DeepCopyInto(out *KeystoneUserInfo) {
 	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
 		*out = new(string)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchBMInfo.
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeystoneUserInfo.
+func (in *KeystoneUserInfo) DeepCopy() *KeystoneUserInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(KeystoneUserInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseInfo) DeepCopyInto(out *LicenseInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseInfo.
+func (in *LicenseInfo) DeepCopy() *LicenseInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchBMInfo) DeepCopyInto(out *MatchBMInfo) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchBMInfo.
 func (in *MatchBMInfo) DeepCopy() *MatchBMInfo {
 	if in == nil {
 		return nil
@@ -1111,9 +1709,39 @@ func (in *MatchInfo) DeepCopy() *MatchInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryAllocationPolicy) DeepCopyInto(out *MemoryAllocationPolicy) {
+	*out = *in
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int)
+		**out = **in
+	}
+	if in.Remaining != nil {
+		in, out := &in.Remaining, &out.Remaining
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryAllocationPolicy.
+func (in *MemoryAllocationPolicy) DeepCopy() *MemoryAllocationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryAllocationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryFunctionInfo) DeepCopyInto(out *MemoryFunctionInfo) {
 	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(MemoryAllocationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryFunctionInfo.
@@ -1131,7 +1759,9 @@ func (in MemoryFunctionList) DeepCopyInto(out *MemoryFunctionList) {
 	{
 		in := &in
 		*out = make(MemoryFunctionList, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -1151,7 +1781,9 @@ func (in *MemoryNodeInfo) DeepCopyInto(out *MemoryNodeInfo) {
 	if in.Functions != nil {
 		in, out := &in.Functions, &out.Functions
 		*out = make(MemoryFunctionList, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -1225,14 +1857,147 @@ func (in NTPServerList) DeepCopy() NTPServerList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkReconfiguration) DeepCopyInto(out *NetworkReconfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkReconfiguration.
+func (in *NetworkReconfiguration) DeepCopy() *NetworkReconfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkReconfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkReconfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkReconfigurationList) DeepCopyInto(out *NetworkReconfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkReconfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkReconfigurationList.
+func (in *NetworkReconfigurationList) DeepCopy() *NetworkReconfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkReconfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkReconfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkReconfigurationSpec) DeepCopyInto(out *NetworkReconfigurationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkReconfigurationSpec.
+func (in *NetworkReconfigurationSpec) DeepCopy() *NetworkReconfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkReconfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkReconfigurationStatus) DeepCopyInto(out *NetworkReconfigurationStatus) {
+	*out = *in
+	if in.Phase != nil {
+		in, out := &in.Phase, &out.Phase
+		*out = new(string)
+		**out = **in
+	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]ReconfigurationCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ReconfigurationStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CurrentStep != nil {
+		in, out := &in.CurrentStep, &out.CurrentStep
+		*out = new(string)
+		**out = **in
+	}
+	if in.Previous != nil {
+		in, out := &in.Previous, &out.Previous
+		*out = make([]AddressPoolSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkReconfigurationStatus.
+func (in *NetworkReconfigurationStatus) DeepCopy() *NetworkReconfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkReconfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OSDInfo) DeepCopyInto(out *OSDInfo) {
 	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(DiskSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClusterName != nil {
 		in, out := &in.ClusterName, &out.ClusterName
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tier != nil {
+		in, out := &in.Tier, &out.Tier
+		*out = new(string)
+		**out = **in
+	}
 	if in.Journal != nil {
 		in, out := &in.Journal, &out.Journal
 		*out = new(JournalInfo)
@@ -1271,6 +2036,72 @@ func (in OSDList) DeepCopy() OSDList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PCIDeviceInfo) DeepCopyInto(out *PCIDeviceInfo) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(string)
+		**out = **in
+	}
+	if in.VFCount != nil {
+		in, out := &in.VFCount, &out.VFCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.VFDriver != nil {
+		in, out := &in.VFDriver, &out.VFDriver
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PCIDeviceInfo.
+func (in *PCIDeviceInfo) DeepCopy() *PCIDeviceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PCIDeviceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PCIDeviceList) DeepCopyInto(out *PCIDeviceList) {
+	{
+		in := &in
+		*out = make(PCIDeviceList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PCIDeviceList.
+func (in PCIDeviceList) DeepCopy() PCIDeviceList {
+	if in == nil {
+		return nil
+	}
+	out := new(PCIDeviceList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PTPInfo) DeepCopyInto(out *PTPInfo) {
 	*out = *in
@@ -1304,6 +2135,11 @@ func (in *PTPInfo) DeepCopy() *PTPInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PhysicalVolumeInfo) DeepCopyInto(out *PhysicalVolumeInfo) {
 	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(DiskSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Size != nil {
 		in, out := &in.Size, &out.Size
 		*out = new(int)
@@ -1343,77 +2179,100 @@ func (in PhysicalVolumeList) DeepCopy() PhysicalVolumeList {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformNetwork) DeepCopyInto(out *PlatformNetwork) {
+func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]PlannedOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetwork.
-func (in *PlatformNetwork) DeepCopy() *PlatformNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
+func (in *PlanStatus) DeepCopy() *PlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetwork)
+	out := new(PlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PlatformNetwork) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PlatformNetworkItemList) DeepCopyInto(out *PlatformNetworkItemList) {
-	{
-		in := &in
-		*out = make(PlatformNetworkItemList, len(*in))
-		copy(*out, *in)
+func (in *PlannedOperation) DeepCopyInto(out *PlannedOperation) {
+	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkItemList.
-func (in PlatformNetworkItemList) DeepCopy() PlatformNetworkItemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedOperation.
+func (in *PlannedOperation) DeepCopy() *PlannedOperation {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetworkItemList)
+	out := new(PlannedOperation)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformNetworkList) DeepCopyInto(out *PlatformNetworkList) {
+func (in *PlatformApplication) DeepCopyInto(out *PlatformApplication) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformApplication.
+func (in *PlatformApplication) DeepCopy() *PlatformApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformApplicationList) DeepCopyInto(out *PlatformApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PlatformNetwork, len(*in))
+		*out = make([]PlatformApplication, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkList.
-func (in *PlatformNetworkList) DeepCopy() *PlatformNetworkList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformApplicationList.
+func (in *PlatformApplicationList) DeepCopy() *PlatformApplicationList {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetworkList)
+	out := new(PlatformApplicationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PlatformNetworkList) DeepCopyObject() runtime.Object {
+func (in *PlatformApplicationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1421,467 +2280,1404 @@ func (in *PlatformNetworkList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformNetworkSpec) DeepCopyInto(out *PlatformNetworkSpec) {
+func (in *PlatformApplicationSpec) DeepCopyInto(out *PlatformApplicationSpec) {
 	*out = *in
-	if in.AssociatedAddressPools != nil {
-		in, out := &in.AssociatedAddressPools, &out.AssociatedAddressPools
-		*out = make([]string, len(*in))
+	if in.Tarball != nil {
+		in, out := &in.Tarball, &out.Tarball
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]HelmOverrideInfo, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkSpec.
-func (in *PlatformNetworkSpec) DeepCopy() *PlatformNetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformApplicationSpec.
+func (in *PlatformApplicationSpec) DeepCopy() *PlatformApplicationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetworkSpec)
+	out := new(PlatformApplicationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlatformNetworkStatus) DeepCopyInto(out *PlatformNetworkStatus) {
+func (in *PlatformApplicationStatus) DeepCopyInto(out *PlatformApplicationStatus) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkStatus.
-func (in *PlatformNetworkStatus) DeepCopy() *PlatformNetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformApplicationStatus.
+func (in *PlatformApplicationStatus) DeepCopy() *PlatformApplicationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PlatformNetworkStatus)
+	out := new(PlatformApplicationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessorFunctionInfo) DeepCopyInto(out *ProcessorFunctionInfo) {
+func (in *PlatformNetwork) DeepCopyInto(out *PlatformNetwork) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFunctionInfo.
-func (in *ProcessorFunctionInfo) DeepCopy() *ProcessorFunctionInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetwork.
+func (in *PlatformNetwork) DeepCopy() *PlatformNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorFunctionInfo)
+	out := new(PlatformNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ProcessorFunctionList) DeepCopyInto(out *ProcessorFunctionList) {
+func (in PlatformNetworkItemList) DeepCopyInto(out *PlatformNetworkItemList) {
 	{
 		in := &in
-		*out = make(ProcessorFunctionList, len(*in))
+		*out = make(PlatformNetworkItemList, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFunctionList.
-func (in ProcessorFunctionList) DeepCopy() ProcessorFunctionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkItemList.
+func (in PlatformNetworkItemList) DeepCopy() PlatformNetworkItemList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorFunctionList)
+	out := new(PlatformNetworkItemList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessorInfo) DeepCopyInto(out *ProcessorInfo) {
+func (in *PlatformNetworkList) DeepCopyInto(out *PlatformNetworkList) {
 	*out = *in
-	if in.Functions != nil {
-		in, out := &in.Functions, &out.Functions
-		*out = make(ProcessorFunctionList, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlatformNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorInfo.
-func (in *ProcessorInfo) DeepCopy() *ProcessorInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkList.
+func (in *PlatformNetworkList) DeepCopy() *PlatformNetworkList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorInfo)
+	out := new(PlatformNetworkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ProcessorNodeList) DeepCopyInto(out *ProcessorNodeList) {
-	{
-		in := &in
-		*out = make(ProcessorNodeList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+func (in *PlatformNetworkSpec) DeepCopyInto(out *PlatformNetworkSpec) {
+	*out = *in
+	if in.AssociatedAddressPools != nil {
+		in, out := &in.AssociatedAddressPools, &out.AssociatedAddressPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorNodeList.
-func (in ProcessorNodeList) DeepCopy() ProcessorNodeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkSpec.
+func (in *PlatformNetworkSpec) DeepCopy() *PlatformNetworkSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorNodeList)
+	out := new(PlatformNetworkSpec)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProfileBaseAttributes) DeepCopyInto(out *ProfileBaseAttributes) {
+func (in *PlatformNetworkStatus) DeepCopyInto(out *PlatformNetworkStatus) {
 	*out = *in
-	if in.Personality != nil {
-		in, out := &in.Personality, &out.Personality
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdministrativeState != nil {
-		in, out := &in.AdministrativeState, &out.AdministrativeState
-		*out = new(string)
-		**out = **in
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.SubFunctions != nil {
-		in, out := &in.SubFunctions, &out.SubFunctions
-		*out = make([]SubFunction, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkStatus.
+func (in *PlatformNetworkStatus) DeepCopy() *PlatformNetworkStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Location != nil {
-		in, out := &in.Location, &out.Location
-		*out = new(string)
-		**out = **in
+	out := new(PlatformNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformUpgrade) DeepCopyInto(out *PlatformUpgrade) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformUpgrade.
+func (in *PlatformUpgrade) DeepCopy() *PlatformUpgrade {
+	if in == nil {
+		return nil
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out := new(PlatformUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformUpgrade) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformUpgradeList) DeepCopyInto(out *PlatformUpgradeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlatformUpgrade, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.InstallOutput != nil {
-		in, out := &in.InstallOutput, &out.InstallOutput
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformUpgradeList.
+func (in *PlatformUpgradeList) DeepCopy() *PlatformUpgradeList {
+	if in == nil {
+		return nil
 	}
-	if in.Console != nil {
-		in, out := &in.Console, &out.Console
+	out := new(PlatformUpgradeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformUpgradeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformUpgradeSpec) DeepCopyInto(out *PlatformUpgradeSpec) {
+	*out = *in
+	if in.Release != nil {
+		in, out := &in.Release, &out.Release
 		*out = new(string)
 		**out = **in
 	}
-	if in.BootDevice != nil {
-		in, out := &in.BootDevice, &out.BootDevice
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.PowerOn != nil {
-		in, out := &in.PowerOn, &out.PowerOn
-		*out = new(bool)
-		**out = **in
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(UpgradeStrategyInfo)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ProvisioningMode != nil {
-		in, out := &in.ProvisioningMode, &out.ProvisioningMode
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformUpgradeSpec.
+func (in *PlatformUpgradeSpec) DeepCopy() *PlatformUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformUpgradeStatus) DeepCopyInto(out *PlatformUpgradeStatus) {
+	*out = *in
+	if in.Phase != nil {
+		in, out := &in.Phase, &out.Phase
 		*out = new(string)
 		**out = **in
 	}
-	if in.BootMAC != nil {
-		in, out := &in.BootMAC, &out.BootMAC
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
 		*out = new(string)
 		**out = **in
 	}
-	if in.PtpInstances != nil {
-		in, out := &in.PtpInstances, &out.PtpInstances
-		*out = make(PtpInstanceItemList, len(*in))
-		copy(*out, *in)
+	if in.StrategyType != nil {
+		in, out := &in.StrategyType, &out.StrategyType
+		*out = new(string)
+		**out = **in
 	}
-	if in.RootDevice != nil {
-		in, out := &in.RootDevice, &out.RootDevice
+	if in.StrategyState != nil {
+		in, out := &in.StrategyState, &out.StrategyState
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClockSynchronization != nil {
-		in, out := &in.ClockSynchronization, &out.ClockSynchronization
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
 		*out = new(string)
 		**out = **in
 	}
-	if in.MaxCPUMhzConfigured != nil {
-		in, out := &in.MaxCPUMhzConfigured, &out.MaxCPUMhzConfigured
-		*out = new(string)
-		**out = **in
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AppArmor != nil {
-		in, out := &in.AppArmor, &out.AppArmor
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformUpgradeStatus.
+func (in *PlatformUpgradeStatus) DeepCopy() *PlatformUpgradeStatus {
+	if in == nil {
+		return nil
 	}
-	if in.HwSettle != nil {
-		in, out := &in.HwSettle, &out.HwSettle
-		*out = new(string)
-		**out = **in
+	out := new(PlatformUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformUsers) DeepCopyInto(out *PlatformUsers) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformUsers.
+func (in *PlatformUsers) DeepCopy() *PlatformUsers {
+	if in == nil {
+		return nil
 	}
-	if in.Kernel != nil {
-		in, out := &in.Kernel, &out.Kernel
-		*out = new(string)
-		**out = **in
+	out := new(PlatformUsers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformUsers) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileBaseAttributes.
-func (in *ProfileBaseAttributes) DeepCopy() *ProfileBaseAttributes {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformUsersList) DeepCopyInto(out *PlatformUsersList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlatformUsers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformUsersList.
+func (in *PlatformUsersList) DeepCopy() *PlatformUsersList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProfileBaseAttributes)
+	out := new(PlatformUsersList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlatformUsersList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProfileStorageInfo) DeepCopyInto(out *ProfileStorageInfo) {
+func (in *PlatformUsersSpec) DeepCopyInto(out *PlatformUsersSpec) {
 	*out = *in
-	if in.Monitor != nil {
-		in, out := &in.Monitor, &out.Monitor
-		*out = new(MonitorInfo)
+	if in.Sysadmin != nil {
+		in, out := &in.Sysadmin, &out.Sysadmin
+		*out = new(SysadminInfo)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OSDs != nil {
-		in, out := &in.OSDs, &out.OSDs
-		*out = make(OSDList, len(*in))
+	if in.SecurityCompliance != nil {
+		in, out := &in.SecurityCompliance, &out.SecurityCompliance
+		*out = new(SecurityComplianceInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]KeystoneProjectInfo, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.VolumeGroups != nil {
-		in, out := &in.VolumeGroups, &out.VolumeGroups
-		*out = make(VolumeGroupList, len(*in))
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]KeystoneUserInfo, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.FileSystems != nil {
-		in, out := &in.FileSystems, &out.FileSystems
-		*out = make(FileSystemList, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformUsersSpec.
+func (in *PlatformUsersSpec) DeepCopy() *PlatformUsersSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(PlatformUsersSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileStorageInfo.
-func (in *ProfileStorageInfo) DeepCopy() *ProfileStorageInfo {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformUsersStatus) DeepCopyInto(out *PlatformUsersStatus) {
+	*out = *in
+	if in.PasswordVersions != nil {
+		in, out := &in.PasswordVersions, &out.PasswordVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformUsersStatus.
+func (in *PlatformUsersStatus) DeepCopy() *PlatformUsersStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProfileStorageInfo)
+	out := new(PlatformUsersStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInstance) DeepCopyInto(out *PtpInstance) {
+func (in *PortSelector) DeepCopyInto(out *PortSelector) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.PCIAddress != nil {
+		in, out := &in.PCIAddress, &out.PCIAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.MAC != nil {
+		in, out := &in.MAC, &out.MAC
+		*out = new(string)
+		**out = **in
+	}
+	if in.VendorID != nil {
+		in, out := &in.VendorID, &out.VendorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceID != nil {
+		in, out := &in.DeviceID, &out.DeviceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(string)
+		**out = **in
+	}
+	if in.NUMANode != nil {
+		in, out := &in.NUMANode, &out.NUMANode
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstance.
-func (in *PtpInstance) DeepCopy() *PtpInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSelector.
+func (in *PortSelector) DeepCopy() *PortSelector {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstance)
+	out := new(PortSelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PtpInstance) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessorAllocationPolicy) DeepCopyInto(out *ProcessorAllocationPolicy) {
+	*out = *in
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int)
+		**out = **in
 	}
-	return nil
+	if in.Remaining != nil {
+		in, out := &in.Remaining, &out.Remaining
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PerSocketLessPlatform != nil {
+		in, out := &in.PerSocketLessPlatform, &out.PerSocketLessPlatform
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorAllocationPolicy.
+func (in *ProcessorAllocationPolicy) DeepCopy() *ProcessorAllocationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessorAllocationPolicy)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PtpInstanceItemList) DeepCopyInto(out *PtpInstanceItemList) {
+func (in *ProcessorFunctionInfo) DeepCopyInto(out *ProcessorFunctionInfo) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(ProcessorAllocationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFunctionInfo.
+func (in *ProcessorFunctionInfo) DeepCopy() *ProcessorFunctionInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessorFunctionInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ProcessorFunctionList) DeepCopyInto(out *ProcessorFunctionList) {
 	{
 		in := &in
-		*out = make(PtpInstanceItemList, len(*in))
-		copy(*out, *in)
+		*out = make(ProcessorFunctionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceItemList.
-func (in PtpInstanceItemList) DeepCopy() PtpInstanceItemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFunctionList.
+func (in ProcessorFunctionList) DeepCopy() ProcessorFunctionList {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstanceItemList)
+	out := new(ProcessorFunctionList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInstanceList) DeepCopyInto(out *PtpInstanceList) {
+func (in *ProcessorInfo) DeepCopyInto(out *ProcessorInfo) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PtpInstance, len(*in))
+	if in.Functions != nil {
+		in, out := &in.Functions, &out.Functions
+		*out = make(ProcessorFunctionList, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceList.
-func (in *PtpInstanceList) DeepCopy() *PtpInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorInfo.
+func (in *ProcessorInfo) DeepCopy() *ProcessorInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstanceList)
+	out := new(ProcessorInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PtpInstanceList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInstanceSpec) DeepCopyInto(out *PtpInstanceSpec) {
-	*out = *in
-	if in.InstanceParameters != nil {
-		in, out := &in.InstanceParameters, &out.InstanceParameters
-		*out = make(map[string][]string, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
+func (in ProcessorNodeList) DeepCopyInto(out *ProcessorNodeList) {
+	{
+		in := &in
+		*out = make(ProcessorNodeList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceSpec.
-func (in *PtpInstanceSpec) DeepCopy() *PtpInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorNodeList.
+func (in ProcessorNodeList) DeepCopy() ProcessorNodeList {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstanceSpec)
+	out := new(ProcessorNodeList)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInstanceStatus) DeepCopyInto(out *PtpInstanceStatus) {
+func (in *ProfileBaseAttributes) DeepCopyInto(out *ProfileBaseAttributes) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Personality != nil {
+		in, out := &in.Personality, &out.Personality
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdministrativeState != nil {
+		in, out := &in.AdministrativeState, &out.AdministrativeState
 		*out = new(string)
 		**out = **in
 	}
+	if in.SubFunctions != nil {
+		in, out := &in.SubFunctions, &out.SubFunctions
+		*out = make([]SubFunction, len(*in))
+		copy(*out, *in)
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InstallOutput != nil {
+		in, out := &in.InstallOutput, &out.InstallOutput
+		*out = new(string)
+		**out = **in
+	}
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootDevice != nil {
+		in, out := &in.BootDevice, &out.BootDevice
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootDeviceSelector != nil {
+		in, out := &in.BootDeviceSelector, &out.BootDeviceSelector
+		*out = new(DiskSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PowerOn != nil {
+		in, out := &in.PowerOn, &out.PowerOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProvisioningMode != nil {
+		in, out := &in.ProvisioningMode, &out.ProvisioningMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootMAC != nil {
+		in, out := &in.BootMAC, &out.BootMAC
+		*out = new(string)
+		**out = **in
+	}
+	if in.PtpInstances != nil {
+		in, out := &in.PtpInstances, &out.PtpInstances
+		*out = make(PtpInstanceItemList, len(*in))
+		copy(*out, *in)
+	}
+	if in.RootDevice != nil {
+		in, out := &in.RootDevice, &out.RootDevice
+		*out = new(string)
+		**out = **in
+	}
+	if in.RootDeviceSelector != nil {
+		in, out := &in.RootDeviceSelector, &out.RootDeviceSelector
+		*out = new(DiskSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClockSynchronization != nil {
+		in, out := &in.ClockSynchronization, &out.ClockSynchronization
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxCPUMhzConfigured != nil {
+		in, out := &in.MaxCPUMhzConfigured, &out.MaxCPUMhzConfigured
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppArmor != nil {
+		in, out := &in.AppArmor, &out.AppArmor
+		*out = new(string)
+		**out = **in
+	}
+	if in.HwSettle != nil {
+		in, out := &in.HwSettle, &out.HwSettle
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kernel != nil {
+		in, out := &in.Kernel, &out.Kernel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileBaseAttributes.
+func (in *ProfileBaseAttributes) DeepCopy() *ProfileBaseAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileBaseAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileStorageInfo) DeepCopyInto(out *ProfileStorageInfo) {
+	*out = *in
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(MonitorInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OSDs != nil {
+		in, out := &in.OSDs, &out.OSDs
+		*out = make(OSDList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeGroups != nil {
+		in, out := &in.VolumeGroups, &out.VolumeGroups
+		*out = make(VolumeGroupList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FileSystems != nil {
+		in, out := &in.FileSystems, &out.FileSystems
+		*out = make(FileSystemList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileStorageInfo.
+func (in *ProfileStorageInfo) DeepCopy() *ProfileStorageInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileStorageInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpHostStatus) DeepCopyInto(out *PtpHostStatus) {
+	*out = *in
+	if in.ClockClass != nil {
+		in, out := &in.ClockClass, &out.ClockClass
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpHostStatus.
+func (in *PtpHostStatus) DeepCopy() *PtpHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInstance) DeepCopyInto(out *PtpInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstance.
+func (in *PtpInstance) DeepCopy() *PtpInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PtpInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PtpInstanceItemList) DeepCopyInto(out *PtpInstanceItemList) {
+	{
+		in := &in
+		*out = make(PtpInstanceItemList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceItemList.
+func (in PtpInstanceItemList) DeepCopy() PtpInstanceItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstanceItemList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInstanceList) DeepCopyInto(out *PtpInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PtpInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceList.
+func (in *PtpInstanceList) DeepCopy() *PtpInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PtpInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInstanceSpec) DeepCopyInto(out *PtpInstanceSpec) {
+	*out = *in
+	if in.InstanceParameters != nil {
+		in, out := &in.InstanceParameters, &out.InstanceParameters
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceSpec.
+func (in *PtpInstanceSpec) DeepCopy() *PtpInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInstanceStatus) DeepCopyInto(out *PtpInstanceStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]PtpHostStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceStatus.
+func (in *PtpInstanceStatus) DeepCopy() *PtpInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInterface) DeepCopyInto(out *PtpInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterface.
+func (in *PtpInterface) DeepCopy() *PtpInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PtpInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PtpInterfaceItemList) DeepCopyInto(out *PtpInterfaceItemList) {
+	{
+		in := &in
+		*out = make(PtpInterfaceItemList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceItemList.
+func (in PtpInterfaceItemList) DeepCopy() PtpInterfaceItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterfaceItemList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInterfaceList) DeepCopyInto(out *PtpInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PtpInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceList.
+func (in *PtpInterfaceList) DeepCopy() *PtpInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PtpInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInterfaceSpec) DeepCopyInto(out *PtpInterfaceSpec) {
+	*out = *in
+	if in.InterfaceParameters != nil {
+		in, out := &in.InterfaceParameters, &out.InterfaceParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceSpec.
+func (in *PtpInterfaceSpec) DeepCopy() *PtpInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PtpInterfaceStatus) DeepCopyInto(out *PtpInterfaceStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceStatus.
+func (in *PtpInterfaceStatus) DeepCopy() *PtpInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PtpInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconfigurationCheck) DeepCopyInto(out *ReconfigurationCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconfigurationCheck.
+func (in *ReconfigurationCheck) DeepCopy() *ReconfigurationCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconfigurationCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconfigurationStep) DeepCopyInto(out *ReconfigurationStep) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconfigurationStep.
+func (in *ReconfigurationStep) DeepCopy() *ReconfigurationStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconfigurationStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteLoggingInfo) DeepCopyInto(out *RemoteLoggingInfo) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(string)
+		**out = **in
+	}
+	if in.Transport != nil {
+		in, out := &in.Transport, &out.Transport
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteLoggingInfo.
+func (in *RemoteLoggingInfo) DeepCopy() *RemoteLoggingInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteLoggingInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceConsumer) DeepCopyInto(out *ResourceConsumer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceConsumer.
+func (in *ResourceConsumer) DeepCopy() *ResourceConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ResourceConsumerList) DeepCopyInto(out *ResourceConsumerList) {
+	{
+		in := &in
+		*out = make(ResourceConsumerList, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceConsumerList.
+func (in ResourceConsumerList) DeepCopy() ResourceConsumerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceConsumerList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteInfo) DeepCopyInto(out *RouteInfo) {
+	*out = *in
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteInfo.
+func (in *RouteInfo) DeepCopy() *RouteInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in RouteList) DeepCopyInto(out *RouteList) {
+	{
+		in := &in
+		*out = make(RouteList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in RouteList) DeepCopy() RouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityComplianceInfo) DeepCopyInto(out *SecurityComplianceInfo) {
+	*out = *in
+	if in.LockoutSeconds != nil {
+		in, out := &in.LockoutSeconds, &out.LockoutSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.LockoutRetries != nil {
+		in, out := &in.LockoutRetries, &out.LockoutRetries
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityComplianceInfo.
+func (in *SecurityComplianceInfo) DeepCopy() *SecurityComplianceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityComplianceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceParameterInfo) DeepCopyInto(out *ServiceParameterInfo) {
+	*out = *in
+	if in.Personality != nil {
+		in, out := &in.Personality, &out.Personality
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceParameterInfo.
+func (in *ServiceParameterInfo) DeepCopy() *ServiceParameterInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceParameterInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ServiceParameterList) DeepCopyInto(out *ServiceParameterList) {
+	{
+		in := &in
+		*out = make(ServiceParameterList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceParameterList.
+func (in ServiceParameterList) DeepCopy() ServiceParameterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceParameterList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageBackend) DeepCopyInto(out *StorageBackend) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int)
+		**out = **in
+	}
+	if in.PartitionSize != nil {
+		in, out := &in.PartitionSize, &out.PartitionSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackend.
+func (in *StorageBackend) DeepCopy() *StorageBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in StorageBackendList) DeepCopyInto(out *StorageBackendList) {
+	{
+		in := &in
+		*out = make(StorageBackendList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceStatus.
-func (in *PtpInstanceStatus) DeepCopy() *PtpInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackendList.
+func (in StorageBackendList) DeepCopy() StorageBackendList {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInstanceStatus)
+	out := new(StorageBackendList)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInterface) DeepCopyInto(out *PtpInterface) {
+func (in *StorageTierInfo) DeepCopyInto(out *StorageTierInfo) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.ClusterName != nil {
+		in, out := &in.ClusterName, &out.ClusterName
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterface.
-func (in *PtpInterface) DeepCopy() *PtpInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageTierInfo.
+func (in *StorageTierInfo) DeepCopy() *StorageTierInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterface)
+	out := new(StorageTierInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PtpInterface) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PtpInterfaceItemList) DeepCopyInto(out *PtpInterfaceItemList) {
+func (in StorageTierList) DeepCopyInto(out *StorageTierList) {
 	{
 		in := &in
-		*out = make(PtpInterfaceItemList, len(*in))
-		copy(*out, *in)
+		*out = make(StorageTierList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceItemList.
-func (in PtpInterfaceItemList) DeepCopy() PtpInterfaceItemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageTierList.
+func (in StorageTierList) DeepCopy() StorageTierList {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterfaceItemList)
+	out := new(StorageTierList)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInterfaceList) DeepCopyInto(out *PtpInterfaceList) {
+func (in *Subcloud) DeepCopyInto(out *Subcloud) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PtpInterface, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceList.
-func (in *PtpInterfaceList) DeepCopy() *PtpInterfaceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subcloud.
+func (in *Subcloud) DeepCopy() *Subcloud {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterfaceList)
+	out := new(Subcloud)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PtpInterfaceList) DeepCopyObject() runtime.Object {
+func (in *Subcloud) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1889,186 +3685,251 @@ func (in *PtpInterfaceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInterfaceSpec) DeepCopyInto(out *PtpInterfaceSpec) {
+func (in *SubcloudBackupInfo) DeepCopyInto(out *SubcloudBackupInfo) {
 	*out = *in
-	if in.InterfaceParameters != nil {
-		in, out := &in.InterfaceParameters, &out.InterfaceParameters
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.LocalOnly != nil {
+		in, out := &in.LocalOnly, &out.LocalOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RegistryImages != nil {
+		in, out := &in.RegistryImages, &out.RegistryImages
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(string)
+		**out = **in
+	}
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceSpec.
-func (in *PtpInterfaceSpec) DeepCopy() *PtpInterfaceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudBackupInfo.
+func (in *SubcloudBackupInfo) DeepCopy() *SubcloudBackupInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterfaceSpec)
+	out := new(SubcloudBackupInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PtpInterfaceStatus) DeepCopyInto(out *PtpInterfaceStatus) {
+func (in *SubcloudBundleInfo) DeepCopyInto(out *SubcloudBundleInfo) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceStatus.
-func (in *PtpInterfaceStatus) DeepCopy() *PtpInterfaceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudBundleInfo.
+func (in *SubcloudBundleInfo) DeepCopy() *SubcloudBundleInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(PtpInterfaceStatus)
+	out := new(SubcloudBundleInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteInfo) DeepCopyInto(out *RouteInfo) {
+func (in *SubcloudCredentialsInfo) DeepCopyInto(out *SubcloudCredentialsInfo) {
 	*out = *in
-	if in.Metric != nil {
-		in, out := &in.Metric, &out.Metric
-		*out = new(int)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteInfo.
-func (in *RouteInfo) DeepCopy() *RouteInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudCredentialsInfo.
+func (in *SubcloudCredentialsInfo) DeepCopy() *SubcloudCredentialsInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteInfo)
+	out := new(SubcloudCredentialsInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in RouteList) DeepCopyInto(out *RouteList) {
-	{
-		in := &in
-		*out = make(RouteList, len(*in))
+func (in *SubcloudList) DeepCopyInto(out *SubcloudList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Subcloud, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
-func (in RouteList) DeepCopy() RouteList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudList.
+func (in *SubcloudList) DeepCopy() *SubcloudList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteList)
+	out := new(SubcloudList)
 	in.DeepCopyInto(out)
-	return *out
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubcloudList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceParameterInfo) DeepCopyInto(out *ServiceParameterInfo) {
+func (in *SubcloudSpec) DeepCopyInto(out *SubcloudSpec) {
 	*out = *in
-	if in.Personality != nil {
-		in, out := &in.Personality, &out.Personality
+	if in.InstallValues != nil {
+		in, out := &in.InstallValues, &out.InstallValues
 		*out = new(string)
 		**out = **in
 	}
-	if in.Resource != nil {
-		in, out := &in.Resource, &out.Resource
+	if in.DeployConfig != nil {
+		in, out := &in.DeployConfig, &out.DeployConfig
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceParameterInfo.
-func (in *ServiceParameterInfo) DeepCopy() *ServiceParameterInfo {
-	if in == nil {
-		return nil
+	out.Credentials = in.Credentials
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ServiceParameterInfo)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ServiceParameterList) DeepCopyInto(out *ServiceParameterList) {
-	{
-		in := &in
-		*out = make(ServiceParameterList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Release != nil {
+		in, out := &in.Release, &out.Release
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(SubcloudBackupInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bundle != nil {
+		in, out := &in.Bundle, &out.Bundle
+		*out = new(SubcloudBundleInfo)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceParameterList.
-func (in ServiceParameterList) DeepCopy() ServiceParameterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudSpec.
+func (in *SubcloudSpec) DeepCopy() *SubcloudSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceParameterList)
+	out := new(SubcloudSpec)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageBackend) DeepCopyInto(out *StorageBackend) {
+func (in *SubcloudStatus) DeepCopyInto(out *SubcloudStatus) {
 	*out = *in
-	if in.Services != nil {
-		in, out := &in.Services, &out.Services
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ReplicationFactor != nil {
-		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(int)
 		**out = **in
 	}
-	if in.PartitionSize != nil {
-		in, out := &in.PartitionSize, &out.PartitionSize
-		*out = new(int)
+	if in.DeployStatus != nil {
+		in, out := &in.DeployStatus, &out.DeployStatus
+		*out = new(string)
 		**out = **in
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
+	if in.AvailabilityStatus != nil {
+		in, out := &in.AvailabilityStatus, &out.AvailabilityStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagementState != nil {
+		in, out := &in.ManagementState, &out.ManagementState
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackupStatus != nil {
+		in, out := &in.BackupStatus, &out.BackupStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.SoftwareVersion != nil {
+		in, out := &in.SoftwareVersion, &out.SoftwareVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeployConfigHash != nil {
+		in, out := &in.DeployConfigHash, &out.DeployConfigHash
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackupRequest != nil {
+		in, out := &in.BackupRequest, &out.BackupRequest
 		*out = new(string)
 		**out = **in
 	}
+	if in.BundleHash != nil {
+		in, out := &in.BundleHash, &out.BundleHash
+		*out = new(string)
+		**out = **in
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackend.
-func (in *StorageBackend) DeepCopy() *StorageBackend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubcloudStatus.
+func (in *SubcloudStatus) DeepCopy() *SubcloudStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageBackend)
+	out := new(SubcloudStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in StorageBackendList) DeepCopyInto(out *StorageBackendList) {
-	{
-		in := &in
-		*out = make(StorageBackendList, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+func (in *SysadminInfo) DeepCopyInto(out *SysadminInfo) {
+	*out = *in
+	if in.PasswordExpiryDays != nil {
+		in, out := &in.PasswordExpiryDays, &out.PasswordExpiryDays
+		*out = new(int)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackendList.
-func (in StorageBackendList) DeepCopy() StorageBackendList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysadminInfo.
+func (in *SysadminInfo) DeepCopy() *SysadminInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageBackendList)
+	out := new(SysadminInfo)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -2173,6 +4034,11 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(PTPInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RemoteLogging != nil {
+		in, out := &in.RemoteLogging, &out.RemoteLogging
+		*out = new(RemoteLoggingInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Certificates != nil {
 		in, out := &in.Certificates, &out.Certificates
 		*out = make(CertificateList, len(*in))
@@ -2220,6 +4086,11 @@ func (in *SystemStatus) DeepCopyInto(out *SystemStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemStatus.
@@ -2250,7 +4121,16 @@ func (in *SystemStorageInfo) DeepCopyInto(out *SystemStorageInfo) {
 	if in.FileSystems != nil {
 		in, out := &in.FileSystems, &out.FileSystems
 		*out = make(ControllerFileSystemList, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make(StorageTierList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2264,6 +4144,51 @@ func (in *SystemStorageInfo) DeepCopy() *SystemStorageInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStrategyInfo) DeepCopyInto(out *UpgradeStrategyInfo) {
+	*out = *in
+	if in.ControllerApplyType != nil {
+		in, out := &in.ControllerApplyType, &out.ControllerApplyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageApplyType != nil {
+		in, out := &in.StorageApplyType, &out.StorageApplyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkerApplyType != nil {
+		in, out := &in.WorkerApplyType, &out.WorkerApplyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxParallelWorkers != nil {
+		in, out := &in.MaxParallelWorkers, &out.MaxParallelWorkers
+		*out = new(int)
+		**out = **in
+	}
+	if in.DefaultInstanceAction != nil {
+		in, out := &in.DefaultInstanceAction, &out.DefaultInstanceAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.AlarmRestrictions != nil {
+		in, out := &in.AlarmRestrictions, &out.AlarmRestrictions
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategyInfo.
+func (in *UpgradeStrategyInfo) DeepCopy() *UpgradeStrategyInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStrategyInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VFInfo) DeepCopyInto(out *VFInfo) {
 	*out = *in
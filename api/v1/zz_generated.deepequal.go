@@ -8,6 +8,26 @@
 
 package v1
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *AddressAllocation) DeepEqual(other *AddressAllocation) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Host != other.Host {
+		return false
+	}
+	if in.Interface != other.Interface {
+		return false
+	}
+	if in.Address != other.Address {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *AddressInfo) DeepEqual(other *AddressInfo) bool {
@@ -55,6 +75,69 @@ func (in *AddressList) DeepEqual(other *AddressList) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *AddressPoolItemList) DeepEqual(other *AddressPoolItemList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement == otherElement {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *AddressPoolSnapshot) DeepEqual(other *AddressPoolSnapshot) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Name != other.Name {
+		return false
+	}
+	if in.Subnet != other.Subnet {
+		return false
+	}
+	if in.Prefix != other.Prefix {
+		return false
+	}
+	if in.FloatingAddress != other.FloatingAddress {
+		return false
+	}
+	if in.Controller0Address != other.Controller0Address {
+		return false
+	}
+	if in.Controller1Address != other.Controller1Address {
+		return false
+	}
+	if (in.Gateway == nil) != (other.Gateway == nil) {
+		return false
+	} else if in.Gateway != nil {
+		if *in.Gateway != *other.Gateway {
+			return false
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *AddressPoolSpec) DeepEqual(other *AddressPoolSpec) bool {
@@ -137,6 +220,67 @@ func (in *AddressPoolStatus) DeepEqual(other *AddressPoolStatus) bool {
 	if in.Delta != other.Delta {
 		return false
 	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+	if ((in.Allocations != nil) && (other.Allocations != nil)) || ((in.Allocations == nil) != (other.Allocations == nil)) {
+		in, other := &in.Allocations, &other.Allocations
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+	if ((in.Consumers != nil) && (other.Consumers != nil)) || ((in.Consumers == nil) != (other.Consumers == nil)) {
+		in, other := &in.Consumers, &other.Consumers
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if (in.AllocatedAddresses == nil) != (other.AllocatedAddresses == nil) {
+		return false
+	} else if in.AllocatedAddresses != nil {
+		if *in.AllocatedAddresses != *other.AllocatedAddresses {
+			return false
+		}
+	}
+	if (in.FreeAddresses == nil) != (other.FreeAddresses == nil) {
+		return false
+	} else if in.FreeAddresses != nil {
+		if *in.FreeAddresses != *other.FreeAddresses {
+			return false
+		}
+	}
 
 	return true
 }
@@ -253,6 +397,33 @@ func (in *BMInfo) DeepEqual(other *BMInfo) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *BMStatus) DeepEqual(other *BMStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Provisioned != other.Provisioned {
+		return false
+	}
+	if in.Secret != other.Secret {
+		return false
+	}
+	if in.SecretVersion != other.SecretVersion {
+		return false
+	}
+	if (in.Error == nil) != (other.Error == nil) {
+		return false
+	} else if in.Error != nil {
+		if *in.Error != *other.Error {
+			return false
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *BondInfo) DeepEqual(other *BondInfo) bool {
@@ -465,6 +636,30 @@ func (in *CommonInterfaceInfo) DeepEqual(other *CommonInterfaceInfo) bool {
 		}
 	}
 
+	if ((in.AddressPools != nil) && (other.AddressPools != nil)) || ((in.AddressPools == nil) != (other.AddressPools == nil)) {
+		in, other := &in.AddressPools, &other.AddressPools
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement == otherElement {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
 	if in.MaxTxRate != nil {
 		if (in.MaxTxRate == nil) != (other.MaxTxRate == nil) {
 			return false
@@ -501,6 +696,13 @@ func (in *ControllerFileSystemInfo) DeepEqual(other *ControllerFileSystemInfo) b
 	if in.Size != other.Size {
 		return false
 	}
+	if (in.SizePolicy == nil) != (other.SizePolicy == nil) {
+		return false
+	} else if in.SizePolicy != nil {
+		if !in.SizePolicy.DeepEqual(other.SizePolicy) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -673,6 +875,106 @@ func (in *DataNetworkStatus) DeepEqual(other *DataNetworkStatus) bool {
 	if in.Delta != other.Delta {
 		return false
 	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+	if ((in.Consumers != nil) && (other.Consumers != nil)) || ((in.Consumers == nil) != (other.Consumers == nil)) {
+		in, other := &in.Consumers, &other.Consumers
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *DiskSelector) DeepEqual(other *DiskSelector) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.MinSize != nil {
+		if (in.MinSize == nil) != (other.MinSize == nil) {
+			return false
+		} else if in.MinSize != nil {
+			if *in.MinSize != *other.MinSize {
+				return false
+			}
+		}
+	}
+
+	if in.MaxSize != nil {
+		if (in.MaxSize == nil) != (other.MaxSize == nil) {
+			return false
+		} else if in.MaxSize != nil {
+			if *in.MaxSize != *other.MaxSize {
+				return false
+			}
+		}
+	}
+
+	if in.Type != nil {
+		if (in.Type == nil) != (other.Type == nil) {
+			return false
+		} else if in.Type != nil {
+			if *in.Type != *other.Type {
+				return false
+			}
+		}
+	}
+
+	if in.Model != nil {
+		if (in.Model == nil) != (other.Model == nil) {
+			return false
+		} else if in.Model != nil {
+			if *in.Model != *other.Model {
+				return false
+			}
+		}
+	}
+
+	if in.Serial != nil {
+		if (in.Serial == nil) != (other.Serial == nil) {
+			return false
+		} else if in.Serial != nil {
+			if *in.Serial != *other.Serial {
+				return false
+			}
+		}
+	}
+
+	if in.WWN != nil {
+		if (in.WWN == nil) != (other.WWN == nil) {
+			return false
+		} else if in.WWN != nil {
+			if *in.WWN != *other.WWN {
+				return false
+			}
+		}
+	}
 
 	return true
 }
@@ -722,7 +1024,7 @@ func (in *EthernetInfo) DeepEqual(other *EthernetInfo) bool {
 		}
 	}
 
-	if in.Port != other.Port {
+	if !in.Port.DeepEqual(&other.Port) {
 		return false
 	}
 
@@ -779,6 +1081,13 @@ func (in *EthernetPortInfo) DeepEqual(other *EthernetPortInfo) bool {
 	if in.Name != other.Name {
 		return false
 	}
+	if (in.Selector == nil) != (other.Selector == nil) {
+		return false
+	} else if in.Selector != nil {
+		if !in.Selector.DeepEqual(other.Selector) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -796,6 +1105,13 @@ func (in *FileSystemInfo) DeepEqual(other *FileSystemInfo) bool {
 	if in.Size != other.Size {
 		return false
 	}
+	if (in.SizePolicy == nil) != (other.SizePolicy == nil) {
+		return false
+	} else if in.SizePolicy != nil {
+		if !in.SizePolicy.DeepEqual(other.SizePolicy) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -829,124 +1145,58 @@ func (in *FileSystemList) DeepEqual(other *FileSystemList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
+func (in *FileSystemSizePolicy) DeepEqual(other *FileSystemSizePolicy) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.Base == nil) != (other.Base == nil) {
+	if (in.Percent == nil) != (other.Percent == nil) {
 		return false
-	} else if in.Base != nil {
-		if *in.Base != *other.Base {
+	} else if in.Percent != nil {
+		if *in.Percent != *other.Percent {
 			return false
 		}
 	}
-
-	if !in.ProfileBaseAttributes.DeepEqual(&other.ProfileBaseAttributes) {
-		return false
-	}
-
-	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
+	if (in.MaxAvailable == nil) != (other.MaxAvailable == nil) {
 		return false
-	} else if in.BoardManagement != nil {
-		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+	} else if in.MaxAvailable != nil {
+		if *in.MaxAvailable != *other.MaxAvailable {
 			return false
 		}
 	}
-
-	if ((in.Processors != nil) && (other.Processors != nil)) || ((in.Processors == nil) != (other.Processors == nil)) {
-		in, other := &in.Processors, &other.Processors
-		if other == nil {
-			return false
-		}
-
-		if len(*in) != len(*other) {
+	if (in.Min == nil) != (other.Min == nil) {
+		return false
+	} else if in.Min != nil {
+		if *in.Min != *other.Min {
 			return false
-		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					return false
-				}
-			}
 		}
 	}
-
-	if ((in.Memory != nil) && (other.Memory != nil)) || ((in.Memory == nil) != (other.Memory == nil)) {
-		in, other := &in.Memory, &other.Memory
-		if other == nil {
-			return false
-		}
-
-		if len(*in) != len(*other) {
+	if (in.Max == nil) != (other.Max == nil) {
+		return false
+	} else if in.Max != nil {
+		if *in.Max != *other.Max {
 			return false
-		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					return false
-				}
-			}
 		}
 	}
 
-	if (in.Storage == nil) != (other.Storage == nil) {
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HelmOverrideInfo) DeepEqual(other *HelmOverrideInfo) bool {
+	if other == nil {
 		return false
-	} else if in.Storage != nil {
-		if !in.Storage.DeepEqual(other.Storage) {
-			return false
-		}
 	}
 
-	if (in.Interfaces == nil) != (other.Interfaces == nil) {
+	if in.Chart != other.Chart {
 		return false
-	} else if in.Interfaces != nil {
-		if !in.Interfaces.DeepEqual(other.Interfaces) {
-			return false
-		}
 	}
-
-	if ((in.Addresses != nil) && (other.Addresses != nil)) || ((in.Addresses == nil) != (other.Addresses == nil)) {
-		in, other := &in.Addresses, &other.Addresses
-		if other == nil || !in.DeepEqual(other) {
-			return false
-		}
+	if in.Namespace != other.Namespace {
+		return false
 	}
-
-	if ((in.Routes != nil) && (other.Routes != nil)) || ((in.Routes == nil) != (other.Routes == nil)) {
-		in, other := &in.Routes, &other.Routes
-		if other == nil {
-			return false
-		}
-
-		if len(*in) != len(*other) {
-			return false
-		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					return false
-				}
-			}
-		}
+	if in.Values != other.Values {
+		return false
 	}
 
 	return true
@@ -954,28 +1204,16 @@ func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *HostSpec) DeepEqual(other *HostSpec) bool {
+func (in *HostActionInfo) DeepEqual(other *HostActionInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Profile != other.Profile {
-		return false
-	}
-	if (in.Match == nil) != (other.Match == nil) {
+	if in.Type != other.Type {
 		return false
-	} else if in.Match != nil {
-		if !in.Match.DeepEqual(other.Match) {
-			return false
-		}
 	}
-
-	if (in.Overrides == nil) != (other.Overrides == nil) {
+	if in.Request != other.Request {
 		return false
-	} else if in.Overrides != nil {
-		if !in.Overrides.DeepEqual(other.Overrides) {
-			return false
-		}
 	}
 
 	return true
@@ -983,76 +1221,84 @@ func (in *HostSpec) DeepEqual(other *HostSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *HostStatus) DeepEqual(other *HostStatus) bool {
+func (in *HostActionStatus) DeepEqual(other *HostActionStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.ID == nil) != (other.ID == nil) {
+	if in.Type != other.Type {
 		return false
-	} else if in.ID != nil {
-		if *in.ID != *other.ID {
-			return false
-		}
 	}
-
-	if (in.AdministrativeState == nil) != (other.AdministrativeState == nil) {
+	if in.Request != other.Request {
 		return false
-	} else if in.AdministrativeState != nil {
-		if *in.AdministrativeState != *other.AdministrativeState {
+	}
+	if in.Phase != other.Phase {
+		return false
+	}
+	if (in.Reason == nil) != (other.Reason == nil) {
+		return false
+	} else if in.Reason != nil {
+		if *in.Reason != *other.Reason {
 			return false
 		}
 	}
-
-	if (in.OperationalStatus == nil) != (other.OperationalStatus == nil) {
+	if (in.StartTime == nil) != (other.StartTime == nil) {
 		return false
-	} else if in.OperationalStatus != nil {
-		if *in.OperationalStatus != *other.OperationalStatus {
+	} else if in.StartTime != nil {
+		if !in.StartTime.Equal(other.StartTime) {
 			return false
 		}
 	}
-
-	if (in.AvailabilityStatus == nil) != (other.AvailabilityStatus == nil) {
+	if (in.SentTime == nil) != (other.SentTime == nil) {
 		return false
-	} else if in.AvailabilityStatus != nil {
-		if *in.AvailabilityStatus != *other.AvailabilityStatus {
+	} else if in.SentTime != nil {
+		if !in.SentTime.Equal(other.SentTime) {
 			return false
 		}
 	}
-
-	if (in.Defaults == nil) != (other.Defaults == nil) {
+	if (in.CompletionTime == nil) != (other.CompletionTime == nil) {
 		return false
-	} else if in.Defaults != nil {
-		if *in.Defaults != *other.Defaults {
+	} else if in.CompletionTime != nil {
+		if !in.CompletionTime.Equal(other.CompletionTime) {
 			return false
 		}
 	}
 
-	if in.Reconciled != other.Reconciled {
-		return false
-	}
-	if in.InSync != other.InSync {
-		return false
-	}
-	if in.DeploymentScope != other.DeploymentScope {
-		return false
-	}
-	if in.ObservedHostProfileGeneration != other.ObservedHostProfileGeneration {
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostMaintenanceInfo) DeepEqual(other *HostMaintenanceInfo) bool {
+	if other == nil {
 		return false
 	}
-	if in.ObservedGeneration != other.ObservedGeneration {
+
+	if in.Lock != other.Lock {
 		return false
 	}
-	if in.HostProfileConfigurationUpdated != other.HostProfileConfigurationUpdated {
+	if (in.Reason == nil) != (other.Reason == nil) {
 		return false
+	} else if in.Reason != nil {
+		if *in.Reason != *other.Reason {
+			return false
+		}
 	}
-	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostPoolMember) DeepEqual(other *HostPoolMember) bool {
+	if other == nil {
 		return false
 	}
-	if in.StrategyRequired != other.StrategyRequired {
+
+	if in.Name != other.Name {
 		return false
 	}
-	if in.Delta != other.Delta {
+	if in.BootMAC != other.BootMAC {
 		return false
 	}
 
@@ -1061,20 +1307,13 @@ func (in *HostStatus) DeepEqual(other *HostStatus) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *InterfaceInfo) DeepEqual(other *InterfaceInfo) bool {
+func (in *HostPoolSelector) DeepEqual(other *HostPoolSelector) bool {
 	if other == nil {
 		return false
 	}
 
-	if ((in.Ethernet != nil) && (other.Ethernet != nil)) || ((in.Ethernet == nil) != (other.Ethernet == nil)) {
-		in, other := &in.Ethernet, &other.Ethernet
-		if other == nil || !in.DeepEqual(other) {
-			return false
-		}
-	}
-
-	if ((in.VLAN != nil) && (other.VLAN != nil)) || ((in.VLAN == nil) != (other.VLAN == nil)) {
-		in, other := &in.VLAN, &other.VLAN
+	if ((in.BootMACPrefixes != nil) && (other.BootMACPrefixes != nil)) || ((in.BootMACPrefixes == nil) != (other.BootMACPrefixes == nil)) {
+		in, other := &in.BootMACPrefixes, &other.BootMACPrefixes
 		if other == nil {
 			return false
 		}
@@ -1082,49 +1321,32 @@ func (in *InterfaceInfo) DeepEqual(other *InterfaceInfo) bool {
 		if len(*in) != len(*other) {
 			return false
 		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
 					return false
 				}
 			}
 		}
 	}
-
-	if ((in.Bond != nil) && (other.Bond != nil)) || ((in.Bond == nil) != (other.Bond == nil)) {
-		in, other := &in.Bond, &other.Bond
-		if other == nil || !in.DeepEqual(other) {
+	if (in.SerialNumber == nil) != (other.SerialNumber == nil) {
+		return false
+	} else if in.SerialNumber != nil {
+		if *in.SerialNumber != *other.SerialNumber {
 			return false
 		}
 	}
-
-	if ((in.VF != nil) && (other.VF != nil)) || ((in.VF == nil) != (other.VF == nil)) {
-		in, other := &in.VF, &other.VF
-		if other == nil {
+	if (in.AssetTag == nil) != (other.AssetTag == nil) {
+		return false
+	} else if in.AssetTag != nil {
+		if *in.AssetTag != *other.AssetTag {
 			return false
 		}
-
-		if len(*in) != len(*other) {
+	}
+	if (in.BoardManagementType == nil) != (other.BoardManagementType == nil) {
+		return false
+	} else if in.BoardManagementType != nil {
+		if *in.BoardManagementType != *other.BoardManagementType {
 			return false
-		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					return false
-				}
-			}
 		}
 	}
 
@@ -1133,40 +1355,24 @@ func (in *InterfaceInfo) DeepEqual(other *InterfaceInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *JournalInfo) DeepEqual(other *JournalInfo) bool {
+func (in *HostPoolSpec) DeepEqual(other *HostPoolSpec) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Location != other.Location {
-		return false
-	}
-	if in.Size != other.Size {
+	if in.Profile != other.Profile {
 		return false
 	}
-
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *MatchBMInfo) DeepEqual(other *MatchBMInfo) bool {
-	if other == nil {
+	if !in.Hostnames.DeepEqual(&other.Hostnames) {
 		return false
 	}
-
-	if (in.Address == nil) != (other.Address == nil) {
+	if !in.Selector.DeepEqual(&other.Selector) {
 		return false
-	} else if in.Address != nil {
-		if *in.Address != *other.Address {
-			return false
-		}
 	}
-
-	if (in.Type == nil) != (other.Type == nil) {
+	if (in.MaxHosts == nil) != (other.MaxHosts == nil) {
 		return false
-	} else if in.Type != nil {
-		if *in.Type != *other.Type {
+	} else if in.MaxHosts != nil {
+		if *in.MaxHosts != *other.MaxHosts {
 			return false
 		}
 	}
@@ -1176,23 +1382,1806 @@ func (in *MatchBMInfo) DeepEqual(other *MatchBMInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MatchDMIInfo) DeepEqual(other *MatchDMIInfo) bool {
+func (in *HostPoolStatus) DeepEqual(other *HostPoolStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.SerialNumber == nil) != (other.SerialNumber == nil) {
-		return false
+	if ((in.Members != nil) && (other.Members != nil)) || ((in.Members == nil) != (other.Members == nil)) {
+		in, other := &in.Members, &other.Members
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Base == nil) != (other.Base == nil) {
+		return false
+	} else if in.Base != nil {
+		if *in.Base != *other.Base {
+			return false
+		}
+	}
+
+	if !in.ProfileBaseAttributes.DeepEqual(&other.ProfileBaseAttributes) {
+		return false
+	}
+
+	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
+		return false
+	} else if in.BoardManagement != nil {
+		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+			return false
+		}
+	}
+
+	if ((in.Processors != nil) && (other.Processors != nil)) || ((in.Processors == nil) != (other.Processors == nil)) {
+		in, other := &in.Processors, &other.Processors
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Memory != nil) && (other.Memory != nil)) || ((in.Memory == nil) != (other.Memory == nil)) {
+		in, other := &in.Memory, &other.Memory
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if (in.Storage == nil) != (other.Storage == nil) {
+		return false
+	} else if in.Storage != nil {
+		if !in.Storage.DeepEqual(other.Storage) {
+			return false
+		}
+	}
+
+	if (in.Interfaces == nil) != (other.Interfaces == nil) {
+		return false
+	} else if in.Interfaces != nil {
+		if !in.Interfaces.DeepEqual(other.Interfaces) {
+			return false
+		}
+	}
+
+	if ((in.Addresses != nil) && (other.Addresses != nil)) || ((in.Addresses == nil) != (other.Addresses == nil)) {
+		in, other := &in.Addresses, &other.Addresses
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	if ((in.Routes != nil) && (other.Routes != nil)) || ((in.Routes == nil) != (other.Routes == nil)) {
+		in, other := &in.Routes, &other.Routes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Devices != nil) && (other.Devices != nil)) || ((in.Devices == nil) != (other.Devices == nil)) {
+		in, other := &in.Devices, &other.Devices
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostPtpStatus) DeepEqual(other *HostPtpStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Instance != other.Instance {
+		return false
+	}
+	if in.Locked != other.Locked {
+		return false
+	}
+	if in.LockState != other.LockState {
+		return false
+	}
+	if in.GNSSStatus != other.GNSSStatus {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostSpec) DeepEqual(other *HostSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Profile != other.Profile {
+		return false
+	}
+	if (in.Match == nil) != (other.Match == nil) {
+		return false
+	} else if in.Match != nil {
+		if !in.Match.DeepEqual(other.Match) {
+			return false
+		}
+	}
+
+	if (in.Overrides == nil) != (other.Overrides == nil) {
+		return false
+	} else if in.Overrides != nil {
+		if !in.Overrides.DeepEqual(other.Overrides) {
+			return false
+		}
+	}
+	if (in.Action == nil) != (other.Action == nil) {
+		return false
+	} else if in.Action != nil {
+		if !in.Action.DeepEqual(other.Action) {
+			return false
+		}
+	}
+	if (in.Maintenance == nil) != (other.Maintenance == nil) {
+		return false
+	} else if in.Maintenance != nil {
+		if !in.Maintenance.DeepEqual(other.Maintenance) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostStatus) DeepEqual(other *HostStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.ID == nil) != (other.ID == nil) {
+		return false
+	} else if in.ID != nil {
+		if *in.ID != *other.ID {
+			return false
+		}
+	}
+
+	if (in.AdministrativeState == nil) != (other.AdministrativeState == nil) {
+		return false
+	} else if in.AdministrativeState != nil {
+		if *in.AdministrativeState != *other.AdministrativeState {
+			return false
+		}
+	}
+
+	if (in.OperationalStatus == nil) != (other.OperationalStatus == nil) {
+		return false
+	} else if in.OperationalStatus != nil {
+		if *in.OperationalStatus != *other.OperationalStatus {
+			return false
+		}
+	}
+
+	if (in.AvailabilityStatus == nil) != (other.AvailabilityStatus == nil) {
+		return false
+	} else if in.AvailabilityStatus != nil {
+		if *in.AvailabilityStatus != *other.AvailabilityStatus {
+			return false
+		}
+	}
+
+	if (in.Defaults == nil) != (other.Defaults == nil) {
+		return false
+	} else if in.Defaults != nil {
+		if *in.Defaults != *other.Defaults {
+			return false
+		}
+	}
+
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.DeploymentScope != other.DeploymentScope {
+		return false
+	}
+	if in.ObservedHostProfileGeneration != other.ObservedHostProfileGeneration {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if in.HostProfileConfigurationUpdated != other.HostProfileConfigurationUpdated {
+		return false
+	}
+	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+		return false
+	}
+	if in.StrategyRequired != other.StrategyRequired {
+		return false
+	}
+	if in.Delta != other.Delta {
+		return false
+	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	if ((in.Processors != nil) && (other.Processors != nil)) || ((in.Processors == nil) != (other.Processors == nil)) {
+		in, other := &in.Processors, &other.Processors
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Memory != nil) && (other.Memory != nil)) || ((in.Memory == nil) != (other.Memory == nil)) {
+		in, other := &in.Memory, &other.Memory
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+	if (in.Action == nil) != (other.Action == nil) {
+		return false
+	} else if in.Action != nil {
+		if !in.Action.DeepEqual(other.Action) {
+			return false
+		}
+	}
+	if in.Maintenance != other.Maintenance {
+		return false
+	}
+	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
+		return false
+	} else if in.BoardManagement != nil {
+		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+			return false
+		}
+	}
+	if ((in.Ptp != nil) && (other.Ptp != nil)) || ((in.Ptp == nil) != (other.Ptp == nil)) {
+		in, other := &in.Ptp, &other.Ptp
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostnameAllocationInfo) DeepEqual(other *HostnameAllocationInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Prefix != other.Prefix {
+		return false
+	}
+	if (in.Start == nil) != (other.Start == nil) {
+		return false
+	} else if in.Start != nil {
+		if *in.Start != *other.Start {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *InterfaceInfo) DeepEqual(other *InterfaceInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.Ethernet != nil) && (other.Ethernet != nil)) || ((in.Ethernet == nil) != (other.Ethernet == nil)) {
+		in, other := &in.Ethernet, &other.Ethernet
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	if ((in.VLAN != nil) && (other.VLAN != nil)) || ((in.VLAN == nil) != (other.VLAN == nil)) {
+		in, other := &in.VLAN, &other.VLAN
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Bond != nil) && (other.Bond != nil)) || ((in.Bond == nil) != (other.Bond == nil)) {
+		in, other := &in.Bond, &other.Bond
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	if ((in.VF != nil) && (other.VF != nil)) || ((in.VF == nil) != (other.VF == nil)) {
+		in, other := &in.VF, &other.VF
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *JournalInfo) DeepEqual(other *JournalInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Location != other.Location {
+		return false
+	}
+	if in.Size != other.Size {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *KeystoneProjectInfo) DeepEqual(other *KeystoneProjectInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Name != other.Name {
+		return false
+	}
+	if (in.Description == nil) != (other.Description == nil) {
+		return false
+	} else if in.Description != nil {
+		if *in.Description != *other.Description {
+			return false
+		}
+	}
+	if (in.Enabled == nil) != (other.Enabled == nil) {
+		return false
+	} else if in.Enabled != nil {
+		if *in.Enabled != *other.Enabled {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *KeystoneUserInfo) DeepEqual(other *KeystoneUserInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Name != other.Name {
+		return false
+	}
+	if (in.Project == nil) != (other.Project == nil) {
+		return false
+	} else if in.Project != nil {
+		if *in.Project != *other.Project {
+			return false
+		}
+	}
+	if (in.Email == nil) != (other.Email == nil) {
+		return false
+	} else if in.Email != nil {
+		if *in.Email != *other.Email {
+			return false
+		}
+	}
+	if (in.Enabled == nil) != (other.Enabled == nil) {
+		return false
+	} else if in.Enabled != nil {
+		if *in.Enabled != *other.Enabled {
+			return false
+		}
+	}
+	if ((in.Roles != nil) && (other.Roles != nil)) || ((in.Roles == nil) != (other.Roles == nil)) {
+		in, other := &in.Roles, &other.Roles
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+	if in.Secret != other.Secret {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MatchBMInfo) DeepEqual(other *MatchBMInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Address == nil) != (other.Address == nil) {
+		return false
+	} else if in.Address != nil {
+		if *in.Address != *other.Address {
+			return false
+		}
+	}
+
+	if (in.Type == nil) != (other.Type == nil) {
+		return false
+	} else if in.Type != nil {
+		if *in.Type != *other.Type {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MatchDMIInfo) DeepEqual(other *MatchDMIInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.SerialNumber == nil) != (other.SerialNumber == nil) {
+		return false
 	} else if in.SerialNumber != nil {
 		if *in.SerialNumber != *other.SerialNumber {
 			return false
 		}
 	}
-
-	if (in.AssetTag == nil) != (other.AssetTag == nil) {
+
+	if (in.AssetTag == nil) != (other.AssetTag == nil) {
+		return false
+	} else if in.AssetTag != nil {
+		if *in.AssetTag != *other.AssetTag {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MatchInfo) DeepEqual(other *MatchInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.BootMAC == nil) != (other.BootMAC == nil) {
+		return false
+	} else if in.BootMAC != nil {
+		if *in.BootMAC != *other.BootMAC {
+			return false
+		}
+	}
+
+	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
+		return false
+	} else if in.BoardManagement != nil {
+		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+			return false
+		}
+	}
+
+	if (in.DMI == nil) != (other.DMI == nil) {
+		return false
+	} else if in.DMI != nil {
+		if !in.DMI.DeepEqual(other.DMI) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryAllocationPolicy) DeepEqual(other *MemoryAllocationPolicy) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Percent != nil {
+		if (in.Percent == nil) != (other.Percent == nil) {
+			return false
+		} else if in.Percent != nil {
+			if *in.Percent != *other.Percent {
+				return false
+			}
+		}
+	}
+
+	if in.Remaining != nil {
+		if (in.Remaining == nil) != (other.Remaining == nil) {
+			return false
+		} else if in.Remaining != nil {
+			if *in.Remaining != *other.Remaining {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryFunctionInfo) DeepEqual(other *MemoryFunctionInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Function != other.Function {
+		return false
+	}
+	if in.PageSize != other.PageSize {
+		return false
+	}
+	if in.PageCount != other.PageCount {
+		return false
+	}
+	if (in.Policy == nil) != (other.Policy == nil) {
+		return false
+	} else if in.Policy != nil {
+		if !in.Policy.DeepEqual(other.Policy) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryFunctionList) DeepEqual(other *MemoryFunctionList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryNodeInfo) DeepEqual(other *MemoryNodeInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Node != other.Node {
+		return false
+	}
+	if ((in.Functions != nil) && (other.Functions != nil)) || ((in.Functions == nil) != (other.Functions == nil)) {
+		in, other := &in.Functions, &other.Functions
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MemoryNodeList) DeepEqual(other *MemoryNodeList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MonitorInfo) DeepEqual(other *MonitorInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Size != nil {
+		if (in.Size == nil) != (other.Size == nil) {
+			return false
+		} else if in.Size != nil {
+			if *in.Size != *other.Size {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *NTPServerList) DeepEqual(other *NTPServerList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement == otherElement {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *NetworkReconfigurationSpec) DeepEqual(other *NetworkReconfigurationSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.PlatformNetwork != other.PlatformNetwork {
+		return false
+	}
+	if in.Paused != other.Paused {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *NetworkReconfigurationStatus) DeepEqual(other *NetworkReconfigurationStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Phase == nil) != (other.Phase == nil) {
+		return false
+	} else if in.Phase != nil {
+		if *in.Phase != *other.Phase {
+			return false
+		}
+	}
+	if in.NetworkType != other.NetworkType {
+		return false
+	}
+	if ((in.Checks != nil) && (other.Checks != nil)) || ((in.Checks == nil) != (other.Checks == nil)) {
+		in, other := &in.Checks, &other.Checks
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+	if ((in.Steps != nil) && (other.Steps != nil)) || ((in.Steps == nil) != (other.Steps == nil)) {
+		in, other := &in.Steps, &other.Steps
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+	if (in.CurrentStep == nil) != (other.CurrentStep == nil) {
+		return false
+	} else if in.CurrentStep != nil {
+		if *in.CurrentStep != *other.CurrentStep {
+			return false
+		}
+	}
+	if ((in.Previous != nil) && (other.Previous != nil)) || ((in.Previous == nil) != (other.Previous == nil)) {
+		in, other := &in.Previous, &other.Previous
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+	if ((in.Rollback != nil) && (other.Rollback != nil)) || ((in.Rollback == nil) != (other.Rollback == nil)) {
+		in, other := &in.Rollback, &other.Rollback
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *OSDInfo) DeepEqual(other *OSDInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Function != other.Function {
+		return false
+	}
+	if in.Path != other.Path {
+		return false
+	}
+	if in.Selector != nil {
+		if (in.Selector == nil) != (other.Selector == nil) {
+			return false
+		} else if in.Selector != nil {
+			if !in.Selector.DeepEqual(other.Selector) {
+				return false
+			}
+		}
+	}
+
+	if in.ClusterName != nil {
+		if (in.ClusterName == nil) != (other.ClusterName == nil) {
+			return false
+		} else if in.ClusterName != nil {
+			if *in.ClusterName != *other.ClusterName {
+				return false
+			}
+		}
+	}
+
+	if in.Tier != nil {
+		if (in.Tier == nil) != (other.Tier == nil) {
+			return false
+		} else if in.Tier != nil {
+			if *in.Tier != *other.Tier {
+				return false
+			}
+		}
+	}
+
+	if in.Journal != nil {
+		if (in.Journal == nil) != (other.Journal == nil) {
+			return false
+		} else if in.Journal != nil {
+			if !in.Journal.DeepEqual(other.Journal) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *OSDList) DeepEqual(other *OSDList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PCIDeviceInfo) DeepEqual(other *PCIDeviceInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Address != nil {
+		if (in.Address == nil) != (other.Address == nil) {
+			return false
+		} else if in.Address != nil {
+			if *in.Address != *other.Address {
+				return false
+			}
+		}
+	}
+
+	if in.DeviceID != nil {
+		if (in.DeviceID == nil) != (other.DeviceID == nil) {
+			return false
+		} else if in.DeviceID != nil {
+			if *in.DeviceID != *other.DeviceID {
+				return false
+			}
+		}
+	}
+
+	if in.Enabled != nil {
+		if (in.Enabled == nil) != (other.Enabled == nil) {
+			return false
+		} else if in.Enabled != nil {
+			if *in.Enabled != *other.Enabled {
+				return false
+			}
+		}
+	}
+
+	if in.Driver != nil {
+		if (in.Driver == nil) != (other.Driver == nil) {
+			return false
+		} else if in.Driver != nil {
+			if *in.Driver != *other.Driver {
+				return false
+			}
+		}
+	}
+
+	if in.VFCount != nil {
+		if (in.VFCount == nil) != (other.VFCount == nil) {
+			return false
+		} else if in.VFCount != nil {
+			if *in.VFCount != *other.VFCount {
+				return false
+			}
+		}
+	}
+
+	if in.VFDriver != nil {
+		if (in.VFDriver == nil) != (other.VFDriver == nil) {
+			return false
+		} else if in.VFDriver != nil {
+			if *in.VFDriver != *other.VFDriver {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PCIDeviceList) DeepEqual(other *PCIDeviceList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PTPInfo) DeepEqual(other *PTPInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Mode != nil {
+		if (in.Mode == nil) != (other.Mode == nil) {
+			return false
+		} else if in.Mode != nil {
+			if *in.Mode != *other.Mode {
+				return false
+			}
+		}
+	}
+
+	if in.Transport != nil {
+		if (in.Transport == nil) != (other.Transport == nil) {
+			return false
+		} else if in.Transport != nil {
+			if *in.Transport != *other.Transport {
+				return false
+			}
+		}
+	}
+
+	if in.Mechanism != nil {
+		if (in.Mechanism == nil) != (other.Mechanism == nil) {
+			return false
+		} else if in.Mechanism != nil {
+			if *in.Mechanism != *other.Mechanism {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PhysicalVolumeInfo) DeepEqual(other *PhysicalVolumeInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Type != other.Type {
+		return false
+	}
+	if in.Path != other.Path {
+		return false
+	}
+	if in.Selector != nil {
+		if (in.Selector == nil) != (other.Selector == nil) {
+			return false
+		} else if in.Selector != nil {
+			if !in.Selector.DeepEqual(other.Selector) {
+				return false
+			}
+		}
+	}
+
+	if in.Size != nil {
+		if (in.Size == nil) != (other.Size == nil) {
+			return false
+		} else if in.Size != nil {
+			if *in.Size != *other.Size {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PhysicalVolumeList) DeepEqual(other *PhysicalVolumeList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlanStatus) DeepEqual(other *PlanStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if ((in.Operations != nil) && (other.Operations != nil)) || ((in.Operations == nil) != (other.Operations == nil)) {
+		in, other := &in.Operations, &other.Operations
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.Message != other.Message {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlannedOperation) DeepEqual(other *PlannedOperation) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Method != other.Method {
+		return false
+	}
+	if in.Path != other.Path {
+		return false
+	}
+
+	if (in.Body == nil) != (other.Body == nil) {
+		return false
+	} else if in.Body != nil {
+		if *in.Body != *other.Body {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformApplicationSpec) DeepEqual(other *PlatformApplicationSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Tarball == nil) != (other.Tarball == nil) {
+		return false
+	} else if in.Tarball != nil {
+		if *in.Tarball != *other.Tarball {
+			return false
+		}
+	}
+	if (in.Version == nil) != (other.Version == nil) {
+		return false
+	} else if in.Version != nil {
+		if *in.Version != *other.Version {
+			return false
+		}
+	}
+	if in.State != other.State {
+		return false
+	}
+	if ((in.Overrides != nil) && (other.Overrides != nil)) || ((in.Overrides == nil) != (other.Overrides == nil)) {
+		in, other := &in.Overrides, &other.Overrides
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformApplicationStatus) DeepEqual(other *PlatformApplicationStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Status == nil) != (other.Status == nil) {
+		return false
+	} else if in.Status != nil {
+		if *in.Status != *other.Status {
+			return false
+		}
+	}
+	if (in.Version == nil) != (other.Version == nil) {
+		return false
+	} else if in.Version != nil {
+		if *in.Version != *other.Version {
+			return false
+		}
+	}
+	if (in.Progress == nil) != (other.Progress == nil) {
+		return false
+	} else if in.Progress != nil {
+		if *in.Progress != *other.Progress {
+			return false
+		}
+	}
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if in.Delta != other.Delta {
+		return false
+	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformNetworkItemList) DeepEqual(other *PlatformNetworkItemList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement == otherElement {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformNetworkSpec) DeepEqual(other *PlatformNetworkSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Type != other.Type {
+		return false
+	}
+	if in.Dynamic != other.Dynamic {
+		return false
+	}
+	if ((in.AssociatedAddressPools != nil) && (other.AssociatedAddressPools != nil)) || ((in.AssociatedAddressPools == nil) != (other.AssociatedAddressPools == nil)) {
+		in, other := &in.AssociatedAddressPools, &other.AssociatedAddressPools
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformNetworkStatus) DeepEqual(other *PlatformNetworkStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.ID == nil) != (other.ID == nil) {
+		return false
+	} else if in.ID != nil {
+		if *in.ID != *other.ID {
+			return false
+		}
+	}
+
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.DeploymentScope != other.DeploymentScope {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+		return false
+	}
+	if in.Delta != other.Delta {
+		return false
+	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformUpgradeSpec) DeepEqual(other *PlatformUpgradeSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Release == nil) != (other.Release == nil) {
+		return false
+	} else if in.Release != nil {
+		if *in.Release != *other.Release {
+			return false
+		}
+	}
+	if (in.KubernetesVersion == nil) != (other.KubernetesVersion == nil) {
+		return false
+	} else if in.KubernetesVersion != nil {
+		if *in.KubernetesVersion != *other.KubernetesVersion {
+			return false
+		}
+	}
+	if (in.Strategy == nil) != (other.Strategy == nil) {
+		return false
+	} else if in.Strategy != nil {
+		if !in.Strategy.DeepEqual(other.Strategy) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformUpgradeStatus) DeepEqual(other *PlatformUpgradeStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Phase == nil) != (other.Phase == nil) {
+		return false
+	} else if in.Phase != nil {
+		if *in.Phase != *other.Phase {
+			return false
+		}
+	}
+	if (in.Target == nil) != (other.Target == nil) {
+		return false
+	} else if in.Target != nil {
+		if *in.Target != *other.Target {
+			return false
+		}
+	}
+	if (in.StrategyType == nil) != (other.StrategyType == nil) {
+		return false
+	} else if in.StrategyType != nil {
+		if *in.StrategyType != *other.StrategyType {
+			return false
+		}
+	}
+	if (in.StrategyState == nil) != (other.StrategyState == nil) {
+		return false
+	} else if in.StrategyState != nil {
+		if *in.StrategyState != *other.StrategyState {
+			return false
+		}
+	}
+	if in.CurrentStage != other.CurrentStage {
+		return false
+	}
+	if in.TotalStages != other.TotalStages {
+		return false
+	}
+	if in.Percentage != other.Percentage {
+		return false
+	}
+	if (in.Reason == nil) != (other.Reason == nil) {
+		return false
+	} else if in.Reason != nil {
+		if *in.Reason != *other.Reason {
+			return false
+		}
+	}
+	if in.InProgress != other.InProgress {
+		return false
+	}
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformUsersSpec) DeepEqual(other *PlatformUsersSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Sysadmin == nil) != (other.Sysadmin == nil) {
+		return false
+	} else if in.Sysadmin != nil {
+		if !in.Sysadmin.DeepEqual(other.Sysadmin) {
+			return false
+		}
+	}
+	if (in.SecurityCompliance == nil) != (other.SecurityCompliance == nil) {
+		return false
+	} else if in.SecurityCompliance != nil {
+		if !in.SecurityCompliance.DeepEqual(other.SecurityCompliance) {
+			return false
+		}
+	}
+	if (in.Domain == nil) != (other.Domain == nil) {
+		return false
+	} else if in.Domain != nil {
+		if *in.Domain != *other.Domain {
+			return false
+		}
+	}
+	if ((in.Projects != nil) && (other.Projects != nil)) || ((in.Projects == nil) != (other.Projects == nil)) {
+		in, other := &in.Projects, &other.Projects
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+	if ((in.Users != nil) && (other.Users != nil)) || ((in.Users == nil) != (other.Users == nil)) {
+		in, other := &in.Users, &other.Users
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformUsersStatus) DeepEqual(other *PlatformUsersStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.PasswordVersions != nil) && (other.PasswordVersions != nil)) || ((in.PasswordVersions == nil) != (other.PasswordVersions == nil)) {
+		in, other := &in.PasswordVersions, &other.PasswordVersions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PortSelector) DeepEqual(other *PortSelector) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.PCIAddress == nil) != (other.PCIAddress == nil) {
+		return false
+	} else if in.PCIAddress != nil {
+		if *in.PCIAddress != *other.PCIAddress {
+			return false
+		}
+	}
+	if (in.MAC == nil) != (other.MAC == nil) {
 		return false
-	} else if in.AssetTag != nil {
-		if *in.AssetTag != *other.AssetTag {
+	} else if in.MAC != nil {
+		if *in.MAC != *other.MAC {
+			return false
+		}
+	}
+	if (in.VendorID == nil) != (other.VendorID == nil) {
+		return false
+	} else if in.VendorID != nil {
+		if *in.VendorID != *other.VendorID {
+			return false
+		}
+	}
+	if (in.DeviceID == nil) != (other.DeviceID == nil) {
+		return false
+	} else if in.DeviceID != nil {
+		if *in.DeviceID != *other.DeviceID {
+			return false
+		}
+	}
+	if (in.Driver == nil) != (other.Driver == nil) {
+		return false
+	} else if in.Driver != nil {
+		if *in.Driver != *other.Driver {
+			return false
+		}
+	}
+	if (in.NUMANode == nil) != (other.NUMANode == nil) {
+		return false
+	} else if in.NUMANode != nil {
+		if *in.NUMANode != *other.NUMANode {
 			return false
 		}
 	}
@@ -1202,32 +3191,38 @@ func (in *MatchDMIInfo) DeepEqual(other *MatchDMIInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MatchInfo) DeepEqual(other *MatchInfo) bool {
+func (in *ProcessorAllocationPolicy) DeepEqual(other *ProcessorAllocationPolicy) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.BootMAC == nil) != (other.BootMAC == nil) {
-		return false
-	} else if in.BootMAC != nil {
-		if *in.BootMAC != *other.BootMAC {
+	if in.Percent != nil {
+		if (in.Percent == nil) != (other.Percent == nil) {
 			return false
+		} else if in.Percent != nil {
+			if *in.Percent != *other.Percent {
+				return false
+			}
 		}
 	}
 
-	if (in.BoardManagement == nil) != (other.BoardManagement == nil) {
-		return false
-	} else if in.BoardManagement != nil {
-		if !in.BoardManagement.DeepEqual(other.BoardManagement) {
+	if in.Remaining != nil {
+		if (in.Remaining == nil) != (other.Remaining == nil) {
 			return false
+		} else if in.Remaining != nil {
+			if *in.Remaining != *other.Remaining {
+				return false
+			}
 		}
 	}
 
-	if (in.DMI == nil) != (other.DMI == nil) {
-		return false
-	} else if in.DMI != nil {
-		if !in.DMI.DeepEqual(other.DMI) {
+	if in.PerSocketLessPlatform != nil {
+		if (in.PerSocketLessPlatform == nil) != (other.PerSocketLessPlatform == nil) {
 			return false
+		} else if in.PerSocketLessPlatform != nil {
+			if *in.PerSocketLessPlatform != *other.PerSocketLessPlatform {
+				return false
+			}
 		}
 	}
 
@@ -1236,7 +3231,7 @@ func (in *MatchInfo) DeepEqual(other *MatchInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MemoryFunctionInfo) DeepEqual(other *MemoryFunctionInfo) bool {
+func (in *ProcessorFunctionInfo) DeepEqual(other *ProcessorFunctionInfo) bool {
 	if other == nil {
 		return false
 	}
@@ -1244,11 +3239,15 @@ func (in *MemoryFunctionInfo) DeepEqual(other *MemoryFunctionInfo) bool {
 	if in.Function != other.Function {
 		return false
 	}
-	if in.PageSize != other.PageSize {
+	if in.Count != other.Count {
 		return false
 	}
-	if in.PageCount != other.PageCount {
+	if (in.Policy == nil) != (other.Policy == nil) {
 		return false
+	} else if in.Policy != nil {
+		if !in.Policy.DeepEqual(other.Policy) {
+			return false
+		}
 	}
 
 	return true
@@ -1256,7 +3255,54 @@ func (in *MemoryFunctionInfo) DeepEqual(other *MemoryFunctionInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MemoryFunctionList) DeepEqual(other *MemoryFunctionList) bool {
+func (in *ProcessorFunctionList) DeepEqual(other *ProcessorFunctionList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ProcessorInfo) DeepEqual(other *ProcessorInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Node != other.Node {
+		return false
+	}
+	if ((in.Functions != nil) && (other.Functions != nil)) || ((in.Functions == nil) != (other.Functions == nil)) {
+		in, other := &in.Functions, &other.Functions
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ProcessorNodeList) DeepEqual(other *ProcessorNodeList) bool {
 	if other == nil {
 		return false
 	}
@@ -1272,54 +3318,249 @@ func (in *MemoryFunctionList) DeepEqual(other *MemoryFunctionList) bool {
 					break
 				}
 			}
-			if !found {
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Personality != nil {
+		if (in.Personality == nil) != (other.Personality == nil) {
+			return false
+		} else if in.Personality != nil {
+			if *in.Personality != *other.Personality {
+				return false
+			}
+		}
+	}
+
+	if in.AdministrativeState != nil {
+		if (in.AdministrativeState == nil) != (other.AdministrativeState == nil) {
+			return false
+		} else if in.AdministrativeState != nil {
+			if *in.AdministrativeState != *other.AdministrativeState {
+				return false
+			}
+		}
+	}
+
+	if ((in.SubFunctions != nil) && (other.SubFunctions != nil)) || ((in.SubFunctions == nil) != (other.SubFunctions == nil)) {
+		in, other := &in.SubFunctions, &other.SubFunctions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.Location != nil {
+		if (in.Location == nil) != (other.Location == nil) {
+			return false
+		} else if in.Location != nil {
+			if *in.Location != *other.Location {
+				return false
+			}
+		}
+	}
+
+	if ((in.Labels != nil) && (other.Labels != nil)) || ((in.Labels == nil) != (other.Labels == nil)) {
+		in, other := &in.Labels, &other.Labels
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	if in.InstallOutput != nil {
+		if (in.InstallOutput == nil) != (other.InstallOutput == nil) {
+			return false
+		} else if in.InstallOutput != nil {
+			if *in.InstallOutput != *other.InstallOutput {
+				return false
+			}
+		}
+	}
+
+	if in.Console != nil {
+		if (in.Console == nil) != (other.Console == nil) {
+			return false
+		} else if in.Console != nil {
+			if *in.Console != *other.Console {
+				return false
+			}
+		}
+	}
+
+	if in.BootDevice != nil {
+		if (in.BootDevice == nil) != (other.BootDevice == nil) {
+			return false
+		} else if in.BootDevice != nil {
+			if *in.BootDevice != *other.BootDevice {
+				return false
+			}
+		}
+	}
+
+	if in.BootDeviceSelector != nil {
+		if (in.BootDeviceSelector == nil) != (other.BootDeviceSelector == nil) {
+			return false
+		} else if in.BootDeviceSelector != nil {
+			if !in.BootDeviceSelector.DeepEqual(other.BootDeviceSelector) {
+				return false
+			}
+		}
+	}
+
+	if in.PowerOn != nil {
+		if (in.PowerOn == nil) != (other.PowerOn == nil) {
+			return false
+		} else if in.PowerOn != nil {
+			if *in.PowerOn != *other.PowerOn {
+				return false
+			}
+		}
+	}
+
+	if in.ProvisioningMode != nil {
+		if (in.ProvisioningMode == nil) != (other.ProvisioningMode == nil) {
+			return false
+		} else if in.ProvisioningMode != nil {
+			if *in.ProvisioningMode != *other.ProvisioningMode {
+				return false
+			}
+		}
+	}
+
+	if in.BootMAC != nil {
+		if (in.BootMAC == nil) != (other.BootMAC == nil) {
+			return false
+		} else if in.BootMAC != nil {
+			if *in.BootMAC != *other.BootMAC {
+				return false
+			}
+		}
+	}
+
+	if ((in.PtpInstances != nil) && (other.PtpInstances != nil)) || ((in.PtpInstances == nil) != (other.PtpInstances == nil)) {
+		in, other := &in.PtpInstances, &other.PtpInstances
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement == otherElement {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.RootDevice != nil {
+		if (in.RootDevice == nil) != (other.RootDevice == nil) {
+			return false
+		} else if in.RootDevice != nil {
+			if *in.RootDevice != *other.RootDevice {
 				return false
 			}
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *MemoryNodeInfo) DeepEqual(other *MemoryNodeInfo) bool {
-	if other == nil {
-		return false
+	if in.RootDeviceSelector != nil {
+		if (in.RootDeviceSelector == nil) != (other.RootDeviceSelector == nil) {
+			return false
+		} else if in.RootDeviceSelector != nil {
+			if !in.RootDeviceSelector.DeepEqual(other.RootDeviceSelector) {
+				return false
+			}
+		}
 	}
 
-	if in.Node != other.Node {
-		return false
-	}
-	if ((in.Functions != nil) && (other.Functions != nil)) || ((in.Functions == nil) != (other.Functions == nil)) {
-		in, other := &in.Functions, &other.Functions
-		if other == nil || !in.DeepEqual(other) {
+	if in.ClockSynchronization != nil {
+		if (in.ClockSynchronization == nil) != (other.ClockSynchronization == nil) {
 			return false
+		} else if in.ClockSynchronization != nil {
+			if *in.ClockSynchronization != *other.ClockSynchronization {
+				return false
+			}
 		}
 	}
 
-	return true
-}
+	if in.MaxCPUMhzConfigured != nil {
+		if (in.MaxCPUMhzConfigured == nil) != (other.MaxCPUMhzConfigured == nil) {
+			return false
+		} else if in.MaxCPUMhzConfigured != nil {
+			if *in.MaxCPUMhzConfigured != *other.MaxCPUMhzConfigured {
+				return false
+			}
+		}
+	}
 
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *MemoryNodeList) DeepEqual(other *MemoryNodeList) bool {
-	if other == nil {
-		return false
+	if in.AppArmor != nil {
+		if (in.AppArmor == nil) != (other.AppArmor == nil) {
+			return false
+		} else if in.AppArmor != nil {
+			if *in.AppArmor != *other.AppArmor {
+				return false
+			}
+		}
 	}
 
-	if len(*in) != len(*other) {
-		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
-				}
+	if in.HwSettle != nil {
+		if (in.HwSettle == nil) != (other.HwSettle == nil) {
+			return false
+		} else if in.HwSettle != nil {
+			if *in.HwSettle != *other.HwSettle {
+				return false
 			}
-			if !found {
+		}
+	}
+
+	if in.Kernel != nil {
+		if (in.Kernel == nil) != (other.Kernel == nil) {
+			return false
+		} else if in.Kernel != nil {
+			if *in.Kernel != *other.Kernel {
 				return false
 			}
 		}
@@ -1330,45 +3571,56 @@ func (in *MemoryNodeList) DeepEqual(other *MemoryNodeList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *MonitorInfo) DeepEqual(other *MonitorInfo) bool {
+func (in *ProfileStorageInfo) DeepEqual(other *ProfileStorageInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Size != nil {
-		if (in.Size == nil) != (other.Size == nil) {
+	if in.Monitor != nil {
+		if (in.Monitor == nil) != (other.Monitor == nil) {
 			return false
-		} else if in.Size != nil {
-			if *in.Size != *other.Size {
+		} else if in.Monitor != nil {
+			if !in.Monitor.DeepEqual(other.Monitor) {
 				return false
 			}
 		}
 	}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *NTPServerList) DeepEqual(other *NTPServerList) bool {
-	if other == nil {
-		return false
+	if ((in.OSDs != nil) && (other.OSDs != nil)) || ((in.OSDs == nil) != (other.OSDs == nil)) {
+		in, other := &in.OSDs, &other.OSDs
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
 	}
 
-	if len(*in) != len(*other) {
-		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement == otherElement {
-					found = true
-					break
+	if ((in.VolumeGroups != nil) && (other.VolumeGroups != nil)) || ((in.VolumeGroups == nil) != (other.VolumeGroups == nil)) {
+		in, other := &in.VolumeGroups, &other.VolumeGroups
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for _, inElement := range *in {
+				found := false
+				for _, otherElement := range *other {
+					if inElement.DeepEqual(&otherElement) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
 				}
 			}
-			if !found {
-				return false
-			}
+		}
+	}
+
+	if ((in.FileSystems != nil) && (other.FileSystems != nil)) || ((in.FileSystems == nil) != (other.FileSystems == nil)) {
+		in, other := &in.FileSystems, &other.FileSystems
+		if other == nil || !in.DeepEqual(other) {
+			return false
 		}
 	}
 
@@ -1377,35 +3629,26 @@ func (in *NTPServerList) DeepEqual(other *NTPServerList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *OSDInfo) DeepEqual(other *OSDInfo) bool {
+func (in *PtpHostStatus) DeepEqual(other *PtpHostStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Function != other.Function {
+	if in.Host != other.Host {
 		return false
 	}
-	if in.Path != other.Path {
+	if in.LockState != other.LockState {
 		return false
 	}
-	if in.ClusterName != nil {
-		if (in.ClusterName == nil) != (other.ClusterName == nil) {
+	if (in.ClockClass == nil) != (other.ClockClass == nil) {
+		return false
+	} else if in.ClockClass != nil {
+		if *in.ClockClass != *other.ClockClass {
 			return false
-		} else if in.ClusterName != nil {
-			if *in.ClusterName != *other.ClusterName {
-				return false
-			}
 		}
 	}
-
-	if in.Journal != nil {
-		if (in.Journal == nil) != (other.Journal == nil) {
-			return false
-		} else if in.Journal != nil {
-			if !in.Journal.DeepEqual(other.Journal) {
-				return false
-			}
-		}
+	if in.GNSSStatus != other.GNSSStatus {
+		return false
 	}
 
 	return true
@@ -1413,7 +3656,7 @@ func (in *OSDInfo) DeepEqual(other *OSDInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *OSDList) DeepEqual(other *OSDList) bool {
+func (in *PtpInstanceItemList) DeepEqual(other *PtpInstanceItemList) bool {
 	if other == nil {
 		return false
 	}
@@ -1424,7 +3667,7 @@ func (in *OSDList) DeepEqual(other *OSDList) bool {
 		for _, inElement := range *in {
 			found := false
 			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
+				if inElement == otherElement {
 					found = true
 					break
 				}
@@ -1440,91 +3683,77 @@ func (in *OSDList) DeepEqual(other *OSDList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PTPInfo) DeepEqual(other *PTPInfo) bool {
+func (in *PtpInstanceStatus) DeepEqual(other *PtpInstanceStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Mode != nil {
-		if (in.Mode == nil) != (other.Mode == nil) {
+	if (in.ID == nil) != (other.ID == nil) {
+		return false
+	} else if in.ID != nil {
+		if *in.ID != *other.ID {
 			return false
-		} else if in.Mode != nil {
-			if *in.Mode != *other.Mode {
-				return false
-			}
 		}
 	}
 
-	if in.Transport != nil {
-		if (in.Transport == nil) != (other.Transport == nil) {
-			return false
-		} else if in.Transport != nil {
-			if *in.Transport != *other.Transport {
-				return false
-			}
-		}
+	if in.Reconciled != other.Reconciled {
+		return false
 	}
-
-	if in.Mechanism != nil {
-		if (in.Mechanism == nil) != (other.Mechanism == nil) {
-			return false
-		} else if in.Mechanism != nil {
-			if *in.Mechanism != *other.Mechanism {
-				return false
-			}
-		}
+	if in.InSync != other.InSync {
+		return false
 	}
-
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *PhysicalVolumeInfo) DeepEqual(other *PhysicalVolumeInfo) bool {
-	if other == nil {
+	if in.DeploymentScope != other.DeploymentScope {
 		return false
 	}
-
-	if in.Type != other.Type {
+	if in.ObservedGeneration != other.ObservedGeneration {
 		return false
 	}
-	if in.Path != other.Path {
+	if in.ConfigurationUpdated != other.ConfigurationUpdated {
 		return false
 	}
-	if in.Size != nil {
-		if (in.Size == nil) != (other.Size == nil) {
+	if in.StrategyRequired != other.StrategyRequired {
+		return false
+	}
+	if in.Delta != other.Delta {
+		return false
+	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
 			return false
-		} else if in.Size != nil {
-			if *in.Size != *other.Size {
-				return false
-			}
 		}
 	}
+	if ((in.Hosts != nil) && (other.Hosts != nil)) || ((in.Hosts == nil) != (other.Hosts == nil)) {
+		in, other := &in.Hosts, &other.Hosts
+		if other == nil {
+			return false
+		}
 
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *PhysicalVolumeList) DeepEqual(other *PhysicalVolumeList) bool {
-	if other == nil {
-		return false
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
 	}
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
 
-	if len(*in) != len(*other) {
-		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
 				}
 			}
-			if !found {
-				return false
-			}
 		}
 	}
 
@@ -1533,7 +3762,7 @@ func (in *PhysicalVolumeList) DeepEqual(other *PhysicalVolumeList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PlatformNetworkItemList) DeepEqual(other *PlatformNetworkItemList) bool {
+func (in *PtpInterfaceItemList) DeepEqual(other *PtpInterfaceItemList) bool {
 	if other == nil {
 		return false
 	}
@@ -1560,19 +3789,16 @@ func (in *PlatformNetworkItemList) DeepEqual(other *PlatformNetworkItemList) boo
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PlatformNetworkSpec) DeepEqual(other *PlatformNetworkSpec) bool {
+func (in *PtpInterfaceSpec) DeepEqual(other *PtpInterfaceSpec) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Type != other.Type {
-		return false
-	}
-	if in.Dynamic != other.Dynamic {
+	if in.PtpInstance != other.PtpInstance {
 		return false
 	}
-	if ((in.AssociatedAddressPools != nil) && (other.AssociatedAddressPools != nil)) || ((in.AssociatedAddressPools == nil) != (other.AssociatedAddressPools == nil)) {
-		in, other := &in.AssociatedAddressPools, &other.AssociatedAddressPools
+	if ((in.InterfaceParameters != nil) && (other.InterfaceParameters != nil)) || ((in.InterfaceParameters == nil) != (other.InterfaceParameters == nil)) {
+		in, other := &in.InterfaceParameters, &other.InterfaceParameters
 		if other == nil {
 			return false
 		}
@@ -1593,7 +3819,7 @@ func (in *PlatformNetworkSpec) DeepEqual(other *PlatformNetworkSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PlatformNetworkStatus) DeepEqual(other *PlatformNetworkStatus) bool {
+func (in *PtpInterfaceStatus) DeepEqual(other *PtpInterfaceStatus) bool {
 	if other == nil {
 		return false
 	}
@@ -1621,25 +3847,18 @@ func (in *PlatformNetworkStatus) DeepEqual(other *PlatformNetworkStatus) bool {
 	if in.ConfigurationUpdated != other.ConfigurationUpdated {
 		return false
 	}
-	if in.Delta != other.Delta {
-		return false
-	}
-
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *ProcessorFunctionInfo) DeepEqual(other *ProcessorFunctionInfo) bool {
-	if other == nil {
+	if in.StrategyRequired != other.StrategyRequired {
 		return false
 	}
-
-	if in.Function != other.Function {
+	if in.Delta != other.Delta {
 		return false
 	}
-	if in.Count != other.Count {
+	if (in.Plan == nil) != (other.Plan == nil) {
 		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
 	}
 
 	return true
@@ -1647,107 +3866,39 @@ func (in *ProcessorFunctionInfo) DeepEqual(other *ProcessorFunctionInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *ProcessorFunctionList) DeepEqual(other *ProcessorFunctionList) bool {
+func (in *ReconfigurationCheck) DeepEqual(other *ReconfigurationCheck) bool {
 	if other == nil {
 		return false
 	}
 
-	if len(*in) != len(*other) {
+	if in.Name != other.Name {
 		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return false
-			}
-		}
 	}
-
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *ProcessorInfo) DeepEqual(other *ProcessorInfo) bool {
-	if other == nil {
+	if in.Passed != other.Passed {
 		return false
 	}
-
-	if in.Node != other.Node {
+	if in.Message != other.Message {
 		return false
 	}
-	if ((in.Functions != nil) && (other.Functions != nil)) || ((in.Functions == nil) != (other.Functions == nil)) {
-		in, other := &in.Functions, &other.Functions
-		if other == nil || !in.DeepEqual(other) {
-			return false
-		}
-	}
 
 	return true
 }
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *ProcessorNodeList) DeepEqual(other *ProcessorNodeList) bool {
+func (in *ReconfigurationStep) DeepEqual(other *ReconfigurationStep) bool {
 	if other == nil {
 		return false
 	}
 
-	if len(*in) != len(*other) {
+	if in.Name != other.Name {
 		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return false
-			}
-		}
 	}
-
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
-	if other == nil {
+	if in.Description != other.Description {
 		return false
 	}
-
-	if in.Personality != nil {
-		if (in.Personality == nil) != (other.Personality == nil) {
-			return false
-		} else if in.Personality != nil {
-			if *in.Personality != *other.Personality {
-				return false
-			}
-		}
-	}
-
-	if in.AdministrativeState != nil {
-		if (in.AdministrativeState == nil) != (other.AdministrativeState == nil) {
-			return false
-		} else if in.AdministrativeState != nil {
-			if *in.AdministrativeState != *other.AdministrativeState {
-				return false
-			}
-		}
-	}
-
-	if ((in.SubFunctions != nil) && (other.SubFunctions != nil)) || ((in.SubFunctions == nil) != (other.SubFunctions == nil)) {
-		in, other := &in.SubFunctions, &other.SubFunctions
+	if ((in.Hosts != nil) && (other.Hosts != nil)) || ((in.Hosts == nil) != (other.Hosts == nil)) {
+		in, other := &in.Hosts, &other.Hosts
 		if other == nil {
 			return false
 		}
@@ -1762,177 +3913,114 @@ func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
 			}
 		}
 	}
-
-	if in.Location != nil {
-		if (in.Location == nil) != (other.Location == nil) {
-			return false
-		} else if in.Location != nil {
-			if *in.Location != *other.Location {
-				return false
-			}
-		}
+	if in.State != other.State {
+		return false
 	}
 
-	if ((in.Labels != nil) && (other.Labels != nil)) || ((in.Labels == nil) != (other.Labels == nil)) {
-		in, other := &in.Labels, &other.Labels
-		if other == nil {
-			return false
-		}
+	return true
+}
 
-		if len(*in) != len(*other) {
-			return false
-		} else {
-			for key, inValue := range *in {
-				if otherValue, present := (*other)[key]; !present {
-					return false
-				} else {
-					if inValue != otherValue {
-						return false
-					}
-				}
-			}
-		}
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ResourceConsumer) DeepEqual(other *ResourceConsumer) bool {
+	if other == nil {
+		return false
 	}
 
-	if in.InstallOutput != nil {
-		if (in.InstallOutput == nil) != (other.InstallOutput == nil) {
-			return false
-		} else if in.InstallOutput != nil {
-			if *in.InstallOutput != *other.InstallOutput {
-				return false
-			}
-		}
+	if in.Host != other.Host {
+		return false
 	}
-
-	if in.Console != nil {
-		if (in.Console == nil) != (other.Console == nil) {
-			return false
-		} else if in.Console != nil {
-			if *in.Console != *other.Console {
-				return false
-			}
-		}
+	if in.Interface != other.Interface {
+		return false
 	}
-
-	if in.BootDevice != nil {
-		if (in.BootDevice == nil) != (other.BootDevice == nil) {
-			return false
-		} else if in.BootDevice != nil {
-			if *in.BootDevice != *other.BootDevice {
-				return false
-			}
-		}
+	if in.Configured != other.Configured {
+		return false
 	}
-
-	if in.PowerOn != nil {
-		if (in.PowerOn == nil) != (other.PowerOn == nil) {
-			return false
-		} else if in.PowerOn != nil {
-			if *in.PowerOn != *other.PowerOn {
-				return false
-			}
-		}
+	if in.Provisioned != other.Provisioned {
+		return false
 	}
 
-	if in.ProvisioningMode != nil {
-		if (in.ProvisioningMode == nil) != (other.ProvisioningMode == nil) {
-			return false
-		} else if in.ProvisioningMode != nil {
-			if *in.ProvisioningMode != *other.ProvisioningMode {
-				return false
-			}
-		}
-	}
+	return true
+}
 
-	if in.BootMAC != nil {
-		if (in.BootMAC == nil) != (other.BootMAC == nil) {
-			return false
-		} else if in.BootMAC != nil {
-			if *in.BootMAC != *other.BootMAC {
-				return false
-			}
-		}
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ResourceConsumerList) DeepEqual(other *ResourceConsumerList) bool {
+	if other == nil {
+		return false
 	}
 
-	if ((in.PtpInstances != nil) && (other.PtpInstances != nil)) || ((in.PtpInstances == nil) != (other.PtpInstances == nil)) {
-		in, other := &in.PtpInstances, &other.PtpInstances
-		if other == nil {
-			return false
-		}
-
-		if len(*in) != len(*other) {
-			return false
-		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement == otherElement {
-						found = true
-						break
-					}
-				}
-				if !found {
-					return false
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
 				}
 			}
-		}
-	}
-
-	if in.RootDevice != nil {
-		if (in.RootDevice == nil) != (other.RootDevice == nil) {
-			return false
-		} else if in.RootDevice != nil {
-			if *in.RootDevice != *other.RootDevice {
+			if !found {
 				return false
 			}
 		}
 	}
 
-	if in.ClockSynchronization != nil {
-		if (in.ClockSynchronization == nil) != (other.ClockSynchronization == nil) {
-			return false
-		} else if in.ClockSynchronization != nil {
-			if *in.ClockSynchronization != *other.ClockSynchronization {
-				return false
-			}
-		}
-	}
+	return true
+}
 
-	if in.MaxCPUMhzConfigured != nil {
-		if (in.MaxCPUMhzConfigured == nil) != (other.MaxCPUMhzConfigured == nil) {
-			return false
-		} else if in.MaxCPUMhzConfigured != nil {
-			if *in.MaxCPUMhzConfigured != *other.MaxCPUMhzConfigured {
-				return false
-			}
-		}
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *RouteInfo) DeepEqual(other *RouteInfo) bool {
+	if other == nil {
+		return false
 	}
 
-	if in.AppArmor != nil {
-		if (in.AppArmor == nil) != (other.AppArmor == nil) {
+	if in.Interface != other.Interface {
+		return false
+	}
+	if in.Network != other.Network {
+		return false
+	}
+	if in.Prefix != other.Prefix {
+		return false
+	}
+	if in.Gateway != other.Gateway {
+		return false
+	}
+	if in.Metric != nil {
+		if (in.Metric == nil) != (other.Metric == nil) {
 			return false
-		} else if in.AppArmor != nil {
-			if *in.AppArmor != *other.AppArmor {
+		} else if in.Metric != nil {
+			if *in.Metric != *other.Metric {
 				return false
 			}
 		}
 	}
 
-	if in.HwSettle != nil {
-		if (in.HwSettle == nil) != (other.HwSettle == nil) {
-			return false
-		} else if in.HwSettle != nil {
-			if *in.HwSettle != *other.HwSettle {
-				return false
-			}
-		}
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *RouteList) DeepEqual(other *RouteList) bool {
+	if other == nil {
+		return false
 	}
 
-	if in.Kernel != nil {
-		if (in.Kernel == nil) != (other.Kernel == nil) {
-			return false
-		} else if in.Kernel != nil {
-			if *in.Kernel != *other.Kernel {
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
 				return false
 			}
 		}
@@ -1943,55 +4031,60 @@ func (in *ProfileBaseAttributes) DeepEqual(other *ProfileBaseAttributes) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *ProfileStorageInfo) DeepEqual(other *ProfileStorageInfo) bool {
+func (in *SecurityComplianceInfo) DeepEqual(other *SecurityComplianceInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Monitor != nil {
-		if (in.Monitor == nil) != (other.Monitor == nil) {
+	if (in.LockoutSeconds == nil) != (other.LockoutSeconds == nil) {
+		return false
+	} else if in.LockoutSeconds != nil {
+		if *in.LockoutSeconds != *other.LockoutSeconds {
 			return false
-		} else if in.Monitor != nil {
-			if !in.Monitor.DeepEqual(other.Monitor) {
-				return false
-			}
 		}
 	}
-
-	if ((in.OSDs != nil) && (other.OSDs != nil)) || ((in.OSDs == nil) != (other.OSDs == nil)) {
-		in, other := &in.OSDs, &other.OSDs
-		if other == nil || !in.DeepEqual(other) {
+	if (in.LockoutRetries == nil) != (other.LockoutRetries == nil) {
+		return false
+	} else if in.LockoutRetries != nil {
+		if *in.LockoutRetries != *other.LockoutRetries {
 			return false
 		}
 	}
 
-	if ((in.VolumeGroups != nil) && (other.VolumeGroups != nil)) || ((in.VolumeGroups == nil) != (other.VolumeGroups == nil)) {
-		in, other := &in.VolumeGroups, &other.VolumeGroups
-		if other == nil {
-			return false
-		}
+	return true
+}
 
-		if len(*in) != len(*other) {
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ServiceParameterInfo) DeepEqual(other *ServiceParameterInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Service != other.Service {
+		return false
+	}
+	if in.Section != other.Section {
+		return false
+	}
+	if in.ParamName != other.ParamName {
+		return false
+	}
+	if in.ParamValue != other.ParamValue {
+		return false
+	}
+	if (in.Personality == nil) != (other.Personality == nil) {
+		return false
+	} else if in.Personality != nil {
+		if *in.Personality != *other.Personality {
 			return false
-		} else {
-			for _, inElement := range *in {
-				found := false
-				for _, otherElement := range *other {
-					if inElement.DeepEqual(&otherElement) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					return false
-				}
-			}
 		}
 	}
 
-	if ((in.FileSystems != nil) && (other.FileSystems != nil)) || ((in.FileSystems == nil) != (other.FileSystems == nil)) {
-		in, other := &in.FileSystems, &other.FileSystems
-		if other == nil || !in.DeepEqual(other) {
+	if (in.Resource == nil) != (other.Resource == nil) {
+		return false
+	} else if in.Resource != nil {
+		if *in.Resource != *other.Resource {
 			return false
 		}
 	}
@@ -2001,7 +4094,7 @@ func (in *ProfileStorageInfo) DeepEqual(other *ProfileStorageInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInstanceItemList) DeepEqual(other *PtpInstanceItemList) bool {
+func (in *ServiceParameterList) DeepEqual(other *ServiceParameterList) bool {
 	if other == nil {
 		return false
 	}
@@ -2012,7 +4105,7 @@ func (in *PtpInstanceItemList) DeepEqual(other *PtpInstanceItemList) bool {
 		for _, inElement := range *in {
 			found := false
 			for _, otherElement := range *other {
-				if inElement == otherElement {
+				if inElement.DeepEqual(&otherElement) {
 					found = true
 					break
 				}
@@ -2028,39 +4121,65 @@ func (in *PtpInstanceItemList) DeepEqual(other *PtpInstanceItemList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInstanceStatus) DeepEqual(other *PtpInstanceStatus) bool {
+func (in *StorageBackend) DeepEqual(other *StorageBackend) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.ID == nil) != (other.ID == nil) {
-		return false
-	} else if in.ID != nil {
-		if *in.ID != *other.ID {
-			return false
-		}
-	}
-
-	if in.Reconciled != other.Reconciled {
+	if in.Name != other.Name {
 		return false
 	}
-	if in.InSync != other.InSync {
+	if in.Type != other.Type {
 		return false
 	}
-	if in.DeploymentScope != other.DeploymentScope {
-		return false
+	if ((in.Services != nil) && (other.Services != nil)) || ((in.Services == nil) != (other.Services == nil)) {
+		in, other := &in.Services, &other.Services
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
 	}
-	if in.ObservedGeneration != other.ObservedGeneration {
+
+	if in.Deployment != other.Deployment {
 		return false
 	}
-	if in.ConfigurationUpdated != other.ConfigurationUpdated {
-		return false
+	if in.ReplicationFactor != nil {
+		if (in.ReplicationFactor == nil) != (other.ReplicationFactor == nil) {
+			return false
+		} else if in.ReplicationFactor != nil {
+			if *in.ReplicationFactor != *other.ReplicationFactor {
+				return false
+			}
+		}
 	}
-	if in.StrategyRequired != other.StrategyRequired {
-		return false
+
+	if in.PartitionSize != nil {
+		if (in.PartitionSize == nil) != (other.PartitionSize == nil) {
+			return false
+		} else if in.PartitionSize != nil {
+			if *in.PartitionSize != *other.PartitionSize {
+				return false
+			}
+		}
 	}
-	if in.Delta != other.Delta {
-		return false
+
+	if in.Network != nil {
+		if (in.Network == nil) != (other.Network == nil) {
+			return false
+		} else if in.Network != nil {
+			if *in.Network != *other.Network {
+				return false
+			}
+		}
 	}
 
 	return true
@@ -2068,7 +4187,7 @@ func (in *PtpInstanceStatus) DeepEqual(other *PtpInstanceStatus) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInterfaceItemList) DeepEqual(other *PtpInterfaceItemList) bool {
+func (in *StorageBackendList) DeepEqual(other *StorageBackendList) bool {
 	if other == nil {
 		return false
 	}
@@ -2079,7 +4198,7 @@ func (in *PtpInterfaceItemList) DeepEqual(other *PtpInterfaceItemList) bool {
 		for _, inElement := range *in {
 			found := false
 			for _, otherElement := range *other {
-				if inElement == otherElement {
+				if inElement.DeepEqual(&otherElement) {
 					found = true
 					break
 				}
@@ -2095,28 +4214,46 @@ func (in *PtpInterfaceItemList) DeepEqual(other *PtpInterfaceItemList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInterfaceSpec) DeepEqual(other *PtpInterfaceSpec) bool {
+func (in *StorageTierInfo) DeepEqual(other *StorageTierInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.PtpInstance != other.PtpInstance {
+	if in.Name != other.Name {
 		return false
 	}
-	if ((in.InterfaceParameters != nil) && (other.InterfaceParameters != nil)) || ((in.InterfaceParameters == nil) != (other.InterfaceParameters == nil)) {
-		in, other := &in.InterfaceParameters, &other.InterfaceParameters
-		if other == nil {
+	if (in.ClusterName == nil) != (other.ClusterName == nil) {
+		return false
+	} else if in.ClusterName != nil {
+		if *in.ClusterName != *other.ClusterName {
 			return false
 		}
+	}
 
-		if len(*in) != len(*other) {
-			return false
-		} else {
-			for i, inElement := range *in {
-				if inElement != (*other)[i] {
-					return false
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *StorageTierList) DeepEqual(other *StorageTierList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement.DeepEqual(&otherElement) {
+					found = true
+					break
 				}
 			}
+			if !found {
+				return false
+			}
 		}
 	}
 
@@ -2125,39 +4262,38 @@ func (in *PtpInterfaceSpec) DeepEqual(other *PtpInterfaceSpec) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PtpInterfaceStatus) DeepEqual(other *PtpInterfaceStatus) bool {
+func (in *SubcloudBackupInfo) DeepEqual(other *SubcloudBackupInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if (in.ID == nil) != (other.ID == nil) {
+	if (in.LocalOnly == nil) != (other.LocalOnly == nil) {
 		return false
-	} else if in.ID != nil {
-		if *in.ID != *other.ID {
+	} else if in.LocalOnly != nil {
+		if *in.LocalOnly != *other.LocalOnly {
 			return false
 		}
 	}
-
-	if in.Reconciled != other.Reconciled {
-		return false
-	}
-	if in.InSync != other.InSync {
-		return false
-	}
-	if in.DeploymentScope != other.DeploymentScope {
-		return false
-	}
-	if in.ObservedGeneration != other.ObservedGeneration {
-		return false
-	}
-	if in.ConfigurationUpdated != other.ConfigurationUpdated {
+	if (in.RegistryImages == nil) != (other.RegistryImages == nil) {
 		return false
+	} else if in.RegistryImages != nil {
+		if *in.RegistryImages != *other.RegistryImages {
+			return false
+		}
 	}
-	if in.StrategyRequired != other.StrategyRequired {
+	if (in.Values == nil) != (other.Values == nil) {
 		return false
+	} else if in.Values != nil {
+		if *in.Values != *other.Values {
+			return false
+		}
 	}
-	if in.Delta != other.Delta {
+	if (in.Request == nil) != (other.Request == nil) {
 		return false
+	} else if in.Request != nil {
+		if *in.Request != *other.Request {
+			return false
+		}
 	}
 
 	return true
@@ -2165,30 +4301,26 @@ func (in *PtpInterfaceStatus) DeepEqual(other *PtpInterfaceStatus) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *RouteInfo) DeepEqual(other *RouteInfo) bool {
+func (in *SubcloudBundleInfo) DeepEqual(other *SubcloudBundleInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Interface != other.Interface {
+	if in.Secret != other.Secret {
 		return false
 	}
-	if in.Network != other.Network {
-		return false
-	}
-	if in.Prefix != other.Prefix {
+	if (in.Key == nil) != (other.Key == nil) {
 		return false
+	} else if in.Key != nil {
+		if *in.Key != *other.Key {
+			return false
+		}
 	}
-	if in.Gateway != other.Gateway {
+	if (in.Namespace == nil) != (other.Namespace == nil) {
 		return false
-	}
-	if in.Metric != nil {
-		if (in.Metric == nil) != (other.Metric == nil) {
+	} else if in.Namespace != nil {
+		if *in.Namespace != *other.Namespace {
 			return false
-		} else if in.Metric != nil {
-			if *in.Metric != *other.Metric {
-				return false
-			}
 		}
 	}
 
@@ -2197,26 +4329,13 @@ func (in *RouteInfo) DeepEqual(other *RouteInfo) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *RouteList) DeepEqual(other *RouteList) bool {
+func (in *SubcloudCredentialsInfo) DeepEqual(other *SubcloudCredentialsInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if len(*in) != len(*other) {
+	if in.Secret != other.Secret {
 		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return false
-			}
-		}
 	}
 
 	return true
@@ -2224,63 +4343,77 @@ func (in *RouteList) DeepEqual(other *RouteList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *ServiceParameterInfo) DeepEqual(other *ServiceParameterInfo) bool {
+func (in *SubcloudSpec) DeepEqual(other *SubcloudSpec) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Service != other.Service {
+	if in.BootstrapAddress != other.BootstrapAddress {
 		return false
 	}
-	if in.Section != other.Section {
+	if in.BootstrapValues != other.BootstrapValues {
 		return false
 	}
-	if in.ParamName != other.ParamName {
+	if (in.InstallValues == nil) != (other.InstallValues == nil) {
 		return false
+	} else if in.InstallValues != nil {
+		if *in.InstallValues != *other.InstallValues {
+			return false
+		}
 	}
-	if in.ParamValue != other.ParamValue {
+	if (in.DeployConfig == nil) != (other.DeployConfig == nil) {
 		return false
+	} else if in.DeployConfig != nil {
+		if *in.DeployConfig != *other.DeployConfig {
+			return false
+		}
 	}
-	if (in.Personality == nil) != (other.Personality == nil) {
+	if !in.Credentials.DeepEqual(&other.Credentials) {
 		return false
-	} else if in.Personality != nil {
-		if *in.Personality != *other.Personality {
+	}
+	if (in.Group == nil) != (other.Group == nil) {
+		return false
+	} else if in.Group != nil {
+		if *in.Group != *other.Group {
 			return false
 		}
 	}
-
-	if (in.Resource == nil) != (other.Resource == nil) {
+	if (in.Release == nil) != (other.Release == nil) {
 		return false
-	} else if in.Resource != nil {
-		if *in.Resource != *other.Resource {
+	} else if in.Release != nil {
+		if *in.Release != *other.Release {
 			return false
 		}
 	}
-
-	return true
-}
-
-// DeepEqual is an autogenerated deepequal function, deeply comparing the
-// receiver with other. in must be non-nil.
-func (in *ServiceParameterList) DeepEqual(other *ServiceParameterList) bool {
-	if other == nil {
+	if (in.Description == nil) != (other.Description == nil) {
+		return false
+	} else if in.Description != nil {
+		if *in.Description != *other.Description {
+			return false
+		}
+	}
+	if (in.Location == nil) != (other.Location == nil) {
 		return false
+	} else if in.Location != nil {
+		if *in.Location != *other.Location {
+			return false
+		}
 	}
-
-	if len(*in) != len(*other) {
+	if in.Management != other.Management {
 		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return false
-			}
+	}
+	if (in.Backup == nil) != (other.Backup == nil) {
+		return false
+	} else if in.Backup != nil {
+		if !in.Backup.DeepEqual(other.Backup) {
+			return false
+		}
+	}
+	if (in.Bundle == nil) != (other.Bundle == nil) {
+		return false
+	} else if in.Bundle != nil {
+		if !in.Bundle.DeepEqual(other.Bundle) {
+			return false
 		}
 	}
 
@@ -2289,64 +4422,88 @@ func (in *ServiceParameterList) DeepEqual(other *ServiceParameterList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *StorageBackend) DeepEqual(other *StorageBackend) bool {
+func (in *SubcloudStatus) DeepEqual(other *SubcloudStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Name != other.Name {
+	if (in.ID == nil) != (other.ID == nil) {
+		return false
+	} else if in.ID != nil {
+		if *in.ID != *other.ID {
+			return false
+		}
+	}
+	if (in.DeployStatus == nil) != (other.DeployStatus == nil) {
 		return false
+	} else if in.DeployStatus != nil {
+		if *in.DeployStatus != *other.DeployStatus {
+			return false
+		}
 	}
-	if in.Type != other.Type {
+	if (in.AvailabilityStatus == nil) != (other.AvailabilityStatus == nil) {
 		return false
+	} else if in.AvailabilityStatus != nil {
+		if *in.AvailabilityStatus != *other.AvailabilityStatus {
+			return false
+		}
 	}
-	if ((in.Services != nil) && (other.Services != nil)) || ((in.Services == nil) != (other.Services == nil)) {
-		in, other := &in.Services, &other.Services
-		if other == nil {
+	if (in.ManagementState == nil) != (other.ManagementState == nil) {
+		return false
+	} else if in.ManagementState != nil {
+		if *in.ManagementState != *other.ManagementState {
 			return false
 		}
-
-		if len(*in) != len(*other) {
+	}
+	if (in.BackupStatus == nil) != (other.BackupStatus == nil) {
+		return false
+	} else if in.BackupStatus != nil {
+		if *in.BackupStatus != *other.BackupStatus {
 			return false
-		} else {
-			for i, inElement := range *in {
-				if inElement != (*other)[i] {
-					return false
-				}
-			}
 		}
 	}
-
-	if in.Deployment != other.Deployment {
+	if (in.SoftwareVersion == nil) != (other.SoftwareVersion == nil) {
 		return false
+	} else if in.SoftwareVersion != nil {
+		if *in.SoftwareVersion != *other.SoftwareVersion {
+			return false
+		}
 	}
-	if in.ReplicationFactor != nil {
-		if (in.ReplicationFactor == nil) != (other.ReplicationFactor == nil) {
+	if (in.DeployConfigHash == nil) != (other.DeployConfigHash == nil) {
+		return false
+	} else if in.DeployConfigHash != nil {
+		if *in.DeployConfigHash != *other.DeployConfigHash {
 			return false
-		} else if in.ReplicationFactor != nil {
-			if *in.ReplicationFactor != *other.ReplicationFactor {
-				return false
-			}
 		}
 	}
-
-	if in.PartitionSize != nil {
-		if (in.PartitionSize == nil) != (other.PartitionSize == nil) {
+	if (in.BackupRequest == nil) != (other.BackupRequest == nil) {
+		return false
+	} else if in.BackupRequest != nil {
+		if *in.BackupRequest != *other.BackupRequest {
 			return false
-		} else if in.PartitionSize != nil {
-			if *in.PartitionSize != *other.PartitionSize {
-				return false
-			}
 		}
 	}
-
-	if in.Network != nil {
-		if (in.Network == nil) != (other.Network == nil) {
+	if (in.BundleHash == nil) != (other.BundleHash == nil) {
+		return false
+	} else if in.BundleHash != nil {
+		if *in.BundleHash != *other.BundleHash {
+			return false
+		}
+	}
+	if in.Reconciled != other.Reconciled {
+		return false
+	}
+	if in.InSync != other.InSync {
+		return false
+	}
+	if in.ObservedGeneration != other.ObservedGeneration {
+		return false
+	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
 			return false
-		} else if in.Network != nil {
-			if *in.Network != *other.Network {
-				return false
-			}
 		}
 	}
 
@@ -2355,25 +4512,16 @@ func (in *StorageBackend) DeepEqual(other *StorageBackend) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *StorageBackendList) DeepEqual(other *StorageBackendList) bool {
+func (in *SysadminInfo) DeepEqual(other *SysadminInfo) bool {
 	if other == nil {
 		return false
 	}
 
-	if len(*in) != len(*other) {
+	if (in.PasswordExpiryDays == nil) != (other.PasswordExpiryDays == nil) {
 		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement.DeepEqual(&otherElement) {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return false
-			}
+	} else if in.PasswordExpiryDays != nil {
+		if *in.PasswordExpiryDays != *other.PasswordExpiryDays {
+			return false
 		}
 	}
 
@@ -2461,6 +4609,16 @@ func (in *SystemSpec) DeepEqual(other *SystemSpec) bool {
 		}
 	}
 
+	if in.RemoteLogging != nil {
+		if (in.RemoteLogging == nil) != (other.RemoteLogging == nil) {
+			return false
+		} else if in.RemoteLogging != nil {
+			if !in.RemoteLogging.DeepEqual(other.RemoteLogging) {
+				return false
+			}
+		}
+	}
+
 	if ((in.Certificates != nil) && (other.Certificates != nil)) || ((in.Certificates == nil) != (other.Certificates == nil)) {
 		in, other := &in.Certificates, &other.Certificates
 		if other == nil || !in.DeepEqual(other) {
@@ -2562,6 +4720,13 @@ func (in *SystemStatus) DeepEqual(other *SystemStatus) bool {
 	if in.StrategyRetryCount != other.StrategyRetryCount {
 		return false
 	}
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -2597,6 +4762,66 @@ func (in *SystemStorageInfo) DeepEqual(other *SystemStorageInfo) bool {
 		}
 	}
 
+	if ((in.Tiers != nil) && (other.Tiers != nil)) || ((in.Tiers == nil) != (other.Tiers == nil)) {
+		in, other := &in.Tiers, &other.Tiers
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *UpgradeStrategyInfo) DeepEqual(other *UpgradeStrategyInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.ControllerApplyType == nil) != (other.ControllerApplyType == nil) {
+		return false
+	} else if in.ControllerApplyType != nil {
+		if *in.ControllerApplyType != *other.ControllerApplyType {
+			return false
+		}
+	}
+	if (in.StorageApplyType == nil) != (other.StorageApplyType == nil) {
+		return false
+	} else if in.StorageApplyType != nil {
+		if *in.StorageApplyType != *other.StorageApplyType {
+			return false
+		}
+	}
+	if (in.WorkerApplyType == nil) != (other.WorkerApplyType == nil) {
+		return false
+	} else if in.WorkerApplyType != nil {
+		if *in.WorkerApplyType != *other.WorkerApplyType {
+			return false
+		}
+	}
+	if (in.MaxParallelWorkers == nil) != (other.MaxParallelWorkers == nil) {
+		return false
+	} else if in.MaxParallelWorkers != nil {
+		if *in.MaxParallelWorkers != *other.MaxParallelWorkers {
+			return false
+		}
+	}
+	if (in.DefaultInstanceAction == nil) != (other.DefaultInstanceAction == nil) {
+		return false
+	} else if in.DefaultInstanceAction != nil {
+		if *in.DefaultInstanceAction != *other.DefaultInstanceAction {
+			return false
+		}
+	}
+	if (in.AlarmRestrictions == nil) != (other.AlarmRestrictions == nil) {
+		return false
+	} else if in.AlarmRestrictions != nil {
+		if *in.AlarmRestrictions != *other.AlarmRestrictions {
+			return false
+		}
+	}
+
 	return true
 }
 
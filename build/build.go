@@ -24,8 +24,10 @@ import (
 	perrors "github.com/pkg/errors"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	ctrlcommon "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/lvgs"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -54,6 +56,7 @@ type DeploymentBuilder struct {
 	profileFilters         []ProfileFilter
 	hostFilters            []HostFilter
 	platformNetworkFilters []PlatformNetworkFilter
+	relativeFileSystems    bool
 }
 
 var defaultSystemFilters = []SystemFilter{
@@ -184,6 +187,13 @@ func (db *DeploymentBuilder) AddPlatformNetworkFilters(filters []PlatformNetwork
 	db.platformNetworkFilters = append(db.platformNetworkFilters, filters...)
 }
 
+// UseRelativeFileSystemSizes requests that host and controller filesystem
+// sizes be exported as size policies relative to the space available in the
+// cgts-vg volume group rather than as absolute sizes.
+func (db *DeploymentBuilder) UseRelativeFileSystemSizes() {
+	db.relativeFileSystems = true
+}
+
 // Build is the main method which produces a deployment object based on a
 // running system.
 func (db *DeploymentBuilder) Build() (*Deployment, error) {
@@ -461,6 +471,19 @@ func (db *DeploymentBuilder) buildSystem(d *Deployment) (*v1info.SystemInfo, err
 		return nil, err
 	}
 
+	if db.relativeFileSystems && system.Spec.Storage != nil {
+		available, err := db.controllerVolumeGroupAvailableSize()
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range system.Spec.Storage.FileSystems {
+			fs := &system.Spec.Storage.FileSystems[i]
+			fs.SizePolicy = relativeFileSystemSize(fs.Size, available)
+			fs.Size = 0
+		}
+	}
+
 	db.progressUpdate("...filtering system attributes\n")
 
 	err = db.filterSystem(system, d)
@@ -799,6 +822,15 @@ func (db *DeploymentBuilder) buildHostsAndProfiles(d *Deployment) error {
 			filterCephHostFS(&profile.Spec)
 		}
 
+		if db.relativeFileSystems && profile.Spec.Storage != nil {
+			available := hostInfo.VolumeGroupAvailableSize(ctrlcommon.LVG_CGTS_VG)
+			for i := range profile.Spec.Storage.FileSystems {
+				fs := &profile.Spec.Storage.FileSystems[i]
+				fs.SizePolicy = relativeFileSystemSize(fs.Size, available)
+				fs.Size = 0
+			}
+		}
+
 		// Force the provisioning mode to static until there is a need to make
 		// this optional.
 		static := starlingxv1.ProvioningModeStatic
@@ -934,3 +966,51 @@ func filterCephHostFS(spec *starlingxv1.HostProfileSpec) {
 	}
 	spec.Storage.FileSystems = filtered
 }
+
+// relativeFileSystemSize converts an absolute filesystem size into a size
+// policy expressed as a percentage of the space available to the filesystem.
+// The percentage is rounded down so that resolving the policy on the system
+// it was collected from never grows the filesystem.
+func relativeFileSystemSize(size int, available int) *starlingxv1.FileSystemSizePolicy {
+	if available <= 0 {
+		maxAvailable := true
+		return &starlingxv1.FileSystemSizePolicy{MaxAvailable: &maxAvailable}
+	}
+
+	percent := max(size*100/(size+available), 1)
+	return &starlingxv1.FileSystemSizePolicy{Percent: &percent}
+}
+
+// controllerVolumeGroupAvailableSize returns the smallest space left in the
+// cgts-vg volume group across all controllers.
+func (db *DeploymentBuilder) controllerVolumeGroupAvailableSize() (int, error) {
+	results, err := hosts.ListHosts(db.client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return 0, err
+	}
+
+	available := -1
+	for _, h := range results {
+		if h.Personality != hosts.PersonalityController {
+			continue
+		}
+
+		groups, err := lvgs.ListVolumeGroups(db.client, h.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list volume groups for host %s", h.ID)
+			return 0, err
+		}
+
+		size := 0
+		if vg, ok := lvgs.FindVolumeGroup(groups, ctrlcommon.LVG_CGTS_VG); ok {
+			size = vg.AvailableSize()
+		}
+
+		if available < 0 || size < available {
+			available = size
+		}
+	}
+
+	return max(available, 0), nil
+}
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package build
 
@@ -798,8 +798,9 @@ func (in *FileSystemFilter) Filter(system *v1.System, deployment *Deployment) er
 	for _, fs := range system.Spec.Storage.FileSystems {
 		if fs.Name == "backup" || fs.Name == "database" || fs.Name == "instances" || fs.Name == "image-conversion" {
 			info := v1.ControllerFileSystemInfo{
-				Name: fs.Name,
-				Size: fs.Size,
+				Name:       fs.Name,
+				Size:       fs.Size,
+				SizePolicy: fs.SizePolicy,
 			}
 			result = append(result, info)
 		}
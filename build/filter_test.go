@@ -1595,3 +1595,23 @@ var _ = Describe("Test filters utilities:", func() {
 		})
 	})
 })
+
+var _ = Describe("Test relative filesystem sizes:", func() {
+	It("should express sizes as a percentage of the available space", func() {
+		policy := relativeFileSystemSize(30, 70)
+		Expect(*policy.Percent).To(Equal(30))
+		Expect(policy.MaxAvailable).To(BeNil())
+		Expect(policy.Resolve(0, 30, 70)).To(Equal(30))
+	})
+
+	It("should never resolve to a larger size on the same system", func() {
+		policy := relativeFileSystemSize(33, 67)
+		Expect(policy.Resolve(0, 33, 67)).To(Equal(33))
+	})
+
+	It("should use all of the available space when none is left", func() {
+		policy := relativeFileSystemSize(30, 0)
+		Expect(*policy.MaxAvailable).To(BeTrue())
+		Expect(policy.Percent).To(BeNil())
+	})
+})
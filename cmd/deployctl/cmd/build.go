@@ -32,6 +32,7 @@ const (
 	NormalizeInterfaceMTUFilterArg   = "normalize-mtu"
 	NormalizeConsoleFilterArg        = "normalize-console"
 	MinimalConfigFilterArg           = "minimal-config"
+	RelativeFileSystemsArg           = "relative-filesystems"
 )
 
 func CollectCmdRun(cmd *cobra.Command, args []string) {
@@ -51,6 +52,7 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 	var noDefaults bool
 	var noMemory bool
 	var noSysVg bool
+	var relativeFileSystems bool
 	var name string
 	var err error
 
@@ -169,6 +171,12 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		os.Exit(16)
 	}
 
+	if relativeFileSystems, err = cmd.Flags().GetBool(RelativeFileSystemsArg); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			RelativeFileSystemsArg)
+		os.Exit(17)
+	}
+
 	if minimalConfig {
 		noCACertificates = true
 		noDefaults = true
@@ -249,6 +257,10 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 
 	builder := build.NewDeploymentBuilder(client, namespace, name, os.Stdout)
 
+	if relativeFileSystems {
+		builder.UseRelativeFileSystemSizes()
+	}
+
 	profileFilters := make([]build.ProfileFilter, 0)
 
 	if noDefaults {
@@ -399,4 +411,5 @@ func init() {
 	collectCmd.Flags().Bool(NormalizeInterfaceMTUFilterArg, false, "Normalize interface MTU values")
 	collectCmd.Flags().Bool(NormalizeConsoleFilterArg, false, "Normalize serial console attributes")
 	collectCmd.Flags().Bool(MinimalConfigFilterArg, false, "Shorthand notation for adding all available filters")
+	collectCmd.Flags().Bool(RelativeFileSystemsArg, false, "Export filesystem sizes relative to the available volume group space")
 }
@@ -199,6 +199,48 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "PtpInterface")
 		os.Exit(1)
 	}
+	if err = (&controller.PlatformApplicationReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PlatformApplication")
+		os.Exit(1)
+	}
+	if err = (&controller.PlatformUpgradeReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PlatformUpgrade")
+		os.Exit(1)
+	}
+	if err = (&controller.SubcloudReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Subcloud")
+		os.Exit(1)
+	}
+	if err = (&controller.PlatformUsersReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PlatformUsers")
+		os.Exit(1)
+	}
+	if err = (&controller.HostPoolReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "HostPool")
+		os.Exit(1)
+	}
+	if err = (&controller.NetworkReconfigurationReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NetworkReconfiguration")
+		os.Exit(1)
+	}
 	if err = (&system.SystemReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
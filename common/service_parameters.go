@@ -0,0 +1,236 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ServiceParamType defines the type of value accepted by a service parameter.
+type ServiceParamType string
+
+// Defines the supported service parameter value types.
+const (
+	ServiceParamTypeString  ServiceParamType = "string"
+	ServiceParamTypeInteger ServiceParamType = "integer"
+	ServiceParamTypeBoolean ServiceParamType = "boolean"
+	ServiceParamTypeEnum    ServiceParamType = "enum"
+)
+
+// ServiceParamImpact defines what is required for a change to a service
+// parameter to take effect.
+type ServiceParamImpact string
+
+// Defines the supported service parameter impacts.
+const (
+	// ServiceParamImpactNone denotes a parameter that takes effect as soon
+	// as it is modified.
+	ServiceParamImpactNone ServiceParamImpact = "none"
+
+	// ServiceParamImpactApply denotes a parameter that takes effect once its
+	// service parameters are explicitly applied.
+	ServiceParamImpactApply ServiceParamImpact = "apply"
+
+	// ServiceParamImpactApiserverRestart denotes a parameter that takes
+	// effect once its service parameters are explicitly applied, which
+	// restarts the kubernetes apiserver.
+	ServiceParamImpactApiserverRestart ServiceParamImpact = "apiserver-restart"
+
+	// ServiceParamImpactLock denotes a parameter that takes effect once the
+	// hosts are locked and unlocked.
+	ServiceParamImpactLock ServiceParamImpact = "lock"
+)
+
+// ServiceParamDefinition defines the attributes of a known service parameter.
+// An empty ParamName matches any parameter of the section.  Parameters that
+// are not Deletable are created by the system itself and must never be
+// removed from it.
+type ServiceParamDefinition struct {
+	ServiceParam
+	Type      ServiceParamType
+	Min       *int
+	Max       *int
+	Values    []string
+	Impact    ServiceParamImpact
+	Deletable bool
+}
+
+// RequiresApply determines whether changes to the parameter must be
+// explicitly applied to take effect.
+func (in *ServiceParamDefinition) RequiresApply() bool {
+	return in.Impact == ServiceParamImpactApply || in.Impact == ServiceParamImpactApiserverRestart
+}
+
+// RequiresLock determines whether changes to the parameter require the hosts
+// to be locked and unlocked to take effect.
+func (in *ServiceParamDefinition) RequiresLock() bool {
+	return in.Impact == ServiceParamImpactLock
+}
+
+// Validate checks a value against the type and range of the parameter.
+func (in *ServiceParamDefinition) Validate(value string) error {
+	name := fmt.Sprintf("%s/%s/%s", in.Service, in.Section, in.ParamName)
+
+	switch in.Type {
+	case ServiceParamTypeInteger:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("service parameter %s must be an integer: %q", name, value)
+		}
+		if in.Min != nil && n < *in.Min {
+			return fmt.Errorf("service parameter %s must be at least %d: %d", name, *in.Min, n)
+		}
+		if in.Max != nil && n > *in.Max {
+			return fmt.Errorf("service parameter %s must be at most %d: %d", name, *in.Max, n)
+		}
+
+	case ServiceParamTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("service parameter %s must be a boolean: %q", name, value)
+		}
+
+	case ServiceParamTypeEnum:
+		if !ContainsString(in.Values, value) {
+			return fmt.Errorf("service parameter %s must be one of %s: %q",
+				name, strings.Join(in.Values, ", "), value)
+		}
+	}
+
+	return nil
+}
+
+// integerParam is a utility function which defines an integer service
+// parameter with an allowed range.
+func integerParam(service, section, name string, min, max int, impact ServiceParamImpact) ServiceParamDefinition {
+	return ServiceParamDefinition{
+		ServiceParam: ServiceParam{Service: service, Section: section, ParamName: name},
+		Type:         ServiceParamTypeInteger,
+		Min:          &min,
+		Max:          &max,
+		Impact:       impact,
+	}
+}
+
+// ServiceParameterCatalog defines the service parameters known to the
+// deployment manager.  Parameters that are not listed are passed to the
+// system as-is.  The default parameters are created by the system itself
+// therefore they are the only ones that are not deletable.
+var ServiceParameterCatalog = []ServiceParamDefinition{
+	integerParam(ServiceTypeIdentity, ServiceParamSectionIdentityConfig,
+		ServiceParamIdentityConfigTokenExpiration, 3600, 14400, ServiceParamImpactApply),
+	integerParam(ServiceTypeIdentity, ServiceParamSectionSecurityCompliance,
+		ServiceParamNameSecurityComplianceLockoutDuration, 1, 86400, ServiceParamImpactApply),
+	integerParam(ServiceTypeIdentity, ServiceParamSectionSecurityCompliance,
+		ServiceParamNameSecurityComplianceLockoutFailureAttempts, 1, 100, ServiceParamImpactApply),
+	integerParam(ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+		ServiceParamPlatMtceWorkerBootTimeout, 720, 1800, ServiceParamImpactNone),
+	integerParam(ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+		ServiceParamPlatMtceControllerBootTimeout, 1200, 1800, ServiceParamImpactNone),
+	integerParam(ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+		ServiceParamPlatMtceHbsPERIOD, 100, 1000, ServiceParamImpactNone),
+	{
+		ServiceParam: ServiceParam{Service: ServiceTypePlatform,
+			Section:   ServiceParamSectionPlatformMaintenance,
+			ParamName: ServiceParamPlatMtceHbsFailureAction},
+		Type:   ServiceParamTypeEnum,
+		Values: []string{"fail", "degrade", "alarm", "none"},
+		Impact: ServiceParamImpactNone,
+	},
+	integerParam(ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+		ServiceParamPlatMtceHbsFailureThreshold, 10, 100, ServiceParamImpactNone),
+	integerParam(ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+		ServiceParamPlatMtceHbsDegradeThreshold, 4, 100, ServiceParamImpactNone),
+	integerParam(ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+		ServiceParamPlatMtceMnfaThreshold, 2, 100, ServiceParamImpactNone),
+	integerParam(ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+		ServiceParamPlatMtceMnfaTimeout, 0, 86400, ServiceParamImpactNone),
+	{
+		ServiceParam: ServiceParam{Service: ServiceTypePlatform,
+			Section:   ServiceParamSectionPlatformKernel,
+			ParamName: ServiceParamNamePlatformAuditD},
+		Type:   ServiceParamTypeString,
+		Impact: ServiceParamImpactLock,
+	},
+	{
+		ServiceParam: ServiceParam{Service: ServiceTypePlatform,
+			Section:   ServiceParamSectionPlatformConfig,
+			ParamName: ServiceParamNamePlatConfigIntelNicDriverVersion},
+		Type:   ServiceParamTypeString,
+		Impact: ServiceParamImpactLock,
+	},
+	{
+		ServiceParam: ServiceParam{Service: ServiceTypePlatform,
+			Section:   ServiceParamSectionPlatformConfig,
+			ParamName: ServiceParamNamePlatConfigIntelPstate},
+		Type:   ServiceParamTypeString,
+		Impact: ServiceParamImpactLock,
+	},
+	integerParam(ServiceTypePlatform, ServiceParamSectionPlatformConfig,
+		ServiceParamNamePlatformMaxCpuPercentage, 1, 100, ServiceParamImpactLock),
+	{
+		ServiceParam: ServiceParam{Service: ServiceTypeRadosgw,
+			Section:   ServiceParamSectionRadosgwConfig,
+			ParamName: ServiceParamNameRadosgwServiceEnabled},
+		Type:   ServiceParamTypeBoolean,
+		Impact: ServiceParamImpactApply,
+	},
+	integerParam(ServiceTypeRadosgw, ServiceParamSectionRadosgwConfig,
+		ServiceParamNameRadosgwFsSizeMB, 1, 1048576, ServiceParamImpactApply),
+	integerParam(ServiceTypeHttp, ServiceParamSectionHttpConfig,
+		ServiceParamHttpPortHttp, 1, 65535, ServiceParamImpactApply),
+	integerParam(ServiceTypeHttp, ServiceParamSectionHttpConfig,
+		ServiceParamHttpPortHttps, 1, 65535, ServiceParamImpactApply),
+	{
+		// Any kube_apiserver parameter is passed as a command line argument
+		// of the apiserver.
+		ServiceParam: ServiceParam{Service: ServiceTypeKubernetes,
+			Section: ServiceParamSectionKubernetesApiserver},
+		Type:      ServiceParamTypeString,
+		Impact:    ServiceParamImpactApiserverRestart,
+		Deletable: true,
+	},
+}
+
+// LookupServiceParameter returns the catalog definition of a service
+// parameter or nil if the parameter is not known.  Definitions of specific
+// parameters take precedence over section wide definitions.
+func LookupServiceParameter(service string, section string, name string) *ServiceParamDefinition {
+	var result *ServiceParamDefinition
+
+	for i := range ServiceParameterCatalog {
+		d := &ServiceParameterCatalog[i]
+		if d.Service != service || d.Section != section {
+			continue
+		}
+
+		if d.ParamName == name {
+			return d
+		} else if d.ParamName == "" {
+			result = d
+		}
+	}
+
+	return result
+}
+
+// ValidateServiceParameter checks a service parameter value against the
+// catalog.  Parameters that are not known are always accepted.
+func ValidateServiceParameter(service string, section string, name string, value string) error {
+	if d := LookupServiceParameter(service, section, name); d != nil {
+		return d.Validate(value)
+	}
+	return nil
+}
+
+// IsServiceParameterDeletable determines whether a service parameter can be
+// removed from the system.  Parameters that are not known are assumed to be
+// deletable.
+func IsServiceParameterDeletable(service string, section string, name string) bool {
+	if d := LookupServiceParameter(service, section, name); d != nil {
+		return d.Deletable
+	}
+	return true
+}
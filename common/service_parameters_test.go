@@ -0,0 +1,99 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service parameter catalog", func() {
+	Describe("LookupServiceParameter", func() {
+		It("should define every default parameter as non deletable", func() {
+			for _, p := range DefaultParameters {
+				definition := LookupServiceParameter(p.Service, p.Section, p.ParamName)
+				Expect(definition).ToNot(BeNil(), "%s/%s/%s", p.Service, p.Section, p.ParamName)
+				Expect(definition.Deletable).To(BeFalse(), "%s/%s/%s", p.Service, p.Section, p.ParamName)
+			}
+		})
+
+		It("should define every other parameter as deletable", func() {
+			for _, d := range ServiceParameterCatalog {
+				isDefault := false
+				for _, p := range DefaultParameters {
+					if p == d.ServiceParam {
+						isDefault = true
+					}
+				}
+				Expect(d.Deletable).To(Equal(!isDefault), "%s/%s/%s", d.Service, d.Section, d.ParamName)
+			}
+		})
+
+		It("should match any kube_apiserver parameter", func() {
+			definition := LookupServiceParameter(ServiceTypeKubernetes,
+				ServiceParamSectionKubernetesApiserver, "oidc-issuer-url")
+			Expect(definition).ToNot(BeNil())
+			Expect(definition.RequiresApply()).To(BeTrue())
+			Expect(definition.RequiresLock()).To(BeFalse())
+			Expect(IsServiceParameterDeletable(ServiceTypeKubernetes,
+				ServiceParamSectionKubernetesApiserver, "oidc-issuer-url")).To(BeTrue())
+		})
+
+		It("should not define unknown parameters", func() {
+			Expect(LookupServiceParameter("sysinv", "config", "unknown")).To(BeNil())
+			Expect(IsServiceParameterDeletable("sysinv", "config", "unknown")).To(BeTrue())
+		})
+	})
+
+	Describe("ValidateServiceParameter", func() {
+		It("should validate the parameter values", func() {
+			tests := []struct {
+				name    string
+				param   ServiceParam
+				value   string
+				wantErr bool
+			}{
+				{name: "integer in range",
+					param: ServiceParam{ServiceTypeIdentity, ServiceParamSectionIdentityConfig,
+						ServiceParamIdentityConfigTokenExpiration},
+					value: "3600"},
+				{name: "integer below range",
+					param: ServiceParam{ServiceTypeIdentity, ServiceParamSectionIdentityConfig,
+						ServiceParamIdentityConfigTokenExpiration},
+					value: "60", wantErr: true},
+				{name: "integer above range",
+					param: ServiceParam{ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+						ServiceParamPlatMtceWorkerBootTimeout},
+					value: "1801", wantErr: true},
+				{name: "not an integer",
+					param: ServiceParam{ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+						ServiceParamPlatMtceWorkerBootTimeout},
+					value: "slow", wantErr: true},
+				{name: "valid enum",
+					param: ServiceParam{ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+						ServiceParamPlatMtceHbsFailureAction},
+					value: "degrade"},
+				{name: "invalid enum",
+					param: ServiceParam{ServiceTypePlatform, ServiceParamSectionPlatformMaintenance,
+						ServiceParamPlatMtceHbsFailureAction},
+					value: "reboot", wantErr: true},
+				{name: "invalid boolean",
+					param: ServiceParam{ServiceTypeRadosgw, ServiceParamSectionRadosgwConfig,
+						ServiceParamNameRadosgwServiceEnabled},
+					value: "maybe", wantErr: true},
+				{name: "unknown parameter",
+					param: ServiceParam{"sysinv", "config", "unknown"},
+					value: "anything"},
+			}
+			for _, tt := range tests {
+				err := ValidateServiceParameter(tt.param.Service, tt.param.Section, tt.param.ParamName, tt.value)
+				if tt.wantErr {
+					Expect(err).To(HaveOccurred(), tt.name)
+				} else {
+					Expect(err).ToNot(HaveOccurred(), tt.name)
+				}
+			}
+		})
+	})
+})
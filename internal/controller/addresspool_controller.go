@@ -9,14 +9,19 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -117,10 +122,109 @@ func (r *AddressPoolReconciler) ReconcileResource(client *gophercloud.ServiceCli
 	return nil
 }
 
+// ReconcileUsage records in the status of an address pool the host interfaces
+// which reference it in their configuration, either directly or through a
+// platform network associated with the pool, or which the system reports as
+// having an address from it.  The number of allocated and free addresses of
+// the pool is updated at the same time.
+func (r *AddressPoolReconciler) ReconcileUsage(platformClient *gophercloud.ServiceClient, instance *starlingxv1.AddressPool) error {
+	networks := &starlingxv1.PlatformNetworkList{}
+	err := r.List(context.TODO(), networks, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list platform networks")
+		return err
+	}
+
+	associated := make(map[string]bool)
+	for _, network := range networks.Items {
+		if utils.ContainsString(network.Spec.AssociatedAddressPools, instance.Name) {
+			associated[network.Name] = true
+		}
+	}
+
+	configured, err := configuredConsumers(r.Client, instance.Namespace, func(iface *interfaceUsage) bool {
+		if utils.ContainsString(iface.addressPools, instance.Name) {
+			return true
+		}
+		for _, name := range iface.platformNetworks {
+			if associated[name] {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	subnet, err := common.PoolSubnet(instance)
+	if err != nil {
+		return err
+	}
+
+	ifaces, err := systemUsage.Interfaces(platformClient, instance.Namespace)
+	if err != nil {
+		return err
+	}
+
+	provisioned, inUse := addressPoolSystemConsumers(ifaces, subnet)
+
+	allocated, free, err := addressPoolCounts(instance, inUse)
+	if err != nil {
+		return err
+	}
+
+	status := instance.Status.DeepCopy()
+	status.Consumers = mergeConsumers(configured, provisioned)
+	status.AllocatedAddresses = &allocated
+	status.FreeAddresses = &free
+
+	if !r.statusUpdateRequired(instance, status) {
+		return nil
+	}
+
+	instance.Status = *status
+
+	err = r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update usage status: %s", instance.Name)
+		return err
+	}
+
+	return nil
+}
+
+// addressPoolsForHost maps a change to a host or a host profile to a
+// reconcile request for every address pool of its namespace so that their
+// usage status is refreshed.
+func (r *AddressPoolReconciler) addressPoolsForHost(ctx context.Context, obj client.Object) []reconcile.Request {
+	// Start a new pass over the system interfaces for the requests of this
+	// event.
+	systemUsage.Invalidate(obj.GetNamespace())
+
+	list := &starlingxv1.AddressPoolList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		logAddressPool.Error(err, "failed to list address pools", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: item.Namespace, Name: item.Name}})
+	}
+
+	return requests
+}
+
 // Reconcile reads that state of the cluster for a AddressPool object and makes changes based on the state read
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=addresspools,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=addresspools/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=addresspools/finalizers,verbs=update
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hostprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformnetworks,verbs=get;list;watch
 func (r *AddressPoolReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	_ = log.FromContext(ctx)
 
@@ -147,6 +251,30 @@ func (r *AddressPoolReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return reconcile.Result{}, err
 	}
 
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system nor the resource is modified.
+		if !utils.IsReconcilerEnabled(utils.AddressPool) {
+			return reconcile.Result{}, nil
+		}
+
+		platformClient := r.GetPlatformClient(request.Namespace)
+		if platformClient == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		err = r.ReconcilePlan(platformClient, instance, request.Namespace)
+		return reconcile.Result{}, err
+	}
+
 	if instance.DeletionTimestamp.IsZero() {
 		// Ensure that the object has a finalizer setup as a pre-delete hook so
 		// that we can delete any system resources that we previously added.
@@ -176,12 +304,23 @@ func (r *AddressPoolReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return common.RetryMissingClient, nil
 	}
 
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if !r.GetSystemReady(request.Namespace) {
 		r.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
 		return common.RetrySystemNotReady, nil
 	}
 
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
 	if !r.IsNotifyingActiveHost() {
 		r.SetNotifyingActiveHost(true)
 		err = r.ReconcileResource(platformClient, instance, request.Namespace)
@@ -194,9 +333,32 @@ func (r *AddressPoolReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return r.HandleReconcilerError(request, err)
 	}
 
+	if instance.DeletionTimestamp.IsZero() {
+		err = r.ReconcileUsage(platformClient, instance)
+		if err != nil {
+			return r.HandleReconcilerError(request, err)
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// ReconcilePlan runs the address pool reconciliation against plan mode
+// clients and publishes the system API requests that it would have issued
+// in the resource status.
+func (r *AddressPoolReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.AddressPool, reqNs string) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logAddressPool)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy(), reqNs)
+
+	return p.Publish(r.Client, instance, result)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AddressPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	tMgr := cloudManager.GetInstance(mgr)
@@ -211,5 +373,13 @@ func (r *AddressPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Logger:        logAddressPool}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.AddressPool{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.AddressPoolList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
+		Watches(&starlingxv1.Host{}, handler.EnqueueRequestsFromMapFunc(r.addressPoolsForHost),
+			builder.WithPredicates(usageChangedPredicate)).
+		Watches(&starlingxv1.HostProfile{}, handler.EnqueueRequestsFromMapFunc(r.addressPoolsForHost),
+			builder.WithPredicates(usageChangedPredicate)).
+		Watches(&starlingxv1.PlatformNetwork{}, handler.EnqueueRequestsFromMapFunc(r.addressPoolsForHost),
+			builder.WithPredicates(usageChangedPredicate)).
 		Complete(r)
 }
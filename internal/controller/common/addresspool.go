@@ -0,0 +1,84 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"fmt"
+	"net"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+// PoolSubnet returns the subnet of an address pool.
+func PoolSubnet(pool *starlingxv1.AddressPool) (*net.IPNet, error) {
+	_, subnet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", pool.Spec.Subnet, pool.Spec.Prefix))
+	if err != nil {
+		err = perrors.Wrapf(err, "invalid subnet for address pool: %s", pool.Name)
+		return nil, err
+	}
+	return subnet, nil
+}
+
+// NextAddress returns the address which immediately follows an address.
+func NextAddress(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+// previousAddress returns the address which immediately precedes an address.
+func previousAddress(ip net.IP) net.IP {
+	previous := make(net.IP, len(ip))
+	copy(previous, ip)
+	for i := len(previous) - 1; i >= 0; i-- {
+		previous[i]--
+		if previous[i] != 0xff {
+			break
+		}
+	}
+	return previous
+}
+
+// PoolAddressRanges returns the first and last address, both included, of
+// each range from which host addresses may be drawn.  The addresses are
+// always returned in their 16-byte form.  If the pool does not define any
+// allocation ranges the whole subnet is used, less its network address and its
+// last address since the latter is the broadcast address of IPv4 networks.
+// The range is empty, i.e., its last address precedes its first one, for
+// subnets that are too small to hold any host address.
+func PoolAddressRanges(pool *starlingxv1.AddressPool) ([][2]net.IP, error) {
+	subnet, err := PoolSubnet(pool)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][2]net.IP, 0)
+	for _, r := range pool.Spec.Allocation.Ranges {
+		start, end := net.ParseIP(r.Start), net.ParseIP(r.End)
+		if start == nil || end == nil {
+			msg := fmt.Sprintf("invalid allocation range %s-%s in address pool %s", r.Start, r.End, pool.Name)
+			return nil, NewUserDataError(msg)
+		}
+		result = append(result, [2]net.IP{start.To16(), end.To16()})
+	}
+
+	if len(result) == 0 {
+		last := make(net.IP, len(subnet.IP))
+		for i := range subnet.IP {
+			last[i] = subnet.IP[i] | ^subnet.Mask[i]
+		}
+		result = append(result, [2]net.IP{
+			NextAddress(subnet.IP).To16(),
+			previousAddress(last).To16()})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,55 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+var _ = Describe("Address pool utils", func() {
+	newPool := func(subnet string, prefix int, ranges ...starlingxv1.AllocationRange) *starlingxv1.AddressPool {
+		return &starlingxv1.AddressPool{
+			Spec: starlingxv1.AddressPoolSpec{
+				Subnet:     subnet,
+				Prefix:     prefix,
+				Allocation: starlingxv1.AllocationInfo{Ranges: ranges},
+			},
+		}
+	}
+
+	Describe("PoolAddressRanges", func() {
+		It("should exclude the network and broadcast addresses without explicit ranges", func() {
+			ranges, err := PoolAddressRanges(newPool("192.168.100.0", 24))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ranges).To(Equal([][2]net.IP{
+				{net.ParseIP("192.168.100.1"), net.ParseIP("192.168.100.254")}}))
+		})
+
+		It("should include both ends of explicit ranges", func() {
+			ranges, err := PoolAddressRanges(newPool("192.168.100.0", 24,
+				starlingxv1.AllocationRange{Start: "192.168.100.10", End: "192.168.100.255"}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ranges).To(Equal([][2]net.IP{
+				{net.ParseIP("192.168.100.10"), net.ParseIP("192.168.100.255")}}))
+		})
+
+		It("should return an empty range for a subnet without host addresses", func() {
+			ranges, err := PoolAddressRanges(newPool("10.10.10.0", 31))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ranges).To(HaveLen(1))
+			Expect(ranges[0][0].String()).To(Equal("10.10.10.1"))
+			Expect(ranges[0][1].String()).To(Equal("10.10.10.0"))
+		})
+
+		It("should reject invalid ranges", func() {
+			_, err := PoolAddressRanges(newPool("fd00:10::", 64,
+				starlingxv1.AllocationRange{Start: "fd00:10::100", End: "invalid"}))
+			Expect(err).To(BeAssignableToTypeOf(ErrUserDataError{}))
+		})
+	})
+})
@@ -38,6 +38,12 @@ var (
 	// is no need to automatically requeue these events.
 	RetrySystemNotReady = reconcile.Result{Requeue: false}
 
+	// RetryUpgradeInProgress should be used whenever a controller needs to
+	// wait for a platform upgrade to complete.  The upgrade controller kicks
+	// the system controller when the upgrade has finished so there is no need
+	// to automatically requeue these events.
+	RetryUpgradeInProgress = reconcile.Result{Requeue: false}
+
 	// RetryCephPrimaryGroupNotReady should be used whenever a storage node needs to wait
 	// for the ceph primary storage group to finish its reconcile task.
 	RetryCephPrimaryGroupNotReady = reconcile.Result{Requeue: true}
@@ -116,8 +122,9 @@ var (
 		"longitude":         nil,
 		"ntpServers":        nil,
 		"ptp":               []string{"mode", "transport", "mechanism"},
+		"remoteLogging":     []string{"enabled", "server", "transport", "port", "certificate"},
 		"serviceParameters": nil,
-		"storage":           []string{"filesystems", "drbd", "backends"},
+		"storage":           []string{"filesystems", "drbd", "backends", "tiers"},
 		"vswitchType":       nil,
 	}
 
@@ -132,6 +139,7 @@ var (
 		"bootMAC":              nil,
 		"clockSynchronization": nil,
 		"console":              nil,
+		"devices":              []string{"address", "deviceID", "driver", "enabled", "vfCount", "vfDriver"},
 		"hwSettle":             nil,
 		"installOutput":        nil,
 		"interfaces":           []string{"bond", "ethernet", "vf", "vlan"},
@@ -322,6 +330,14 @@ func (h *ErrorHandler) HandleReconcilerError(request reconcile.Request, in error
 		err = nil
 		h.Info("Change after reconcile ignored", "request", request)
 
+	case AdoptionPending:
+		// The operator must confirm the adoption before any changes are
+		// applied.  The annotation change will trigger a new reconcile.
+		resetClient = false
+		result = RetryValidationError
+		err = nil
+		h.Info("waiting for adoption to be confirmed", "request", request)
+
 	case ValidationError:
 		// These errors are data validation errors.  There is likely a problem
 		// with the data provided by the user so wait for the user to correct
@@ -92,6 +92,17 @@ var _ = Describe("Common utils", func() {
 				Expect(result).To(Equal(RetryValidationError))
 			})
 		})
+		Context("when error is AdoptionPending", func() {
+			It("should log info and return RetryValidationError", func() {
+				testError := NewAdoptionPending("adoption pending")
+				result, err := testHandler.HandleReconcilerError(request, testError)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(RetryValidationError))
+				Expect(sink.infoCalled).To(BeTrue())
+				Expect(sink.message).To(Equal("waiting for adoption to be confirmed"))
+			})
+		})
 		Context("when error is ErrMissingSystemResource", func() {
 			It("should log error and return RetryUserError", func() {
 				testError := starlingxv1.ErrMissingSystemResource{}
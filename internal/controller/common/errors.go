@@ -15,6 +15,8 @@ const (
 	ChangedAllowedAfterReconciled      = "manual override; allowing configuration changes after initial synchronization"
 	NoProvisioningAfterReconciled      = "resource provisioning ignored after initial synchronization has completed"
 	ProvisioningAllowedAfterReconciled = "manual override; allowing resource provisioning after initial synchronization"
+	AdoptionNoSystemResource           = "adopt mode; no existing system resource found to adopt"
+	AdoptionChangesHeld                = "adopt mode; system resource changes held until the adoption is confirmed"
 )
 
 // extractFaultString extracts the fault message from the fault error.
@@ -107,6 +109,13 @@ type ChangeAfterReconciled struct {
 	BaseError
 }
 
+// AdoptionPending defines a new error type used to signal that a resource is
+// bound to an existing system resource in adopt mode and that no changes can
+// be made to it until the operator confirms the adoption.
+type AdoptionPending struct {
+	BaseError
+}
+
 // PlatformNetworkReconciliationError defines an error to be used when reconciliation
 // of platform network / address pool resources fail and the reconciliation request
 // needs to be requeued.
@@ -174,6 +183,11 @@ func NewChangeAfterInSync(msg string) error {
 	return ChangeAfterReconciled{BaseError{msg}}
 }
 
+// NewAdoptionPending defines a constructor for the AdoptionPending error type.
+func NewAdoptionPending(msg string) error {
+	return AdoptionPending{BaseError{msg}}
+}
+
 // NewPlatformNetworkReconciliationError defines a constructor for the
 // PlatformNetworkReconciliationError error type.
 func NewPlatformNetworkReconciliationError(msg string) error {
@@ -67,6 +67,13 @@ var _ = Describe("Test Build utilities:", func() {
 		Expect(got).To(Equal(want))
 	})
 
+	It("should create a NewAdoptionPending", func() {
+		msg := "message"
+		want := AdoptionPending{BaseError{msg}}
+		got := NewAdoptionPending(msg)
+		Expect(got).To(Equal(want))
+	})
+
 	It("should create a NewUnlockError with simple message", func() {
 		expectedMessage := "Failed to unlock controller-0: Kernel upgrade in progress"
 		want := ErrUnlockError{BaseError{expectedMessage}}
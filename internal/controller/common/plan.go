@@ -0,0 +1,592 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// PlannableInstance is implemented by every resource kind that can publish
+// the list of system API requests computed while in plan mode.
+type PlannableInstance interface {
+	client.Object
+	GetPlan() *starlingxv1.PlanStatus
+	SetPlan(plan *starlingxv1.PlanStatus)
+}
+
+// planModeEnabled returns whether the plan mode annotation is set to true in
+// the supplied set of annotations.
+func planModeEnabled(annotations map[string]string) bool {
+	value, ok := annotations[starlingxv1.PlanModeAnnotation]
+	return ok && strings.EqualFold(value, "true")
+}
+
+// IsPlanModeEnabled determines whether a resource must be reconciled in plan
+// mode.  Plan mode is enabled either by annotating the resource itself or by
+// annotating its namespace.
+func IsPlanModeEnabled(c client.Client, instance client.Object) (bool, error) {
+	if planModeEnabled(instance.GetAnnotations()) {
+		return true, nil
+	}
+
+	namespace := &v1.Namespace{}
+	err := c.Get(context.TODO(), types.NamespacedName{Name: instance.GetNamespace()}, namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return planModeEnabled(namespace.Annotations), nil
+}
+
+// PlanRecorder accumulates, in order, the system API requests intercepted
+// while a resource is being reconciled in plan mode.
+type PlanRecorder struct {
+	lock       sync.Mutex
+	operations []starlingxv1.PlannedOperation
+}
+
+// Record appends a new operation to the plan and returns its sequence number.
+func (in *PlanRecorder) Record(op starlingxv1.PlannedOperation) int {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	in.operations = append(in.operations, op)
+	return len(in.operations)
+}
+
+// Operations returns the list of operations recorded so far.
+func (in *PlanRecorder) Operations() []starlingxv1.PlannedOperation {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	result := make([]starlingxv1.PlannedOperation, len(in.operations))
+	copy(result, in.operations)
+	return result
+}
+
+// planTransport is an http.RoundTripper which lets read-only requests through
+// to the system API but records every other request and answers it with a
+// simulated response so that the reconciler can keep computing its plan.
+// Requests are sent with the current token of the shared provider client so
+// that a reauthentication of the shared client is seen by the plan client.
+type planTransport struct {
+	next     http.RoundTripper
+	recorder *PlanRecorder
+	provider *gophercloud.ProviderClient
+}
+
+// authenticated returns a copy of a request which carries the current token
+// of the shared provider client.
+func (t *planTransport) authenticated(req *http.Request) *http.Request {
+	token := t.provider.Token()
+	if token == "" || req.Header.Get("X-Auth-Token") == token {
+		return req
+	}
+
+	result := req.Clone(req.Context())
+	result.Header.Set("X-Auth-Token", token)
+	return result
+}
+
+// simulatedResponse builds a response that mimics what the system API would
+// have returned for a successful request.
+func simulatedResponse(req *http.Request, code int, body []byte) *http.Response {
+	header := http.Header{}
+	if body != nil {
+		header.Set("Content-Type", "application/json")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// applyPatch applies a system API request body to the current representation
+// of a resource.  The system API accepts either a list of JSON patch
+// operations or a partial object.
+func applyPatch(current map[string]interface{}, body []byte) {
+	var ops []map[string]interface{}
+	if err := json.Unmarshal(body, &ops); err == nil {
+		for _, op := range ops {
+			path, _ := op["path"].(string)
+			key := strings.TrimPrefix(path, "/")
+			if key == "" {
+				continue
+			}
+
+			if op["op"] == "remove" {
+				delete(current, key)
+			} else {
+				current[key] = op["value"]
+			}
+		}
+		return
+	}
+
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(body, &attributes); err == nil {
+		for key, value := range attributes {
+			current[key] = value
+		}
+	}
+}
+
+// redactedAttributes lists the request attributes whose value must never be
+// published in a plan since plans are readable by anyone who can read the
+// resource.
+var redactedAttributes = map[string]bool{
+	"password":    true,
+	"bm_password": true,
+}
+
+// redactedValue replaces the value of a redacted attribute in a plan.
+const redactedValue = "<redacted>"
+
+// redactValue walks a decoded request body and replaces the value of every
+// redacted attribute, whether it is set directly or through a JSON patch
+// operation.
+func redactValue(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if redactedAttributes[key] {
+				v[key] = redactedValue
+				continue
+			}
+			redactValue(item)
+		}
+
+		if path, ok := v["path"].(string); ok && redactedAttributes[strings.TrimPrefix(path, "/")] {
+			if _, ok := v["value"]; ok {
+				v["value"] = redactedValue
+			}
+		}
+
+	case []interface{}:
+		for _, item := range v {
+			redactValue(item)
+		}
+	}
+}
+
+// redactBody returns the representation of a request body recorded in a
+// plan.  Secrets such as passwords are replaced so that they are not exposed
+// in the resource status.
+func redactBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	redactValue(value)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(data)
+}
+
+// currentObject retrieves the current representation of the resource
+// targeted by a request so that an update can be simulated on top of it.
+func (t *planTransport) currentObject(req *http.Request) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	get, err := http.NewRequestWithContext(req.Context(), http.MethodGet, req.URL.String(), nil)
+	if err != nil {
+		return result
+	}
+	get.Header = req.Header.Clone()
+
+	resp, err := t.next.RoundTrip(t.authenticated(get))
+	if err != nil {
+		return result
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return result
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err == nil {
+		_ = json.Unmarshal(data, &result)
+	}
+
+	return result
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *planTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.next.RoundTrip(t.authenticated(req))
+	}
+
+	var body []byte
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = data
+	}
+
+	op := starlingxv1.PlannedOperation{
+		Method: req.Method,
+		Path:   req.URL.Path,
+	}
+	if len(body) > 0 {
+		value := redactBody(body)
+		op.Body = &value
+	}
+	count := t.recorder.Record(op)
+
+	switch req.Method {
+	case http.MethodDelete:
+		return simulatedResponse(req, http.StatusNoContent, nil), nil
+
+	case http.MethodPost:
+		object := make(map[string]interface{})
+		_ = json.Unmarshal(body, &object)
+		if _, ok := object["uuid"]; !ok {
+			// Give the new resource a recognizable identifier so that any
+			// later request that refers to it can be traced in the plan.
+			object["uuid"] = fmt.Sprintf("planned-%d", count)
+		}
+		data, _ := json.Marshal(object)
+		return simulatedResponse(req, http.StatusOK, data), nil
+
+	default:
+		object := t.currentObject(req)
+		applyPatch(object, body)
+		data, _ := json.Marshal(object)
+		return simulatedResponse(req, http.StatusOK, data), nil
+	}
+}
+
+// NewPlanModeServiceClient returns a copy of the supplied system API client
+// which records every request that would modify the system rather than
+// sending it.  The copy has its own provider client, since the transport of
+// the shared one must not change, but it always authenticates with the token
+// of the shared provider client and reauthenticates through it.
+func NewPlanModeServiceClient(c *gophercloud.ServiceClient, recorder *PlanRecorder) *gophercloud.ServiceClient {
+	shared := c.ProviderClient
+
+	httpClient := shared.HTTPClient
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &planTransport{next: next, recorder: recorder, provider: shared}
+
+	provider := &gophercloud.ProviderClient{
+		IdentityBase:     shared.IdentityBase,
+		IdentityEndpoint: shared.IdentityEndpoint,
+		EndpointLocator:  shared.EndpointLocator,
+		HTTPClient:       httpClient,
+		UserAgent:        shared.UserAgent,
+	}
+	provider.UseTokenLock()
+	provider.CopyTokenFrom(shared)
+
+	if shared.ReauthFunc != nil {
+		provider.ReauthFunc = func() error {
+			// Only reauthenticate the shared client if nobody else has
+			// already done so since the plan client last copied its token.
+			err := shared.Reauthenticate(provider.Token())
+			if err != nil {
+				return err
+			}
+			provider.CopyTokenFrom(shared)
+			return nil
+		}
+	}
+
+	result := *c
+	result.ProviderClient = provider
+	return &result
+}
+
+// PlanModeChangedPredicate filters the namespace events which enable or
+// disable plan mode for the resources of the namespace.
+var PlanModeChangedPredicate = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return false },
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return planModeEnabled(e.ObjectOld.GetAnnotations()) != planModeEnabled(e.ObjectNew.GetAnnotations())
+	},
+}
+
+// EnqueueNamespaceResources returns an event handler which maps a namespace
+// event to a reconcile request for every resource of the namespace of the
+// kind held by the supplied list so that a change of the namespace plan mode
+// annotation takes effect without waiting for another event.
+func EnqueueNamespaceResources(c client.Client, list client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		items := list.DeepCopyObject().(client.ObjectList)
+		err := c.List(ctx, items, client.InNamespace(obj.GetName()))
+		if err != nil {
+			return nil
+		}
+
+		objects, err := meta.ExtractList(items)
+		if err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(objects))
+		for _, object := range objects {
+			if item, ok := object.(client.Object); ok {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(item)})
+			}
+		}
+
+		return requests
+	})
+}
+
+// planModeClient is a kubernetes client which allows reads but silently
+// discards every write so that a reconciler running in plan mode does not
+// alter the state of the resources it is computing a plan for.
+type planModeClient struct {
+	client.Client
+}
+
+func (c *planModeClient) Create(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+	return nil
+}
+
+func (c *planModeClient) Update(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+	return nil
+}
+
+func (c *planModeClient) Patch(_ context.Context, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	return nil
+}
+
+func (c *planModeClient) Delete(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+	return nil
+}
+
+func (c *planModeClient) DeleteAllOf(_ context.Context, _ client.Object, _ ...client.DeleteAllOfOption) error {
+	return nil
+}
+
+func (c *planModeClient) Status() client.SubResourceWriter {
+	return &planModeStatusWriter{}
+}
+
+// planModeStatusWriter discards every status update.
+type planModeStatusWriter struct{}
+
+func (w *planModeStatusWriter) Create(_ context.Context, _ client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+	return nil
+}
+
+func (w *planModeStatusWriter) Update(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+	return nil
+}
+
+func (w *planModeStatusWriter) Patch(_ context.Context, _ client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+	return nil
+}
+
+// planModeCloudManager wraps the cloud manager so that a reconciler running
+// in plan mode can neither start monitors, notify other reconcilers, change
+// the shared system state, nor request a VIM strategy.
+type planModeCloudManager struct {
+	manager.CloudManager
+	platformClient *gophercloud.ServiceClient
+}
+
+func (m *planModeCloudManager) GetPlatformClient(_ string) *gophercloud.ServiceClient {
+	return m.platformClient
+}
+
+func (m *planModeCloudManager) ResetPlatformClient(_ string) error {
+	return nil
+}
+
+func (m *planModeCloudManager) StartMonitor(_ *manager.Monitor, message string) error {
+	// Planning stops at the first dependency on a state change since it is
+	// not possible to know what the system will look like afterwards.
+	return manager.NewWaitForMonitor(message)
+}
+
+func (m *planModeCloudManager) NotifySystemDependencies(_ string) error {
+	return nil
+}
+
+func (m *planModeCloudManager) NotifyResource(_ client.Object) error {
+	return nil
+}
+
+func (m *planModeCloudManager) SetSystemReady(_ string, _ bool) {}
+
+func (m *planModeCloudManager) SetSystemType(_ string, _ manager.SystemType) {}
+
+func (m *planModeCloudManager) SetUpgradeInProgress(_ string, _ bool) {}
+
+func (m *planModeCloudManager) CancelMonitor(_ client.Object) {}
+
+func (m *planModeCloudManager) SetResourceInfo(_ string, _ string, _ string, _ bool, _ string) {}
+
+func (m *planModeCloudManager) SetResourceMaintenance(_ string, _ bool) {}
+
+func (m *planModeCloudManager) UpdateConfigVersion() {}
+
+func (m *planModeCloudManager) StrategySent() {}
+
+func (m *planModeCloudManager) ClearStrategy() {}
+
+func (m *planModeCloudManager) StartStrategyMonitor() {}
+
+func (m *planModeCloudManager) SetStrategyAppliedSent(_ string, _ bool) error {
+	return nil
+}
+
+func (m *planModeCloudManager) SetStrategyRetryCount(_ int) error {
+	return nil
+}
+
+func (m *planModeCloudManager) SetStrategyExpectedByOtherReconcilers(_ bool) {}
+
+func (m *planModeCloudManager) SetFactoryConfigFinalized(_ string, _ bool) error {
+	return nil
+}
+
+func (m *planModeCloudManager) SetFactoryResourceDataUpdated(_ string, _ string, _ string, _ bool) error {
+	return nil
+}
+
+func (m *planModeCloudManager) GcActionStrategy(_ *gophercloud.ServiceClient, _ systemconfigupdate.StrategyActionOpts) (*systemconfigupdate.SystemConfigUpdate, error) {
+	return nil, NewSystemDependency("VIM strategies are not applied in plan mode")
+}
+
+func (m *planModeCloudManager) GcCreate(_ *gophercloud.ServiceClient, _ systemconfigupdate.SystemConfigUpdateOpts) (*systemconfigupdate.SystemConfigUpdate, error) {
+	return nil, NewSystemDependency("VIM strategies are not created in plan mode")
+}
+
+func (m *planModeCloudManager) GcDelete(_ *gophercloud.ServiceClient) (r systemconfigupdate.DeleteResult) {
+	r.Err = NewSystemDependency("VIM strategies are not deleted in plan mode")
+	return r
+}
+
+// PlanEventLogger is a ReconcilerEventLogger which only logs events so that
+// planned changes are never reported as having been applied.
+type PlanEventLogger struct {
+	logr.Logger
+}
+
+func (in *PlanEventLogger) NormalEvent(_ runtime.Object, reason string, messageFmt string, args ...interface{}) {
+	in.V(1).Info("planned event", "reason", reason, "message", fmt.Sprintf(messageFmt, args...))
+}
+
+func (in *PlanEventLogger) WarningEvent(_ runtime.Object, reason string, messageFmt string, args ...interface{}) {
+	in.V(1).Info("planned warning event", "reason", reason, "message", fmt.Sprintf(messageFmt, args...))
+}
+
+// Planner bundles the replacement clients that a reconciler must use while
+// computing a plan.  None of them let any change reach the system or the
+// kubernetes API.
+type Planner struct {
+	Client         client.Client
+	CloudManager   manager.CloudManager
+	EventLogger    ReconcilerEventLogger
+	PlatformClient *gophercloud.ServiceClient
+	Recorder       *PlanRecorder
+}
+
+// NewPlanner builds a set of plan mode clients from the reconciler's own
+// clients.
+func NewPlanner(c client.Client, m manager.CloudManager, platformClient *gophercloud.ServiceClient, logger logr.Logger) *Planner {
+	recorder := &PlanRecorder{}
+	planClient := NewPlanModeServiceClient(platformClient, recorder)
+
+	return &Planner{
+		Client:         &planModeClient{Client: c},
+		CloudManager:   &planModeCloudManager{CloudManager: m, platformClient: planClient},
+		EventLogger:    &PlanEventLogger{Logger: logger},
+		PlatformClient: planClient,
+		Recorder:       recorder,
+	}
+}
+
+// Publish stores the recorded operations in the resource status.  The result
+// of the planning attempt, if any, is recorded as the plan message.
+func (p *Planner) Publish(c client.Client, instance PlannableInstance, result error) error {
+	plan := &starlingxv1.PlanStatus{
+		ObservedGeneration: instance.GetGeneration(),
+		Operations:         p.Recorder.Operations(),
+	}
+	if result != nil {
+		plan.Message = result.Error()
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := c.Get(context.TODO(), client.ObjectKeyFromObject(instance), instance)
+		if err != nil {
+			return err
+		}
+
+		if current := instance.GetPlan(); current != nil && current.DeepEqual(plan) {
+			// Avoid triggering another reconciliation for the same plan.
+			return nil
+		}
+
+		instance.SetPlan(plan)
+		return c.Status().Update(context.TODO(), instance)
+	})
+}
+
+// ClearPlan removes a previously published plan from the resource status once
+// plan mode has been disabled.
+func ClearPlan(c client.Client, instance PlannableInstance) error {
+	if instance.GetPlan() == nil {
+		return nil
+	}
+
+	instance.SetPlan(nil)
+	return c.Status().Update(context.TODO(), instance)
+}
@@ -0,0 +1,266 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Plan mode utils", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	Describe("IsPlanModeEnabled", func() {
+		It("should be enabled by an annotation on the resource", func() {
+			instance := &starlingxv1.DataNetwork{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "group0-data0",
+					Namespace:   "deployment",
+					Annotations: map[string]string{starlingxv1.PlanModeAnnotation: "true"},
+				},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			enabled, err := IsPlanModeEnabled(c, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+		})
+
+		It("should be enabled by an annotation on the namespace", func() {
+			namespace := &v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "deployment",
+					Annotations: map[string]string{starlingxv1.PlanModeAnnotation: "True"},
+				},
+			}
+			instance := &starlingxv1.DataNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "group0-data0", Namespace: "deployment"},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespace).Build()
+
+			enabled, err := IsPlanModeEnabled(c, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+		})
+
+		It("should be disabled otherwise", func() {
+			namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "deployment"}}
+			instance := &starlingxv1.DataNetwork{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "group0-data0",
+					Namespace:   "deployment",
+					Annotations: map[string]string{starlingxv1.PlanModeAnnotation: "false"},
+				},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespace).Build()
+
+			enabled, err := IsPlanModeEnabled(c, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeFalse())
+		})
+	})
+
+	Describe("NewPlanModeServiceClient", func() {
+		var server *httptest.Server
+		var mutations int
+		var token string
+		var recorder *PlanRecorder
+		var original *gophercloud.ServiceClient
+		var planClient *gophercloud.ServiceClient
+
+		BeforeEach(func() {
+			mutations = 0
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.Method != http.MethodGet {
+					mutations++
+				}
+				token = req.Header.Get("X-Auth-Token")
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = io.WriteString(w, `{"uuid": "abc", "name": "data0", "mtu": 1500}`)
+			}))
+
+			recorder = &PlanRecorder{}
+			original = &gophercloud.ServiceClient{
+				ProviderClient: &gophercloud.ProviderClient{},
+				Endpoint:       server.URL + "/",
+			}
+			planClient = NewPlanModeServiceClient(original, recorder)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should let read requests through", func() {
+			var result map[string]interface{}
+			_, err := planClient.Get(planClient.ServiceURL("datanetworks", "abc"), &result, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result["name"]).To(Equal("data0"))
+			Expect(recorder.Operations()).To(BeEmpty())
+		})
+
+		It("should record and simulate write requests", func() {
+			var result map[string]interface{}
+			patch := []map[string]interface{}{{"op": "replace", "path": "/mtu", "value": 9000}}
+			_, err := planClient.Patch(planClient.ServiceURL("datanetworks", "abc"), patch, &result,
+				&gophercloud.RequestOpts{OkCodes: []int{200}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result["mtu"]).To(BeEquivalentTo(9000))
+			Expect(result["name"]).To(Equal("data0"))
+
+			create := map[string]interface{}{"name": "data1"}
+			_, err = planClient.Post(planClient.ServiceURL("datanetworks"), create, &result,
+				&gophercloud.RequestOpts{OkCodes: []int{200}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result["uuid"]).To(Equal("planned-2"))
+
+			_, err = planClient.Delete(planClient.ServiceURL("datanetworks", "abc"), nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(mutations).To(Equal(0))
+
+			ops := recorder.Operations()
+			Expect(ops).To(HaveLen(3))
+			Expect(ops[0].Method).To(Equal(http.MethodPatch))
+			Expect(ops[0].Path).To(Equal("/datanetworks/abc"))
+			Expect(*ops[0].Body).To(ContainSubstring("/mtu"))
+			Expect(ops[1].Method).To(Equal(http.MethodPost))
+			Expect(ops[2].Method).To(Equal(http.MethodDelete))
+			Expect(ops[2].Body).To(BeNil())
+		})
+
+		It("should use the token of the shared client and leave its transport alone", func() {
+			original.SetToken("renewed")
+
+			var result map[string]interface{}
+			_, err := planClient.Get(planClient.ServiceURL("datanetworks", "abc"), &result, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token).To(Equal("renewed"))
+
+			Expect(planClient.ProviderClient).ToNot(BeIdenticalTo(original.ProviderClient))
+			Expect(original.HTTPClient.Transport).To(BeNil())
+		})
+
+		It("should not record passwords", func() {
+			var result map[string]interface{}
+			create := map[string]interface{}{"user": map[string]interface{}{"name": "operator", "password": "secret"}}
+			_, err := planClient.Post(planClient.ServiceURL("users"), create, &result,
+				&gophercloud.RequestOpts{OkCodes: []int{200}})
+			Expect(err).ToNot(HaveOccurred())
+
+			patch := []map[string]interface{}{{"op": "replace", "path": "/bm_password", "value": "secret"}}
+			_, err = planClient.Patch(planClient.ServiceURL("ihosts", "abc"), patch, &result,
+				&gophercloud.RequestOpts{OkCodes: []int{200}})
+			Expect(err).ToNot(HaveOccurred())
+
+			ops := recorder.Operations()
+			Expect(ops).To(HaveLen(2))
+			Expect(*ops[0].Body).To(ContainSubstring("operator"))
+			Expect(*ops[0].Body).ToNot(ContainSubstring("secret"))
+			Expect(*ops[1].Body).To(ContainSubstring("/bm_password"))
+			Expect(*ops[1].Body).ToNot(ContainSubstring("secret"))
+		})
+	})
+
+	Describe("PlanModeChangedPredicate", func() {
+		It("should only pass namespace updates which toggle plan mode", func() {
+			before := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "deployment"}}
+			after := before.DeepCopy()
+			after.Labels = map[string]string{"team": "edge"}
+			Expect(PlanModeChangedPredicate.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after})).To(BeFalse())
+
+			after.Annotations = map[string]string{starlingxv1.PlanModeAnnotation: "true"}
+			Expect(PlanModeChangedPredicate.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after})).To(BeTrue())
+		})
+	})
+
+	Describe("EnqueueNamespaceResources", func() {
+		It("should request every resource of the namespace", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&starlingxv1.DataNetwork{ObjectMeta: metav1.ObjectMeta{Name: "data0", Namespace: "deployment"}},
+				&starlingxv1.DataNetwork{ObjectMeta: metav1.ObjectMeta{Name: "data1", Namespace: "deployment"}},
+				&starlingxv1.DataNetwork{ObjectMeta: metav1.ObjectMeta{Name: "data0", Namespace: "other"}},
+			).Build()
+
+			queue := &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+			h := EnqueueNamespaceResources(c, &starlingxv1.DataNetworkList{})
+			namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "deployment"}}
+			h.Update(context.TODO(), event.UpdateEvent{ObjectOld: namespace, ObjectNew: namespace}, queue)
+
+			Expect(queue.Len()).To(Equal(2))
+		})
+	})
+
+	Describe("Planner", func() {
+		It("should publish the plan and discard all other writes", func() {
+			instance := &starlingxv1.DataNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "group0-data0", Namespace: "deployment", Generation: 3},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+
+			p := NewPlanner(c, nil, &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}}, logr.Discard())
+			p.Recorder.Record(starlingxv1.PlannedOperation{Method: http.MethodPatch, Path: "/datanetworks/abc"})
+
+			copied := instance.DeepCopy()
+			copied.Status.InSync = true
+			Expect(p.Client.Status().Update(context.TODO(), copied)).To(Succeed())
+			Expect(p.CloudManager.StartMonitor(nil, "waiting for lock")).To(HaveOccurred())
+
+			err := p.Publish(c, instance, manager.NewWaitForMonitor("waiting for lock"))
+			Expect(err).ToNot(HaveOccurred())
+
+			result := &starlingxv1.DataNetwork{}
+			Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(instance), result)).To(Succeed())
+			Expect(result.Status.InSync).To(BeFalse())
+			Expect(result.Status.Plan).ToNot(BeNil())
+			Expect(result.Status.Plan.ObservedGeneration).To(Equal(int64(3)))
+			Expect(result.Status.Plan.Operations).To(HaveLen(1))
+			Expect(result.Status.Plan.Message).To(Equal("waiting for lock"))
+
+			Expect(ClearPlan(c, result)).To(Succeed())
+			Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(instance), result)).To(Succeed())
+			Expect(result.Status.Plan).To(BeNil())
+		})
+	})
+
+	Describe("applyPatch", func() {
+		It("should support partial objects", func() {
+			current := map[string]interface{}{"name": "data0"}
+			body, _ := json.Marshal(map[string]interface{}{"mtu": 1500})
+			applyPatch(current, body)
+			Expect(current).To(HaveKeyWithValue("mtu", BeEquivalentTo(1500)))
+		})
+
+		It("should support removals", func() {
+			current := map[string]interface{}{"name": "data0", "description": "old"}
+			applyPatch(current, []byte(`[{"op": "remove", "path": "/description"}]`))
+			Expect(current).ToNot(HaveKey("description"))
+		})
+	})
+})
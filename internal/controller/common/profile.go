@@ -0,0 +1,167 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/imdario/mergo"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MergeProfiles invokes the mergo.Merge API with our desired modifiers.
+func MergeProfiles(a, b *starlingxv1.HostProfileSpec) (*starlingxv1.HostProfileSpec, error) {
+	t := DefaultMergeTransformer
+	err := mergo.Merge(a, b, mergo.WithOverride, mergo.WithTransformers(t))
+	if err != nil {
+		err = perrors.Wrap(err, "mergo.Merge failed to merge profiles")
+		return nil, err
+	}
+
+	if b.Storage != nil && b.Storage.VolumeGroups != nil &&
+		a.Storage != nil && a.Storage.VolumeGroups != nil {
+		a.Storage.VolumeGroups = MergeVolumeGroups(a.Storage, b.Storage)
+	}
+
+	return a, nil
+}
+
+// MergeVolumeGroups uses b (user profile) as source of truth for which VGs
+// should exist. VGs in b that match by name in a (defaults) get their nil
+// fields filled from defaults. VGs in b with no match in a are new and
+// included as-is (to be created on the system).
+func MergeVolumeGroups(a, b *starlingxv1.ProfileStorageInfo) starlingxv1.VolumeGroupList {
+	filtered := make(starlingxv1.VolumeGroupList, 0, len(b.VolumeGroups))
+	for _, srcVG := range b.VolumeGroups {
+		for _, vg := range a.VolumeGroups {
+			if vg.Name == srcVG.Name {
+				if srcVG.LVMFunction == nil && vg.LVMFunction != nil {
+					srcVG.LVMFunction = vg.LVMFunction
+				}
+				if srcVG.LVMFunction != nil && *srcVG.LVMFunction != LVMFunctionNone {
+					if srcVG.LVMType == nil && vg.LVMType != nil {
+						srcVG.LVMType = vg.LVMType
+					}
+					if srcVG.LVMPoolSize == nil && vg.LVMPoolSize != nil {
+						srcVG.LVMPoolSize = vg.LVMPoolSize
+					}
+				}
+				break
+			}
+		}
+		filtered = append(filtered, srcVG)
+	}
+	return filtered
+}
+
+// GetHostProfile retrieves a HostProfile from the kubernetes API
+func GetHostProfile(cl client.Reader, namespace, profile string) (*starlingxv1.HostProfile, error) {
+	instance := &starlingxv1.HostProfile{}
+	name := types.NamespacedName{Namespace: namespace, Name: profile}
+
+	err := cl.Get(context.TODO(), name, instance)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			err = perrors.Wrapf(err, "failed to get profile: %s", name)
+			return nil, err
+		} else {
+			msg := fmt.Sprintf("host profile %q not present", name)
+			return nil, NewResourceConfigurationDependency(msg)
+		}
+	}
+
+	return instance, nil
+}
+
+// MergeProfileChain merges the profile attributes from each profile in the
+// inheritance chain.  This is done recursively and fields set in lower profiles
+// take precedence over its parent/base profile attributes.  Arrays are handled
+// by looking for equivalent entries in the base profile attribute and replacing
+// their values.  Array entries that are not found in the base profile are
+// added to the array.  The root of the chain is merged over the defaults.
+func MergeProfileChain(cl client.Reader, namespace string, current, defaults *starlingxv1.HostProfileSpec, visited map[string]bool) (*starlingxv1.HostProfileSpec, error) {
+	if current.Base != nil {
+		if value, ok := visited[*current.Base]; ok && value {
+			msg := fmt.Sprintf("profile loop detected at: %s", *current.Base)
+			return nil, NewValidationError(msg)
+		}
+		visited[*current.Base] = true
+
+		instance, err := GetHostProfile(cl, namespace, *current.Base)
+		if err != nil {
+			return nil, err
+		}
+
+		parent, err := MergeProfileChain(cl, namespace, &instance.Spec, defaults, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		return MergeProfiles(parent, current)
+	}
+
+	return MergeProfiles(defaults, current)
+}
+
+// MergeHostProfile combines the defaults, the profile inheritance chain of a
+// host, and its overrides into a single profile.
+func MergeHostProfile(cl client.Reader, host *starlingxv1.Host, defaults *starlingxv1.HostProfileSpec) (*starlingxv1.HostProfileSpec, error) {
+	// Start with the explicit profile attached to the host.
+	instance, err := GetHostProfile(cl, host.Namespace, host.Spec.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize map to track which profiles have already been visited so
+	// that we can catch loops.
+	visited := map[string]bool{host.Spec.Profile: true}
+
+	// Traverse the list of profiles until the root profile is found.
+	// Attributes from lower profiles (those closest to the host level) are
+	// merged into the higher level profile.
+	composite, err := MergeProfileChain(cl, host.Namespace, &instance.Spec, defaults, visited)
+	if err != nil {
+		return composite, err
+	}
+
+	// Finally, if the user had provided any per-host overrides then apply
+	// over the composite profile.
+	if host.Spec.Overrides != nil {
+		composite, err = MergeProfiles(composite, host.Spec.Overrides)
+		if err != nil {
+			return composite, err
+		}
+	}
+
+	return composite, nil
+}
+
+// ProfileInterfaces returns the common attributes of every interface of a
+// profile.
+func ProfileInterfaces(profile *starlingxv1.HostProfileSpec) []*starlingxv1.CommonInterfaceInfo {
+	result := make([]*starlingxv1.CommonInterfaceInfo, 0)
+	if profile == nil || profile.Interfaces == nil {
+		return result
+	}
+
+	for i := range profile.Interfaces.Ethernet {
+		result = append(result, &profile.Interfaces.Ethernet[i].CommonInterfaceInfo)
+	}
+	for i := range profile.Interfaces.VLAN {
+		result = append(result, &profile.Interfaces.VLAN[i].CommonInterfaceInfo)
+	}
+	for i := range profile.Interfaces.Bond {
+		result = append(result, &profile.Interfaces.Bond[i].CommonInterfaceInfo)
+	}
+	for i := range profile.Interfaces.VF {
+		result = append(result, &profile.Interfaces.VF[i].CommonInterfaceInfo)
+	}
+
+	return result
+}
@@ -0,0 +1,96 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Profile utils", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	profile := func(name string, base *string, ifaces ...starlingxv1.EthernetInfo) *starlingxv1.HostProfile {
+		return &starlingxv1.HostProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "deployment"},
+			Spec: starlingxv1.HostProfileSpec{
+				Base:       base,
+				Interfaces: &starlingxv1.InterfaceInfo{Ethernet: ifaces},
+			},
+		}
+	}
+
+	ethernet := func(name string, dataNetworks ...string) starlingxv1.EthernetInfo {
+		return starlingxv1.EthernetInfo{
+			CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+				Name: name, Class: "data", DataNetworks: dataNetworks},
+			Port: starlingxv1.EthernetPortInfo{Name: "enp0s" + name},
+		}
+	}
+
+	host := func(profile string, overrides *starlingxv1.HostProfileSpec) *starlingxv1.Host {
+		return &starlingxv1.Host{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: "deployment"},
+			Spec:       starlingxv1.HostSpec{Profile: profile, Overrides: overrides},
+		}
+	}
+
+	Describe("MergeHostProfile", func() {
+		It("should merge the profile chain and the host overrides", func() {
+			base := "base"
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				profile("base", nil, ethernet("data0", "physnet0"), ethernet("data1", "physnet0")),
+				profile("worker", &base)).Build()
+
+			overrides := &starlingxv1.HostProfileSpec{
+				Interfaces: &starlingxv1.InterfaceInfo{
+					Ethernet: starlingxv1.EthernetList{ethernet("data1", "physnet1")}}}
+
+			result, err := MergeHostProfile(c, host("worker", overrides), &starlingxv1.HostProfileSpec{})
+			Expect(err).ToNot(HaveOccurred())
+
+			ifaces := ProfileInterfaces(result)
+			Expect(ifaces).To(HaveLen(2))
+			for _, iface := range ifaces {
+				if iface.Name == "data1" {
+					Expect(iface.DataNetworks).To(Equal([]string{"physnet1"}))
+				} else {
+					Expect(iface.DataNetworks).To(Equal([]string{"physnet0"}))
+				}
+			}
+		})
+
+		It("should detect a profile loop", func() {
+			a, b := "loop-a", "loop-b"
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				profile(a, &b), profile(b, &a)).Build()
+
+			_, err := MergeHostProfile(c, host(a, nil), &starlingxv1.HostProfileSpec{})
+			Expect(err).To(BeAssignableToTypeOf(ValidationError{}))
+		})
+
+		It("should report a missing profile as a dependency", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			_, err := MergeHostProfile(c, host("missing", nil), &starlingxv1.HostProfileSpec{})
+			Expect(err).To(BeAssignableToTypeOf(ErrResourceConfigurationDependency{}))
+		})
+	})
+
+	Describe("ProfileInterfaces", func() {
+		It("should return no interfaces for a profile without interfaces", func() {
+			Expect(ProfileInterfaces(&starlingxv1.HostProfileSpec{})).To(BeEmpty())
+			Expect(ProfileInterfaces(nil)).To(BeEmpty())
+		})
+	})
+})
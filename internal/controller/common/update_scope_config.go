@@ -7,8 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,8 +43,12 @@ func extractScope(config string) (string, error) {
 	return scope, nil
 }
 
-// GetDeploymentScope is to get the deploymentScope from the last applied configuration
-// and set expected value based on the data.
+// GetDeploymentScope is to get the deploymentScope of a resource and set
+// expected value based on the data.  The deployment scope annotation takes
+// precedence over the deploymentScope found in the last applied configuration
+// since the latter is only set by client-side "kubectl apply" and is therefore
+// missing when resources are created with server-side apply, Helm or GitOps
+// tools.
 func GetDeploymentScope(instance StarlingxInstance) (string, error) {
 	// Set default value for deployment scope
 	scope := manager.ScopeBootstrap
@@ -54,6 +58,10 @@ func GetDeploymentScope(instance StarlingxInstance) (string, error) {
 		return scope, nil
 	}
 
+	if value, ok := annotation[starlingxv1.DeploymentScopeAnnotation]; ok {
+		return starlingxv1.ParseDeploymentScope(value)
+	}
+
 	config, ok := annotation["kubectl.kubernetes.io/last-applied-configuration"]
 	if !ok {
 		return scope, nil
@@ -68,22 +76,10 @@ func GetDeploymentScope(instance StarlingxInstance) (string, error) {
 		scope = scope2
 	}
 
-	scope, err = validateDeploymentScope(scope)
+	scope, err = starlingxv1.ParseDeploymentScope(scope)
 	return scope, err
 }
 
-func validateDeploymentScope(scope string) (string, error) {
-	lowerCaseScope := strings.ToLower(scope)
-	switch lowerCaseScope {
-	case manager.ScopeBootstrap:
-		return manager.ScopeBootstrap, nil
-	case manager.ScopePrincipal:
-		return manager.ScopePrincipal, nil
-	default:
-		return manager.ScopeBootstrap, fmt.Errorf("unsupported DeploymentScope: %s", scope)
-	}
-}
-
 func UpdateDeploymentScope(client client.Client, instance StarlingxInstance) (bool, error) {
 	scope, err := GetDeploymentScope(instance)
 	if err != nil {
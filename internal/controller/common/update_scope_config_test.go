@@ -6,6 +6,7 @@ package common
 import (
 	"testing"
 
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -136,6 +137,31 @@ func TestGetDeploymentScope(t *testing.T) {
 			expectedScope: "bootstrap",
 			expectError:   false,
 		},
+		{
+			name: "Valid scope in dedicated annotation",
+			annotations: map[string]string{
+				starlingxv1.DeploymentScopeAnnotation: "Principal",
+			},
+			expectedScope: "principal",
+			expectError:   false,
+		},
+		{
+			name: "Dedicated annotation takes precedence",
+			annotations: map[string]string{
+				starlingxv1.DeploymentScopeAnnotation:              "bootstrap",
+				"kubectl.kubernetes.io/last-applied-configuration": `{"status":{"deploymentScope":"principal"}}`,
+			},
+			expectedScope: "bootstrap",
+			expectError:   false,
+		},
+		{
+			name: "Invalid scope in dedicated annotation",
+			annotations: map[string]string{
+				starlingxv1.DeploymentScopeAnnotation: "day2",
+			},
+			expectedScope: "bootstrap",
+			expectError:   true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -16,12 +16,15 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -125,6 +128,13 @@ func dataNetworkUpdateRequired(instance *starlingxv1.DataNetwork, n *datanetwork
 // ReconcileNew is a method which handles reconciling a new data resource and
 // creates the corresponding system resource thru the system API.
 func (r *DataNetworkReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.DataNetwork) (*datanetworks.DataNetwork, error) {
+	if starlingxv1.IsAdoptionPending(instance) {
+		// Only existing resources can be adopted.
+		msg := common.AdoptionNoSystemResource
+		r.NormalEvent(instance, common.ResourceDependency, msg)
+		return nil, common.NewAdoptionPending(msg)
+	}
+
 	if instance.Status.Reconciled && r.StopAfterInSync() {
 		// Do not process any further changes once we have reached a
 		// synchronized state unless there is an annotation on the resource.
@@ -173,6 +183,14 @@ func (r *DataNetworkReconciler) ReconcileNew(client *gophercloud.ServiceClient,
 func (r *DataNetworkReconciler) ReconcileUpdated(client *gophercloud.ServiceClient, instance *starlingxv1.DataNetwork, network *datanetworks.DataNetwork) error {
 	// Update existing network
 	if opts, ok := dataNetworkUpdateRequired(instance, network, r); ok {
+		if starlingxv1.IsAdoptionPending(instance) {
+			// The delta has been reported but the system resource must not
+			// be modified until the adoption is confirmed.
+			msg := common.AdoptionChangesHeld
+			r.NormalEvent(instance, common.ResourceDependency, msg)
+			return common.NewAdoptionPending(msg)
+		}
+
 		if instance.Status.Reconciled && r.StopAfterInSync() {
 			// Do not process any further changes once we have reached a
 			// synchronized state unless there is an annotation on the resource.
@@ -362,6 +380,64 @@ func (r *DataNetworkReconciler) ReconcileResource(client *gophercloud.ServiceCli
 	return err
 }
 
+// ReconcileUsage records in the status of a data network the host interfaces
+// which reference it in their configuration or which the system reports as
+// assigned to it.
+func (r *DataNetworkReconciler) ReconcileUsage(platformClient *gophercloud.ServiceClient, instance *starlingxv1.DataNetwork) error {
+	configured, err := configuredConsumers(r.Client, instance.Namespace, func(iface *interfaceUsage) bool {
+		return utils.ContainsString(iface.dataNetworks, instance.Name)
+	})
+	if err != nil {
+		return err
+	}
+
+	ifaces, err := systemUsage.Interfaces(platformClient, instance.Namespace)
+	if err != nil {
+		return err
+	}
+
+	status := instance.Status.DeepCopy()
+	status.Consumers = mergeConsumers(configured, dataNetworkSystemConsumers(ifaces, instance.Name))
+
+	if instance.Status.DeepEqual(status) {
+		return nil
+	}
+
+	instance.Status = *status
+
+	err = r.Client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update usage status: %s", instance.Name)
+		return err
+	}
+
+	return nil
+}
+
+// dataNetworksForHost maps a change to a host or a host profile to a
+// reconcile request for every data network of its namespace so that their
+// usage status is refreshed.
+func (r *DataNetworkReconciler) dataNetworksForHost(ctx context.Context, obj client.Object) []reconcile.Request {
+	// Start a new pass over the system interfaces for the requests of this
+	// event.
+	systemUsage.Invalidate(obj.GetNamespace())
+
+	list := &starlingxv1.DataNetworkList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		logDataNetwork.Error(err, "failed to list data networks", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: item.Namespace, Name: item.Name}})
+	}
+
+	return requests
+}
+
 // StopAfterInSync determines whether the reconciler should continue processing
 // change requests after the configuration has been reconciled a first time.
 func (r *DataNetworkReconciler) StopAfterInSync() bool {
@@ -493,6 +569,8 @@ func (r *DataNetworkReconciler) UpdateStatusForFactoryInstall(
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=datanetworks,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=datanetworks/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=datanetworks/finalizers,verbs=update
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hostprofiles,verbs=get;list;watch
 func (r *DataNetworkReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	_ = log.FromContext(ctx)
 
@@ -515,6 +593,35 @@ func (r *DataNetworkReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return reconcile.Result{}, err
 	}
 
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system nor the resource is modified.
+		if !utils.IsReconcilerEnabled(utils.DataNetwork) {
+			return reconcile.Result{}, nil
+		}
+
+		if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+			// Nothing is removed from the system when a resource is orphaned.
+			return reconcile.Result{}, nil
+		}
+
+		platformClient := r.GetPlatformClient(request.Namespace)
+		if platformClient == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		err = r.ReconcilePlan(platformClient, instance)
+		return reconcile.Result{}, err
+	}
+
 	if err, _ := r.UpdateDeploymentScope(instance); err != nil {
 		return r.HandleReconcilerError(request, err)
 	}
@@ -554,6 +661,17 @@ func (r *DataNetworkReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		}
 	}
 
+	if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+		// The resource is being released without removing it from the
+		// system so the only thing left to do is to drop the finalizer.
+		if utils.ContainsString(instance.Finalizers, DataNetworkFinalizerName) {
+			r.NormalEvent(instance, common.ResourceDeleted,
+				"data network orphaned; system resource left in place")
+			r.removeDataNetworkFinalizer(instance)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if !utils.IsReconcilerEnabled(utils.DataNetwork) {
 		return reconcile.Result{}, nil
 	}
@@ -567,20 +685,54 @@ func (r *DataNetworkReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return common.RetryMissingClient, nil
 	}
 
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if !r.GetSystemReady(request.Namespace) {
 		r.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
 		return common.RetrySystemNotReady, nil
 	}
 
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
 	err = r.ReconcileResource(platformClient, instance)
 	if err != nil {
 		return r.HandleReconcilerError(request, err)
 	}
 
+	if instance.DeletionTimestamp.IsZero() {
+		err = r.ReconcileUsage(platformClient, instance)
+		if err != nil {
+			return r.HandleReconcilerError(request, err)
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// ReconcilePlan runs the data network reconciliation against plan mode
+// clients and publishes the system API requests that it would have issued
+// in the resource status.
+func (r *DataNetworkReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.DataNetwork) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logDataNetwork)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy())
+
+	return p.Publish(r.Client, instance, result)
+}
+
 // UpdateDeploymentScope function is used to update the deployment scope for DataNetwork.
 func (r *DataNetworkReconciler) UpdateDeploymentScope(instance *starlingxv1.DataNetwork) (error, bool) {
 	updated, err := common.UpdateDeploymentScope(r.Client, instance)
@@ -605,5 +757,11 @@ func (r *DataNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Logger:        logDataNetwork}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.DataNetwork{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.DataNetworkList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
+		Watches(&starlingxv1.Host{}, handler.EnqueueRequestsFromMapFunc(r.dataNetworksForHost),
+			builder.WithPredicates(usageChangedPredicate)).
+		Watches(&starlingxv1.HostProfile{}, handler.EnqueueRequestsFromMapFunc(r.dataNetworksForHost),
+			builder.WithPredicates(usageChangedPredicate)).
 		Complete(r)
 }
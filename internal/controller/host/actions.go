@@ -0,0 +1,344 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/idisks"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// hostActionReset is the system action which power-cycles a host through its
+// board management controller.
+const hostActionReset = "reset"
+
+// hostAvailOnline is the availability status of a locked host which is
+// running and reachable.
+const hostAvailOnline = "online"
+
+// actionStartTimeout is the time allowed for a host to show that it has
+// started running an action once the action has been sent.  A host which
+// shows no sign of running the action within this time may have ignored it,
+// or may have completed it while it was not being monitored, so the outcome
+// is unknown and the action is failed rather than waited on forever.
+const actionStartTimeout = 15 * time.Minute
+
+// actionStarted is a utility function which determines whether a host shows a
+// positive sign that an action is running; either the system reports a task
+// for the host or the host is no longer online.
+func actionStarted(host *hosts.Host) bool {
+	return !host.Idle() || host.AvailabilityStatus != hostAvailOnline
+}
+
+// isRootDisk is a utility function which determines whether a disk is the
+// root disk of a host.  The root device may be reported as a short device
+// name, a device node, or a device path.
+func isRootDisk(disk *idisks.Disk, rootDevice string) bool {
+	if rootDevice == "" {
+		return false
+	}
+
+	if !strings.HasPrefix(rootDevice, "/") {
+		rootDevice = "/dev/" + rootDevice
+	}
+
+	return disk.DeviceNode == rootDevice || disk.DevicePath == rootDevice
+}
+
+// disksToWipe is a utility function which returns the disks to be wiped before
+// a host is reinstalled.  The root disk is excluded since it is overwritten by
+// the installation and the system refuses to wipe it.
+func disksToWipe(disks []idisks.Disk, rootDevice string) []idisks.Disk {
+	result := make([]idisks.Disk, 0)
+	for i := range disks {
+		if !isRootDisk(&disks[i], rootDevice) {
+			result = append(result, disks[i])
+		}
+	}
+	return result
+}
+
+// isRejectedRequest is a utility function which determines whether an error
+// was caused by the system rejecting a request rather than by a failure to
+// reach it.
+func isRejectedRequest(err error) bool {
+	switch perrors.Cause(err).(type) {
+	case gophercloud.ErrDefault400, gophercloud.ErrDefault403, gophercloud.ErrDefault409:
+		return true
+	}
+	return false
+}
+
+// failAction is a utility method which records the failure of an action.
+// Failed actions are not retried until a new request is made.
+func (r *HostReconciler) failAction(instance *starlingxv1.Host, reason string) {
+	status := instance.Status.Action
+	now := metav1.Now()
+
+	status.Phase = starlingxv1.HostActionPhaseFailed
+	status.Reason = &reason
+	status.CompletionTime = &now
+
+	r.WarningEvent(instance, common.ResourceUpdated,
+		"host action %s %q has failed: %s", status.Type, status.Request, reason)
+}
+
+// validateAction is a utility method which determines whether an action can
+// be run safely on a host.  An empty string is returned if it can.
+func (r *HostReconciler) validateAction(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) (string, error) {
+	active, err := r.IsActiveHost(client, instance, instance.Namespace)
+	if err != nil {
+		return "", err
+	} else if active {
+		return "actions cannot be run on the active controller", nil
+	}
+
+	if instance.Status.Action.Type == starlingxv1.HostActionPowerCycle {
+		if !isBMProvisioned(host) {
+			return "board management must be configured to power-cycle the host", nil
+		}
+	}
+
+	return "", nil
+}
+
+// wipeDisks is a utility method which wipes every disk of a host other than
+// its root disk.
+func (r *HostReconciler) wipeDisks(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) error {
+	disks, err := idisks.ListDisks(client, host.ID)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to list disks: %s", host.ID)
+		return err
+	}
+
+	for _, disk := range disksToWipe(disks, host.RootDevice) {
+		logHost.Info("wiping disk", "id", disk.ID, "node", disk.DeviceNode)
+
+		_, err = idisks.Wipe(client, disk.ID).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to wipe disk: %s", disk.DeviceNode)
+			return err
+		}
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"disk %s has been wiped", disk.DeviceNode)
+	}
+
+	return nil
+}
+
+// sendAction is a utility method which sends an action to a host and
+// returns the updated host.
+func (r *HostReconciler) sendAction(client *gophercloud.ServiceClient, host *hosts.Host, action string) (*hosts.Host, error) {
+	opts := hosts.HostOpts{Action: &action}
+
+	logHost.Info("sending action to host", "opts", opts)
+
+	result, err := hosts.Update(client, host.ID, opts).Extract()
+	if err != nil || result == nil {
+		err = perrors.Wrapf(err, "failed to send action to host: %s, %s",
+			host.ID, common.FormatStruct(opts))
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// lockForAction is a utility method which arranges for a host to be locked
+// before an action is run.  While the host is being bootstrapped it is locked
+// directly, as it is by ReconcileInitialState, but once it has been deployed
+// it is only locked through a strategy; the host is marked as requiring a
+// lock and is locked by the strategy framework along with any other host
+// requiring one.
+func (r *HostReconciler) lockForAction(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) error {
+	status := instance.Status.Action
+
+	if instance.Status.DeploymentScope == cloudManager.ScopeBootstrap && !r.GetStrategySent() {
+		_, err := r.sendAction(client, host, hosts.ActionLock)
+		if err != nil {
+			return err
+		}
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"host has been locked to run action %s", status.Type)
+		return nil
+	}
+
+	if instance.Status.StrategyRequired == cloudManager.StrategyLockRequired {
+		return nil
+	}
+
+	instance.Status.StrategyRequired = cloudManager.StrategyLockRequired
+	r.SetResourceInfo(cloudManager.ResourceHost, host.Personality, instance.Name,
+		instance.Status.Reconciled, instance.Status.StrategyRequired)
+
+	r.NormalEvent(instance, common.ResourceUpdated,
+		"host lock has been requested to run action %s", status.Type)
+
+	return nil
+}
+
+// runAction is a utility method which advances an action through its
+// phases.  Each phase ends by starting a monitor which triggers a new
+// reconciliation once the host has reached the state required by the next
+// phase.
+func (r *HostReconciler) runAction(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) error {
+	status := instance.Status.Action
+
+	switch status.Phase {
+	case starlingxv1.HostActionPhaseLocking:
+		if host.AdministrativeState != hosts.AdminLocked {
+			err := r.lockForAction(client, instance, host)
+			if err != nil {
+				if isRejectedRequest(err) {
+					r.failAction(instance, fmt.Sprintf("failed to lock host: %s", err.Error()))
+					return nil
+				}
+				return err
+			}
+		}
+
+		if !host.IsLockedDisabled() || !host.Idle() {
+			msg := "waiting for host to be locked before running action"
+			return r.StartMonitor(NewLockedDisabledHostMonitor(instance, host.ID), msg)
+		}
+
+		if status.Type == starlingxv1.HostActionWipeReinstall {
+			err := r.wipeDisks(client, instance, host)
+			if err != nil {
+				if isRejectedRequest(err) {
+					r.failAction(instance, err.Error())
+					return nil
+				}
+				return err
+			}
+		}
+
+		action := hosts.ActionReinstall
+		if status.Type == starlingxv1.HostActionPowerCycle {
+			action = hostActionReset
+		}
+
+		result, err := r.sendAction(client, host, action)
+		if err != nil {
+			if isRejectedRequest(err) {
+				r.failAction(instance, err.Error())
+				return nil
+			}
+			return err
+		}
+
+		*host = *result
+		now := metav1.Now()
+		status.Phase = starlingxv1.HostActionPhaseExecuting
+		status.SentTime = &now
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"host action %s %q has been sent", status.Type, status.Request)
+
+		fallthrough
+
+	case starlingxv1.HostActionPhaseExecuting:
+		// The host is idle and online both before the action starts and
+		// after it completes, so only a positive sign that the action is
+		// running, such as the task reported in response to the action
+		// itself, allows waiting for the host to recover.
+		if !actionStarted(host) {
+			deadline := time.Now().Add(actionStartTimeout)
+			if status.SentTime != nil {
+				deadline = status.SentTime.Add(actionStartTimeout)
+			}
+
+			if time.Now().After(deadline) {
+				r.failAction(instance, fmt.Sprintf(
+					"host did not show any sign of running the action within %s", actionStartTimeout))
+				return nil
+			}
+
+			msg := "waiting for host to start running action"
+			return r.StartMonitor(NewActionStartedMonitor(instance, host.ID, deadline), msg)
+		}
+
+		status.Phase = starlingxv1.HostActionPhaseRecovering
+
+		fallthrough
+
+	case starlingxv1.HostActionPhaseRecovering:
+		if !host.IsLockedDisabled() || !host.Idle() || host.AvailabilityStatus != hostAvailOnline {
+			msg := "waiting for host to recover from action"
+			return r.StartMonitor(NewLockedOnlineHostMonitor(instance, host.ID), msg)
+		}
+
+		now := metav1.Now()
+		status.Phase = starlingxv1.HostActionPhaseCompleted
+		status.CompletionTime = &now
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"host action %s %q has completed", status.Type, status.Request)
+	}
+
+	return nil
+}
+
+// ReconcileAction is responsible for running the one-shot action requested
+// on a host.  A new action is started whenever the request value differs from
+// the one last recorded in the status, but only once any action in progress
+// has finished.  The configuration of the host is not reconciled while an
+// action is in progress so that the reconciler does not undo the state
+// changes made by the action (e.g., by unlocking the host).
+func (r *HostReconciler) ReconcileAction(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) error {
+	spec := instance.Spec.Action
+	status := instance.Status.Action
+
+	if status == nil || status.Finished() {
+		if spec == nil || (status != nil && status.Request == spec.Request) {
+			return nil
+		}
+
+		now := metav1.Now()
+		instance.Status.Action = &starlingxv1.HostActionStatus{
+			Type:      spec.Type,
+			Request:   spec.Request,
+			Phase:     starlingxv1.HostActionPhaseLocking,
+			StartTime: &now,
+		}
+
+		reason, err := r.validateAction(client, instance, host)
+		if err != nil {
+			return err
+		} else if reason != "" {
+			r.failAction(instance, reason)
+		} else {
+			r.NormalEvent(instance, common.ResourceUpdated,
+				"host action %s %q has been started", spec.Type, spec.Request)
+		}
+	}
+
+	original := status.DeepCopy()
+	strategyRequired := instance.Status.StrategyRequired
+
+	err := r.runAction(client, instance, host)
+
+	if original == nil || !original.DeepEqual(instance.Status.Action) ||
+		strategyRequired != instance.Status.StrategyRequired {
+		err2 := r.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				common.FormatStruct(instance.Status))
+			return err2
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,73 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package host
+
+import (
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/idisks"
+)
+
+var _ = Describe("disksToWipe", func() {
+	sdaPath := "/dev/disk/by-path/pci-0000:00:1f.2-ata-1.0"
+	disks := []idisks.Disk{
+		{ID: "d1", DeviceNode: "/dev/sda", DevicePath: sdaPath},
+		{ID: "d2", DeviceNode: "/dev/sdb"},
+		{ID: "d3", DeviceNode: "/dev/nvme0n1"},
+	}
+
+	Context("when the root device is a short device name", func() {
+		It("should exclude the root disk", func() {
+			result := disksToWipe(disks, "sda")
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].ID).To(Equal("d2"))
+			Expect(result[1].ID).To(Equal("d3"))
+		})
+	})
+
+	Context("when the root device is a device path", func() {
+		It("should exclude the root disk", func() {
+			result := disksToWipe(disks, sdaPath)
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].ID).To(Equal("d2"))
+		})
+	})
+
+	Context("when the root device is unknown", func() {
+		It("should not exclude any disk", func() {
+			Expect(disksToWipe(disks, "")).To(HaveLen(3))
+		})
+	})
+})
+
+var _ = Describe("HostActionStatus", func() {
+	It("should only be finished once completed or failed", func() {
+		status := starlingxv1.HostActionStatus{Phase: starlingxv1.HostActionPhaseRecovering}
+		Expect(status.Finished()).To(BeFalse())
+		status.Phase = starlingxv1.HostActionPhaseCompleted
+		Expect(status.Finished()).To(BeTrue())
+		status.Phase = starlingxv1.HostActionPhaseFailed
+		Expect(status.Finished()).To(BeTrue())
+	})
+})
+
+var _ = Describe("actionStarted", func() {
+	task := "Reinstalling"
+
+	It("should not consider an idle and online host as started", func() {
+		host := &hosts.Host{AvailabilityStatus: hostAvailOnline}
+		Expect(actionStarted(host)).To(BeFalse())
+	})
+
+	It("should consider a host running a task as started", func() {
+		host := &hosts.Host{AvailabilityStatus: hostAvailOnline, Task: &task}
+		Expect(actionStarted(host)).To(BeTrue())
+	})
+
+	It("should consider a host which is no longer online as started", func() {
+		host := &hosts.Host{AvailabilityStatus: "offline"}
+		Expect(actionStarted(host)).To(BeTrue())
+	})
+})
@@ -0,0 +1,179 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// bmSecretIndex is the name of the field index which maps a host resource to
+// the BM credentials secret last applied to it.
+const bmSecretIndex = "status.boardManagement.secret"
+
+// indexBMSecret is the field index function which extracts the name of the
+// BM credentials secret last applied to a host.  The status is used rather
+// than the profile so that secrets referenced through base profiles or host
+// overrides are resolved the same way as during reconciliation.
+func indexBMSecret(obj client.Object) []string {
+	instance, ok := obj.(*starlingxv1.Host)
+	if !ok || instance.Status.BoardManagement == nil || instance.Status.BoardManagement.Secret == "" {
+		return nil
+	}
+
+	return []string{instance.Status.BoardManagement.Secret}
+}
+
+// hostsForBMSecret maps a change to a secret to a reconcile request for every
+// host which uses it as its BM credentials secret.  A single secret update
+// therefore rotates the credentials of every host which shares it.
+func (r *HostReconciler) hostsForBMSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &starlingxv1.HostList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{bmSecretIndex: obj.GetName()})
+	if err != nil {
+		logHost.Error(err, "failed to list hosts using BM secret", "secret", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: item.Namespace, Name: item.Name}})
+	}
+
+	return requests
+}
+
+// isBMProvisioned is a utility function which determines whether board
+// management is configured on a host.
+func isBMProvisioned(host *hosts.Host) bool {
+	return host.BMType != nil && *host.BMType != "" && *host.BMType != hosts.BMTypeDisabled
+}
+
+// BMCredentialsRotated determines whether the BM credentials secret last
+// applied to a host has changed since.
+func (r *HostReconciler) BMCredentialsRotated(instance *starlingxv1.Host) bool {
+	status := instance.Status.BoardManagement
+	if status == nil || status.Secret == "" {
+		return false
+	}
+
+	secret, err := r.getBMSecret(instance.Namespace, status.Secret)
+	if err != nil {
+		return false
+	}
+
+	return secret.ResourceVersion != status.SecretVersion
+}
+
+// pushBMCredentials is a utility method which applies the credentials stored
+// in a secret to a host.  The type and address are sent along with them since
+// the system validates the board management attributes as a whole.
+func (r *HostReconciler) pushBMCredentials(client *gophercloud.ServiceClient, host *hosts.Host, secret *v1.Secret) error {
+	username, password, err := getBMSecretCredentials(secret)
+	if err != nil {
+		return err
+	}
+
+	err = r.checkBMCredentialsTransport(client)
+	if err != nil {
+		return err
+	}
+
+	opts := hosts.HostOpts{
+		BMType:     host.BMType,
+		BMAddress:  host.BMAddress,
+		BMUsername: &username,
+		BMPassword: &password,
+	}
+
+	logHost.Info("updating BM credentials", "secret", secret.Name)
+
+	result, err := hosts.Update(client, host.ID, opts).Extract()
+	if err != nil || result == nil {
+		err = perrors.Wrapf(err, "failed to update BM credentials: %s", host.ID)
+		return err
+	}
+
+	*host = *result
+
+	return nil
+}
+
+// ReconcileBMCredentials is responsible for applying the BM credentials of a
+// host again whenever their secret is changed, and for reporting the board
+// management state of the host.  The credentials already configured on a
+// host are assumed to be current the first time that the secret is recorded
+// since the attribute reconciler applies them when board management is first
+// provisioned.
+func (r *HostReconciler) ReconcileBMCredentials(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *hosts.Host) error {
+	current := instance.Status.BoardManagement
+	status := &starlingxv1.BMStatus{Provisioned: isBMProvisioned(host)}
+
+	bm := profile.BoardManagement
+	if status.Provisioned && bm != nil && bm.Credentials != nil && bm.Credentials.Password != nil {
+		name := bm.Credentials.Password.Secret
+
+		secret, err := r.getBMSecret(instance.Namespace, name)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				// The attribute reconciler waits for the secret to appear.
+				return nil
+			}
+			return err
+		}
+
+		status.Secret = name
+		status.SecretVersion = secret.ResourceVersion
+
+		if current != nil && current.Secret != "" &&
+			(current.Secret != status.Secret || current.SecretVersion != status.SecretVersion) {
+			err = r.pushBMCredentials(client, host, secret)
+			if err != nil {
+				if !isRejectedRequest(err) {
+					return err
+				}
+
+				// Rejected credentials are not retried until the secret is
+				// changed again.
+				reason := err.Error()
+				status.Error = &reason
+
+				r.WarningEvent(instance, common.ResourceUpdated,
+					"BM credentials from secret %q were rejected: %s", name, reason)
+			} else {
+				r.NormalEvent(instance, common.ResourceUpdated,
+					"BM credentials have been updated from secret %q", name)
+			}
+		} else if current != nil {
+			status.Error = current.Error
+		}
+	}
+
+	if current != nil && current.DeepEqual(status) {
+		return nil
+	}
+
+	instance.Status.BoardManagement = status
+
+	err := r.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package host
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+var _ = Describe("BM credentials", func() {
+	bmType := "redfish"
+	bmAddress := "192.168.9.9"
+
+	Describe("indexBMSecret", func() {
+		It("should index the secret last applied to the host", func() {
+			instance := newHostInstance("worker-0", "default", false, nil)
+			Expect(indexBMSecret(instance)).To(BeEmpty())
+			instance.Status.BoardManagement = &starlingxv1.BMStatus{Provisioned: true, Secret: "bmc-secret"}
+			Expect(indexBMSecret(instance)).To(Equal([]string{"bmc-secret"}))
+		})
+	})
+
+	Describe("ReconcileBMCredentials", func() {
+		var (
+			secret   *v1.Secret
+			instance *starlingxv1.Host
+			profile  *starlingxv1.HostProfileSpec
+			host     *hosts.Host
+		)
+
+		BeforeEach(func() {
+			secret = &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bmc-secret", Namespace: "default"},
+				Data: map[string][]byte{
+					usernameKey: []byte("admin"),
+					passwordKey: []byte("secret"),
+				},
+			}
+			Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+
+			instance = newHostInstance("worker-0", "default", true, nil)
+			profile = &starlingxv1.HostProfileSpec{
+				BoardManagement: &starlingxv1.BMInfo{
+					Type:    &bmType,
+					Address: &bmAddress,
+					Credentials: &starlingxv1.BMCredentials{
+						Password: &starlingxv1.BMPasswordInfo{Secret: "bmc-secret"},
+					},
+				},
+			}
+			host = &hosts.Host{ID: "worker-0-id", BMType: &bmType, BMAddress: &bmAddress}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(context.Background(), secret)).To(Succeed())
+		})
+
+		It("should not push credentials that were already applied", func() {
+			r := newTestHostReconciler(nil)
+			instance.Status.BoardManagement = &starlingxv1.BMStatus{
+				Provisioned:   true,
+				Secret:        secret.Name,
+				SecretVersion: secret.ResourceVersion,
+			}
+
+			server, client := newTestServiceClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "unexpected call", http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			Expect(r.BMCredentialsRotated(instance)).To(BeFalse())
+			Expect(r.ReconcileBMCredentials(client, instance, profile, host)).To(Succeed())
+		})
+
+		It("should detect a rotated secret", func() {
+			r := newTestHostReconciler(nil)
+			instance.Status.BoardManagement = &starlingxv1.BMStatus{
+				Provisioned:   true,
+				Secret:        secret.Name,
+				SecretVersion: secret.ResourceVersion,
+			}
+
+			secret.Data[passwordKey] = []byte("rotated")
+			Expect(k8sClient.Update(context.Background(), secret)).To(Succeed())
+
+			Expect(r.BMCredentialsRotated(instance)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,194 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
+	"k8s.io/utils/ptr"
+)
+
+// findPCIDevices returns the list of host PCI devices that are selected by the
+// address and/or device ID of a PCI device profile entry.
+func findPCIDevices(info starlingxv1.PCIDeviceInfo, devices []pcidevices.PCIDevice) []pcidevices.PCIDevice {
+	result := make([]pcidevices.PCIDevice, 0)
+
+	for _, d := range devices {
+		if info.Matches(d.Address, d.DeviceID) {
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+// pciDeviceUpdateRequired determines whether a PCI device needs to be updated
+// to match its profile entry and returns the update options if so.
+func pciDeviceUpdateRequired(info starlingxv1.PCIDeviceInfo, device *pcidevices.PCIDevice) (opts pcidevices.PCIDeviceOpts, result bool) {
+	if info.Enabled != nil && *info.Enabled != device.Enabled {
+		opts.Enabled = info.Enabled
+		result = true
+	}
+
+	if info.Driver != nil && (device.Driver == nil || *info.Driver != *device.Driver) {
+		opts.Driver = info.Driver
+		result = true
+	}
+
+	if info.VFCount != nil && (device.VFCount == nil || *info.VFCount != *device.VFCount) {
+		opts.VFCount = info.VFCount
+		result = true
+	}
+
+	if info.VFDriver != nil && (device.VFDriver == nil || *info.VFDriver != *device.VFDriver) {
+		opts.VFDriver = info.VFDriver
+		result = true
+	}
+
+	return opts, result
+}
+
+// isDefaultPCIDeviceInfo determines whether the attributes requested by a
+// PCI device profile entry are equal to those of an unconfigured device.
+// Unconfigured devices are not included in the profile built from the current
+// host configuration therefore such entries are considered in sync when no
+// matching device is present.
+func isDefaultPCIDeviceInfo(info starlingxv1.PCIDeviceInfo) bool {
+	if info.Enabled != nil && !*info.Enabled {
+		return false
+	}
+	if info.Driver != nil && *info.Driver != "" {
+		return false
+	}
+	if info.VFCount != nil && *info.VFCount != 0 {
+		return false
+	}
+	if info.VFDriver != nil && *info.VFDriver != "" {
+		return false
+	}
+	return true
+}
+
+// withPCIDeviceDefaults returns a copy of a PCI device profile entry with all
+// unset attributes filled in with the values of an unconfigured device.
+func withPCIDeviceDefaults(info starlingxv1.PCIDeviceInfo) *starlingxv1.PCIDeviceInfo {
+	result := info.DeepCopy()
+
+	if result.Enabled == nil {
+		result.Enabled = ptr.To(true)
+	}
+	if result.Driver == nil {
+		result.Driver = ptr.To("")
+	}
+	if result.VFCount == nil {
+		result.VFCount = ptr.To(0)
+	}
+	if result.VFDriver == nil {
+		result.VFDriver = ptr.To("")
+	}
+
+	return result
+}
+
+// CompareDevices determines whether the PCI devices listed in a profile spec
+// are configured as requested in the profile built from the current host
+// configuration.  Only the devices listed in the desired profile are
+// considered since all other devices are left untouched.
+func (r *HostReconciler) CompareDevices(in *starlingxv1.HostProfileSpec, other *starlingxv1.HostProfileSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	for _, info := range in.Devices {
+		found := false
+
+		for _, current := range other.Devices {
+			if current.Address == nil || current.DeviceID == nil {
+				continue
+			}
+
+			if !info.Matches(*current.Address, *current.DeviceID) {
+				continue
+			}
+
+			found = true
+
+			// The desired entry omits the selector it was not keyed by
+			// therefore only compare the requested attributes, and the
+			// current entry omits attributes that are set to their default.
+			desired := info.DeepCopy()
+			desired.Address = nil
+			desired.DeviceID = nil
+			if !desired.DeepEqual(withPCIDeviceDefaults(current)) {
+				return false
+			}
+		}
+
+		if !found && !isDefaultPCIDeviceInfo(info) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ReconcileDevices is responsible for reconciling the PCI device configuration
+// of a host resource.
+func (r *HostReconciler) ReconcileDevices(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	updated := false
+
+	if len(profile.Devices) == 0 || !common.IsReconcilerEnabled(common.PCIDevice) {
+		return nil
+	}
+
+	for _, info := range profile.Devices {
+		devices := findPCIDevices(info, host.PCIDevices)
+		if len(devices) == 0 {
+			msg := fmt.Sprintf("failed to find PCI device matching %s",
+				utils.FormatStruct(info))
+			return starlingxv1.NewMissingSystemResource(msg)
+		}
+
+		for _, d := range devices {
+			opts, ok := pciDeviceUpdateRequired(info, &d)
+			if !ok {
+				continue
+			}
+
+			logHost.Info("updating PCI device", "address", d.Address, "opts", opts)
+
+			_, err := pcidevices.Update(client, d.ID, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to update PCI device: %s, %s",
+					d.Address, utils.FormatStruct(opts))
+				return err
+			}
+
+			r.NormalEvent(instance, utils.ResourceUpdated,
+				"PCI device %q has been updated", d.Address)
+
+			updated = true
+		}
+	}
+
+	if updated {
+		result, err := pcidevices.ListPCIDevices(client, host.ID)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to refresh host PCI devices")
+			return err
+		}
+
+		// update the hostinfo 'cache'
+		host.PCIDevices = result
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package host
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
+)
+
+var _ = Describe("pciDeviceUpdateRequired", func() {
+	deviceID := "0d5c"
+	driver := "vfio-pci"
+
+	Context("when the device matches the requested attributes", func() {
+		It("should return false", func() {
+			vfCount := 8
+			info := starlingxv1.PCIDeviceInfo{DeviceID: &deviceID, Driver: &driver, VFCount: &vfCount}
+			device := &pcidevices.PCIDevice{DeviceID: deviceID, Enabled: true, Driver: &driver, VFCount: &vfCount}
+			_, required := pciDeviceUpdateRequired(info, device)
+			Expect(required).To(BeFalse())
+		})
+	})
+
+	Context("when the virtual function count differs", func() {
+		It("should return true with only the changed attributes", func() {
+			current := 0
+			vfCount := 8
+			info := starlingxv1.PCIDeviceInfo{DeviceID: &deviceID, Driver: &driver, VFCount: &vfCount}
+			device := &pcidevices.PCIDevice{DeviceID: deviceID, Enabled: true, Driver: &driver, VFCount: &current}
+			opts, required := pciDeviceUpdateRequired(info, device)
+			Expect(required).To(BeTrue())
+			Expect(opts.Driver).To(BeNil())
+			Expect(*opts.VFCount).To(Equal(8))
+		})
+	})
+})
+
+var _ = Describe("CompareDevices", func() {
+	r := &HostReconciler{}
+	address := "0000:b3:00.0"
+	deviceID := "0d5c"
+	driver := "vfio-pci"
+	enabled := true
+
+	Context("when a device selected by device ID is configured", func() {
+		It("should return true", func() {
+			vfCount := 8
+			in := &starlingxv1.HostProfileSpec{
+				Devices: starlingxv1.PCIDeviceList{
+					{DeviceID: &deviceID, Driver: &driver, VFCount: &vfCount},
+				},
+			}
+			other := &starlingxv1.HostProfileSpec{
+				Devices: starlingxv1.PCIDeviceList{
+					{Address: &address, DeviceID: &deviceID, Enabled: &enabled, Driver: &driver, VFCount: &vfCount},
+				},
+			}
+			Expect(r.CompareDevices(in, other)).To(BeTrue())
+		})
+	})
+
+	Context("when a device is not yet configured", func() {
+		It("should return false", func() {
+			vfCount := 8
+			in := &starlingxv1.HostProfileSpec{
+				Devices: starlingxv1.PCIDeviceList{
+					{Address: &address, VFCount: &vfCount},
+				},
+			}
+			other := &starlingxv1.HostProfileSpec{}
+			Expect(r.CompareDevices(in, other)).To(BeFalse())
+		})
+	})
+
+	Context("when a device is requested with default attributes", func() {
+		It("should return true if the device is not configured", func() {
+			vfCount := 0
+			in := &starlingxv1.HostProfileSpec{
+				Devices: starlingxv1.PCIDeviceList{
+					{Address: &address, Enabled: &enabled, VFCount: &vfCount},
+				},
+			}
+			other := &starlingxv1.HostProfileSpec{}
+			Expect(r.CompareDevices(in, other)).To(BeTrue())
+		})
+
+		It("should return false if the device is still configured", func() {
+			vfCount := 0
+			current := 8
+			in := &starlingxv1.HostProfileSpec{
+				Devices: starlingxv1.PCIDeviceList{
+					{Address: &address, VFCount: &vfCount},
+				},
+			}
+			other := &starlingxv1.HostProfileSpec{
+				Devices: starlingxv1.PCIDeviceList{
+					{Address: &address, DeviceID: &deviceID, Enabled: &enabled, VFCount: &current},
+				},
+			}
+			Expect(r.CompareDevices(in, other)).To(BeFalse())
+		})
+	})
+})
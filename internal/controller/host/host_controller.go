@@ -28,7 +28,9 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -153,24 +155,30 @@ func (r *HostReconciler) IsActiveHost(client *gophercloud.ServiceClient, instanc
 	return false, nil
 }
 
-// getBMPasswordCredentials is a utility to retrieve the host's board management
-// credentials from the information stored in the specified secret.
-func (r *HostReconciler) getBMPasswordCredentials(namespace string, name string) (username, password string, err error) {
+// getBMSecret is a utility to retrieve the secret which contains the host's
+// board management credentials.
+func (r *HostReconciler) getBMSecret(namespace string, name string) (*v1.Secret, error) {
 	secret := &v1.Secret{}
 	secretName := types.NamespacedName{Namespace: namespace, Name: name}
 
 	// Lookup the secret via the system client.
-	err = r.Get(context.TODO(), secretName, secret)
+	err := r.Get(context.TODO(), secretName, secret)
 	if err != nil {
 		if !errors.IsNotFound(err) {
 			err = perrors.Wrap(err, "failed to get host BM secret")
 		}
-		return "", "", err
+		return nil, err
 	}
 
+	return secret, nil
+}
+
+// getBMSecretCredentials is a utility to extract the host's board management
+// credentials from a secret.
+func getBMSecretCredentials(secret *v1.Secret) (username, password string, err error) {
 	// Make sure that required keys are present.
 	for _, key := range []string{usernameKey, passwordKey} {
-		if _, ok := secret.Data[usernameKey]; !ok {
+		if _, ok := secret.Data[key]; !ok {
 			msg := fmt.Sprintf("missing %q key within BM credential secret", key)
 			return "", "", common.NewUserDataError(msg)
 		}
@@ -179,6 +187,17 @@ func (r *HostReconciler) getBMPasswordCredentials(namespace string, name string)
 	return string(secret.Data[usernameKey]), string(secret.Data[passwordKey]), nil
 }
 
+// getBMPasswordCredentials is a utility to retrieve the host's board management
+// credentials from the information stored in the specified secret.
+func (r *HostReconciler) getBMPasswordCredentials(namespace string, name string) (username, password string, err error) {
+	secret, err := r.getBMSecret(namespace, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	return getBMSecretCredentials(secret)
+}
+
 // buildInitialHostOpts is a utility to assemble the options required to
 // provision a host that needs to be statically provisioned.  Further
 // provisioning of other host attributes will be handled at a later stage.
@@ -400,18 +419,31 @@ func (r *HostReconciler) HTTPSRequired() bool {
 	return true
 }
 
+// checkBMCredentialsTransport determines whether BM credentials can be sent
+// to the system over the connection used by the client.
+func (r *HostReconciler) checkBMCredentialsTransport(client *gophercloud.ServiceClient) error {
+	if strings.HasPrefix(client.Endpoint, cloudManager.HTTPPrefix) {
+		if r.HTTPSRequired() {
+			// Do not send password information in the clear.
+			msg := "it is unsafe to configure BM credentials thru a non HTTPS URL"
+			return common.NewSystemDependency(msg)
+		} else {
+			logHost.Info("allowing BMC configuration over HTTP connection")
+		}
+	}
+
+	return nil
+}
+
 // ReconcileAttributes is responsible for reconciling the basic attributes for a
 // host resource.
 func (r *HostReconciler) ReconcileAttributes(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *hosts.Host) error {
 	if opts, ok, err := r.UpdateRequired(instance, profile, host); ok && err == nil {
 
-		if opts.BMPassword != nil && strings.HasPrefix(client.Endpoint, cloudManager.HTTPPrefix) {
-			if r.HTTPSRequired() {
-				// Do not send password information in the clear.
-				msg := "it is unsafe to configure BM credentials thru a non HTTPS URL"
-				return common.NewSystemDependency(msg)
-			} else {
-				logHost.Info("allowing BMC configuration over HTTP connection")
+		if opts.BMPassword != nil {
+			err = r.checkBMCredentialsTransport(client)
+			if err != nil {
+				return err
 			}
 		}
 
@@ -841,6 +873,11 @@ func (r *HostReconciler) ReconcileDisabledHost(client *gophercloud.ServiceClient
 		}
 	}
 
+	err = r.ReconcileDevices(client, instance, profile, host)
+	if err != nil {
+		return err
+	}
+
 	err = r.ReconcileLabels(client, instance, profile, host)
 	if err != nil {
 		return err
@@ -1120,6 +1157,12 @@ func (r *HostReconciler) CompareDisabledAttributes(in *starlingxv1.HostProfileSp
 		}
 	}
 
+	if utils.IsReconcilerEnabled(utils.PCIDevice) {
+		if !r.CompareDevices(in, other) {
+			return false
+		}
+	}
+
 	if utils.IsReconcilerEnabled(utils.FileSystemTypes) {
 		if !r.CompareFileSystemTypes(in, other) {
 			return false
@@ -1763,11 +1806,32 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 	}
 	defaults.BoardManagement = &bmInfo
 
+	// Disk selectors are resolved to device paths before merging since the
+	// storage resources of both profiles are matched by their device path.
+	err = ResolveDiskSelectors(profile, &hostInfo)
+	if err != nil {
+		return err
+	}
+
+	// Port selectors are resolved to port names for the same reason since
+	// Ethernet interfaces are matched by their port name.
+	err = ResolvePortSelectors(profile, &hostInfo)
+	if err != nil {
+		return err
+	}
+
+	// Address pool references are converted to static addresses so that
+	// they are configured like any other address of the profile.
+	err = r.ReconcilePoolAddresses(client, instance, profile)
+	if err != nil {
+		return err
+	}
+
 	// Create a new composite profile that is backed by the host's default
 	// configuration.  This will ensure that if a user deletes an optional
 	// attribute that we will know how to restore the original value.
 	logHost.Info("merging profiles", "host", host.ID)
-	profile, err = MergeProfiles(defaults, profile)
+	profile, err = common.MergeProfiles(defaults, profile)
 	if err != nil {
 		return perrors.Wrap(err, "failed to merge profiles")
 	}
@@ -1791,6 +1855,19 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 		return err
 	}
 
+	// Filesystem size policies are relative to the space available on this
+	// particular host so they are converted to absolute sizes before being
+	// compared against the current configuration.
+	ResolveFileSystemSizes(profile, &hostInfo)
+
+	// Processor and memory allocation policies are likewise relative to the
+	// topology of this particular host.  The resolved values are reported in
+	// the host status so that users can see what each policy amounted to.
+	err = r.ResolveAllocationPolicies(instance, profile, &hostInfo)
+	if err != nil {
+		return err
+	}
+
 	// Normalize volume group fields so that system-calculated lvmType and
 	// lvmPoolSize do not produce false deltas when not explicitly set by the user.
 	//
@@ -2077,11 +2154,21 @@ func (r *HostReconciler) ReconcileResource(
 				}
 
 				logHost.Info("Host successfully removed")
-				// Only remove the finalizer after successful deletion
-				defer r.removeHostFinalizer(instance)
 			} else {
 				logHost.Info("host being deleted is no longer present on system")
 			}
+
+			// Addresses allocated from address pools can only be handed out
+			// again once the host is gone from the system.
+			err = r.ReleasePoolAddresses(instance)
+			if err != nil {
+				return err
+			}
+
+			if host != nil {
+				// Only remove the finalizer after successful deletion
+				defer r.removeHostFinalizer(instance)
+			}
 		}
 
 		// Remove deleted host from CephPrimaryGroup
@@ -2114,11 +2201,21 @@ func (r *HostReconciler) ReconcileResource(
 		}
 	}
 
-	// Check that the current configuration of a host matches the desired state.
-	// This also captures errors from platform network subreconciler separately
-	// thus enabling conditional handling of certain errors coming from
-	// platform network subreconciler in future.
-	err = r.ReconcileExistingHost(client, instance, profile, host, reqNs)
+	// Run any one-shot action requested on the host before reconciling its
+	// configuration so that the two do not fight over the host state.
+	err = r.ReconcileAction(client, instance, host)
+	if err == nil {
+		// Credentials rotated in their secret are applied regardless of
+		// whether the configuration is otherwise left untouched once in sync.
+		err = r.ReconcileBMCredentials(client, instance, profile, host)
+	}
+	if err == nil {
+		// Check that the current configuration of a host matches the desired
+		// state.  This also captures errors from platform network
+		// subreconciler separately thus enabling conditional handling of
+		// certain errors coming from platform network subreconciler in future.
+		err = r.ReconcileExistingHost(client, instance, profile, host, reqNs)
+	}
 
 	inSync = err == nil
 	oldInSync := instance.Status.InSync
@@ -2465,6 +2562,10 @@ func (r *HostReconciler) UpdateStatusForFactoryInstall(
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=addresspools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=addresspools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=networkreconfigurations,verbs=get;list;watch
 func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (result ctrl.Result, err error) {
 	_ = log.FromContext(ctx)
 	// FIXME: check log object
@@ -2493,6 +2594,18 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 	// Cancel any existing monitors
 	r.CancelMonitor(instance)
 
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system nor the resource is modified.
+		return r.reconcilePlanMode(request, instance)
+	}
+
 	err, updateRequired := r.PlatformNetworkUpdateRequired(instance)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -2515,7 +2628,7 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 		instance.Status.DeploymentScope == "bootstrap" &&
 		instance.Status.AvailabilityStatus != nil && *instance.Status.AvailabilityStatus == "available" &&
 		instance.Status.StrategyRequired == cloudManager.StrategyNotRequired &&
-		!updateRequired {
+		!updateRequired && !r.BMCredentialsRotated(instance) {
 
 		if !scope_updated {
 			logHost.V(2).Info("reconcile finished, desired state reached after reconciled.")
@@ -2539,6 +2652,21 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 		}
 	}
 
+	if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+		// The resource is being released without removing it from the
+		// system so the only thing left to do is to drop the finalizer.
+		if utils.ContainsString(instance.Finalizers, HostFinalizerName) {
+			r.NormalEvent(instance, common.ResourceDeleted,
+				"host orphaned; system resource left in place")
+			host_uid := string(instance.UID)
+			if utils.ContainsString(CephPrimaryGroup, host_uid) {
+				CephPrimaryGroup = utils.RemoveString(CephPrimaryGroup, host_uid)
+			}
+			r.removeHostFinalizer(instance)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if !utils.IsReconcilerEnabled(utils.Host) {
 		return reconcile.Result{}, nil
 	}
@@ -2552,12 +2680,31 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 		return common.RetryMissingClient, nil
 	}
 
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if !r.GetSystemReady(request.Namespace) {
 		r.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
 		return common.RetrySystemNotReady, nil
 	}
 
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
+	paused, err := r.ReconcileMaintenance(platformClient, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	} else if paused {
+		logHost.V(2).Info("host is under maintenance; reconciliation paused")
+		return reconcile.Result{}, nil
+	}
+
 	// Build a composite profile based on the profile chain and host overrides
 	profile, err := r.BuildAndValidateCompositeProfile(instance)
 	if err != nil {
@@ -2606,6 +2753,61 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 	return ctrl.Result{}, nil
 }
 
+// reconcilePlanMode handles a reconcile request for a host in plan mode.  The
+// plan is computed against the composite profile of the host but, unlike a
+// normal reconciliation, neither the finalizer, the deployment scope nor any
+// other status attribute is updated; only the plan itself is published.
+func (r *HostReconciler) reconcilePlanMode(request ctrl.Request, instance *starlingxv1.Host) (ctrl.Result, error) {
+	if !utils.IsReconcilerEnabled(utils.Host) {
+		return reconcile.Result{}, nil
+	}
+
+	if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+		// Nothing is removed from the system when a resource is orphaned.
+		return reconcile.Result{}, nil
+	}
+
+	if instance.Spec.Maintenance != nil {
+		// Nothing is planned for a host under maintenance.
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	profile, err := r.BuildAndValidateCompositeProfile(instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	err = r.ReconcilePlan(platformClient, instance, profile, request.Namespace)
+	return reconcile.Result{}, err
+}
+
+// ReconcilePlan runs the host reconciliation, including all of its
+// sub-reconcilers, against plan mode clients and publishes the system API
+// requests that it would have issued in the resource status.  Any lock or
+// unlock action is recorded like any other request, but planning stops there
+// since the remaining changes depend on the outcome of that action.
+func (r *HostReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, reqNs string) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logHost)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy(), profile.DeepCopy(), reqNs)
+
+	return p.Publish(r.Client, instance, result)
+}
+
 // PlatformNetworkUpdateRequired checks and returns true if any of the
 // platform networks / address pools are out of sync.
 func (r *HostReconciler) PlatformNetworkUpdateRequired(instance *starlingxv1.Host) (error, bool) {
@@ -2637,6 +2839,48 @@ func (r *HostReconciler) PlatformNetworkUpdateRequired(instance *starlingxv1.Hos
 	return nil, false
 }
 
+// GetHoldingNetworkReconfiguration returns the NetworkReconfiguration which
+// prevents the system address pools of a platform network from being updated,
+// if any.
+func (r *HostReconciler) GetHoldingNetworkReconfiguration(namespace string, network string) (*starlingxv1.NetworkReconfiguration, error) {
+	opts := client.ListOptions{}
+	opts.Namespace = namespace
+	reconfigurations := &starlingxv1.NetworkReconfigurationList{}
+	err := r.List(context.TODO(), reconfigurations, &opts)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list network reconfigurations")
+		return nil, err
+	}
+
+	for _, reconfiguration := range reconfigurations.Items {
+		if reconfiguration.Spec.PlatformNetwork == network && reconfiguration.IsHolding() {
+			return reconfiguration.DeepCopy(), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// hostsForNetworkReconfiguration maps a change to a network reconfiguration
+// to a reconcile request for every host of its namespace so that a
+// reconfiguration which is no longer held resumes without delay.
+func (r *HostReconciler) hostsForNetworkReconfiguration(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &starlingxv1.HostList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		logHost.Error(err, "failed to list hosts for network reconfiguration", "reconfiguration", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: item.Namespace, Name: item.Name}})
+	}
+
+	return requests
+}
+
 // ListPlatformNetworks returns all of PlatformNetwork instances or errors while
 // retrieving them if any.
 func (r *HostReconciler) ListPlatformNetworks(namespace string) ([]*starlingxv1.PlatformNetwork, []error) {
@@ -2746,7 +2990,18 @@ func (r *HostReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(HostControllerName),
 		Logger:        logHost}
+
+	err := mgr.GetFieldIndexer().IndexField(context.Background(),
+		&starlingxv1.Host{}, bmSecretIndex, indexBMSecret)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.Host{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.HostList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
+		Watches(&v1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.hostsForBMSecret)).
+		Watches(&starlingxv1.NetworkReconfiguration{}, handler.EnqueueRequestsFromMapFunc(r.hostsForNetworkReconfiguration)).
 		Complete(r)
 }
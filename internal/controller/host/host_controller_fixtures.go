@@ -852,6 +852,27 @@ const storage_tiers = `
 	]
 }`
 
+const PCIDeviceListBody = `
+{
+	"pci_devices": [
+		{
+			"uuid": "3b8f7f5a-4f54-4f2e-9d1c-3a2a3e0f7c10",
+			"host_uuid": "d99637e9-5451-45c6-98f4-f18968e43e91",
+			"name": "pci_0000_b3_00_0",
+			"pciaddr": "0000:b3:00.0",
+			"pclass_id": "120000",
+			"pvendor_id": "8086",
+			"pdevice_id": "0d5c",
+			"numa_node": 0,
+			"enabled": true,
+			"driver": "vfio-pci",
+			"sriov_totalvfs": 16,
+			"sriov_numvfs": 8,
+			"sriov_vf_driver": "vfio"
+		}
+	]
+}`
+
 const PTPInstanceListBody = `
 {
 	"ptp_instances": [
@@ -1098,6 +1119,15 @@ func HandleListFSRequests(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, `{"error": "Method not allowed"}`, http.StatusMethodNotAllowed)
 	}
 }
+func HandlePCIDeviceRequests(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	switch r.Method {
+	case http.MethodGet:
+		_, _ = fmt.Fprint(w, PCIDeviceListBody)
+	default:
+		http.Error(w, `{"error": "Method not allowed"}`, http.StatusMethodNotAllowed)
+	}
+}
 func HandleKernelRequests(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Content-Type", "application/json")
 	switch r.Method {
@@ -1387,6 +1417,7 @@ func HostAPIS() {
 	th.Mux.HandleFunc("/ihosts/d99637e9-5451-45c6-98f4-f18968e43e91/imemorys", HandleMemoryRequests)
 	th.Mux.HandleFunc("/ceph_mon", HandleCephMonitorsRequests)
 	th.Mux.HandleFunc("/ihosts/d99637e9-5451-45c6-98f4-f18968e43e91/host_fs", HandleListFSRequests)
+	th.Mux.HandleFunc("/ihosts/d99637e9-5451-45c6-98f4-f18968e43e91/pci_devices", HandlePCIDeviceRequests)
 
 }
 
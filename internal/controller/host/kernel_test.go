@@ -83,3 +83,70 @@ var _ = Describe("GetCPUUpdateOpts", func() {
 		})
 	})
 })
+
+var _ = Describe("ResolveProcessorPolicies", func() {
+	var host *v1info.HostInfo
+
+	BeforeEach(func() {
+		// Node 0 has 8 physical cores with hyper-threading and node 1 has 4.
+		host = &v1info.HostInfo{}
+		for core := 0; core < 8; core++ {
+			function := cpus.CPUFunctionApplication
+			if core < 2 {
+				function = cpus.CPUFunctionPlatform
+			}
+			host.CPU = append(host.CPU,
+				cpus.CPU{Processor: 0, Thread: 0, Function: function},
+				cpus.CPU{Processor: 0, Thread: 1, Function: function})
+		}
+		for core := 0; core < 4; core++ {
+			host.CPU = append(host.CPU, cpus.CPU{Processor: 1, Function: cpus.CPUFunctionApplication})
+		}
+	})
+
+	It("should resolve policies against the node topology", func() {
+		remaining, percent, cores := true, 25, 3
+		profile := &starlingxv1.HostProfileSpec{
+			Processors: starlingxv1.ProcessorNodeList{
+				{Node: 0, Functions: starlingxv1.ProcessorFunctionList{
+					{Function: "application-isolated", Policy: &starlingxv1.ProcessorAllocationPolicy{Remaining: &remaining}},
+					{Function: cpus.CPUFunctionVSwitch, Policy: &starlingxv1.ProcessorAllocationPolicy{Percent: &percent}},
+				}},
+				{Node: 1, Functions: starlingxv1.ProcessorFunctionList{
+					{Function: cpus.CPUFunctionPlatform, Count: 1},
+					{Function: "application-isolated", Policy: &starlingxv1.ProcessorAllocationPolicy{PerSocketLessPlatform: &cores}},
+				}},
+			},
+		}
+
+		resolved, err := ResolveProcessorPolicies(profile, host)
+		Expect(err).ToNot(HaveOccurred())
+
+		// 8 cores less 2 platform cores less 2 vswitch cores.
+		Expect(profile.Processors[0].Functions).To(Equal(starlingxv1.ProcessorFunctionList{
+			{Function: "application-isolated", Count: 4},
+			{Function: cpus.CPUFunctionVSwitch, Count: 2},
+		}))
+		Expect(profile.Processors[1].Functions[1]).To(Equal(
+			starlingxv1.ProcessorFunctionInfo{Function: "application-isolated", Count: 2}))
+		Expect(resolved).To(HaveLen(2))
+		Expect(resolved[0].Functions).To(Equal(starlingxv1.ProcessorFunctionList{
+			{Function: cpus.CPUFunctionVSwitch, Count: 2},
+			{Function: "application-isolated", Count: 4},
+		}))
+	})
+
+	It("should fail when a node does not have enough cores", func() {
+		cores := 1
+		profile := &starlingxv1.HostProfileSpec{
+			Processors: starlingxv1.ProcessorNodeList{
+				{Node: 0, Functions: starlingxv1.ProcessorFunctionList{
+					{Function: "application-isolated", Policy: &starlingxv1.ProcessorAllocationPolicy{PerSocketLessPlatform: &cores}},
+				}},
+			},
+		}
+
+		_, err := ResolveProcessorPolicies(profile, host)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,108 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+)
+
+// lockForMaintenance is a utility method which locks a host that is being
+// put under maintenance.  A lock rejected by the system (e.g., on the active
+// controller) is reported but not retried.
+func (r *HostReconciler) lockForMaintenance(client *gophercloud.ServiceClient, instance *starlingxv1.Host) error {
+	host, err := hosts.Get(client, *instance.Status.ID).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get host: %s", *instance.Status.ID)
+		return err
+	}
+
+	if host.AdministrativeState == hosts.AdminLocked {
+		return nil
+	}
+
+	_, err = r.sendAction(client, host, hosts.ActionLock)
+	if err != nil {
+		if isRejectedRequest(err) {
+			r.WarningEvent(instance, common.ResourceUpdated,
+				"host could not be locked for maintenance: %s", err.Error())
+			return nil
+		}
+		return err
+	}
+
+	r.NormalEvent(instance, common.ResourceUpdated, "host has been locked for maintenance")
+
+	return nil
+}
+
+// ReconcileMaintenance is responsible for putting a host under maintenance
+// and for bringing it back.  While a host is under maintenance paused is
+// returned as true so that none of its sub-reconcilers are run, and it is
+// excluded from strategies.  The maintenance is handled before the
+// configuration status is updated so that the change in generation does not
+// mark the host as not reconciled in the strategy.  Once the maintenance
+// attribute is removed the host is marked as out of sync and not reconciled
+// so that its full configuration is checked again.
+func (r *HostReconciler) ReconcileMaintenance(client *gophercloud.ServiceClient, instance *starlingxv1.Host) (paused bool, err error) {
+	maintenance := instance.Spec.Maintenance
+
+	if maintenance == nil {
+		if !instance.Status.Maintenance {
+			return false, nil
+		}
+
+		instance.Status.Maintenance = false
+		instance.Status.InSync = false
+		instance.Status.Reconciled = false
+		instance.Status.StrategyRequired = cloudManager.StrategyNotRequired
+
+		err = r.Status().Update(context.TODO(), instance)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update status: %s",
+				common.FormatStruct(instance.Status))
+			return false, err
+		}
+
+		r.UpdateConfigVersion()
+		r.SetResourceMaintenance(instance.Name, false)
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"host has left maintenance; resuming reconciliation")
+
+		return false, nil
+	}
+
+	if !instance.Status.Maintenance {
+		instance.Status.Maintenance = true
+
+		err = r.Status().Update(context.TODO(), instance)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update status: %s",
+				common.FormatStruct(instance.Status))
+			return true, err
+		}
+
+		r.SetResourceMaintenance(instance.Name, true)
+
+		if maintenance.Reason != nil {
+			r.NormalEvent(instance, common.ResourceUpdated,
+				"host has entered maintenance: %s", *maintenance.Reason)
+		} else {
+			r.NormalEvent(instance, common.ResourceUpdated, "host has entered maintenance")
+		}
+	}
+
+	if maintenance.Lock && instance.Status.ID != nil {
+		err = r.lockForMaintenance(client, instance)
+	}
+
+	return true, err
+}
@@ -146,6 +146,47 @@ func memoryUpdateRequired(f starlingxv1.MemoryFunctionInfo, count int) (opts mem
 	return opts, result
 }
 
+// ResolveMemoryPolicies converts the memory allocation policies of the
+// profile into page counts based on the number of huge pages that the memory
+// of each node can hold.  The resolved allocations are returned so that they
+// can be reported in the host status.
+func ResolveMemoryPolicies(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) (starlingxv1.MemoryNodeList, error) {
+	var resolved starlingxv1.MemoryNodeList
+
+	for i := range profile.Memory {
+		nodeInfo := &profile.Memory[i]
+
+		result := starlingxv1.MemoryNodeInfo{Node: nodeInfo.Node}
+		for j := range nodeInfo.Functions {
+			f := &nodeInfo.Functions[j]
+			if f.Policy == nil {
+				continue
+			}
+
+			if f.Function != memory.MemoryFunctionVM {
+				msg := fmt.Sprintf("memory allocation policies are not supported for the %s function", f.Function)
+				return nil, utils.NewUserDataError(msg)
+			}
+
+			pageSize := starlingxv1.PageSize(f.PageSize)
+			possible := host.HugepagesPossible(nodeInfo.Node, pageSize.Megabytes())
+			count := f.Policy.Resolve(possible)
+
+			logHost.V(2).Info("resolved memory allocation policy",
+				"node", nodeInfo.Node, "pagesize", f.PageSize, "count", count)
+			f.PageCount = count
+			f.Policy = nil
+			result.Functions = append(result.Functions, *f)
+		}
+
+		if len(result.Functions) > 0 {
+			resolved = append(resolved, result)
+		}
+	}
+
+	return resolved, nil
+}
+
 // ReconcileMemory is responsible for reconciling the Memory configuration of a
 // host resource.
 func (r *HostReconciler) ReconcileMemory(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
@@ -7,6 +7,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/imemory"
 )
 
 var _ = Describe("Memory utils", func() {
@@ -241,3 +243,36 @@ var _ = Describe("Memory utils", func() {
 		})
 	})
 })
+
+var _ = Describe("ResolveMemoryPolicies", func() {
+	It("should resolve policies against the huge page capacity of the node", func() {
+		percent, remaining := 50, true
+		profile := &starlingxv1.HostProfileSpec{
+			Memory: starlingxv1.MemoryNodeList{
+				{Node: 0, Functions: starlingxv1.MemoryFunctionList{
+					{Function: memory.MemoryFunctionVSwitch, PageSize: string(starlingxv1.PageSize1G), PageCount: 1},
+					{Function: memory.MemoryFunctionVM, PageSize: string(starlingxv1.PageSize1G),
+						Policy: &starlingxv1.MemoryAllocationPolicy{Percent: &percent}},
+				}},
+				{Node: 1, Functions: starlingxv1.MemoryFunctionList{
+					{Function: memory.MemoryFunctionVM, PageSize: string(starlingxv1.PageSize2M),
+						Policy: &starlingxv1.MemoryAllocationPolicy{Remaining: &remaining}},
+				}},
+			},
+		}
+		host := &v1info.HostInfo{
+			MemoryCapacity: []imemory.Memory{
+				{Node: 0, VM2MHugepagesPossible: 40000, VM1GHugepagesPossible: 78},
+				{Node: 1, VM2MHugepagesPossible: 42000, VM1GHugepagesPossible: 82},
+			},
+		}
+
+		resolved, err := ResolveMemoryPolicies(profile, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(profile.Memory[0].Functions[1].PageCount).To(Equal(39))
+		Expect(profile.Memory[0].Functions[1].Policy).To(BeNil())
+		Expect(profile.Memory[1].Functions[0].PageCount).To(Equal(42000))
+		Expect(resolved).To(HaveLen(2))
+		Expect(resolved[0].Functions).To(HaveLen(1))
+	})
+})
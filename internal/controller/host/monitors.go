@@ -259,13 +259,13 @@ func (m *storageTierMonitor) Run(client *gophercloud.ServiceClient) (stop bool,
 	}
 
 	for _, t := range tiers {
-		if t.Name == storagetiers.StorageTierName {
-			m.SetState("storage tier for cluster %q has been found", m.clusterID)
+		if t.Name == m.tierName {
+			m.SetState("storage tier %q for cluster %q has been found", m.tierName, m.clusterID)
 			return true, nil
 		}
 	}
 
-	m.SetState("waiting for storage tier for cluster %q", m.clusterID)
+	m.SetState("waiting for storage tier %q for cluster %q", m.tierName, m.clusterID)
 
 	return false, nil
 }
@@ -355,6 +355,15 @@ func NewLockedDisabledHostMonitor(instance *starlingxv1.Host, id string) *manage
 	return NewStateMonitor(instance, id, &admin, &oper, nil)
 }
 
+// NewLockedOnlineHostMonitor is a convenience wrapper around NewStateMonitor
+// to wait for a host to reach the locked/disabled/online state.
+func NewLockedOnlineHostMonitor(instance *starlingxv1.Host, id string) *manager.Monitor {
+	admin := hosts.AdminLocked
+	oper := hosts.OperDisabled
+	avail := hostAvailOnline
+	return NewStateMonitor(instance, id, &admin, &oper, &avail)
+}
+
 // Run implements the MonitorBody interface Run method which is responsible
 // for monitor one or more resources and returning true when all conditions
 // are satisfied.
@@ -776,3 +785,57 @@ func (m *stateChangeMonitor) Run(client *gophercloud.ServiceClient) (stop bool,
 		return true, nil
 	}
 }
+
+// DefaultActionStartedMonitorInterval represents the default interval between
+// polling attempts to check whether a host has started running an action.
+const DefaultActionStartedMonitorInterval = 10 * time.Second
+
+// actionStartedMonitor waits for a host to start running an action.  A host
+// is considered to have started once it is either running a task or is no
+// longer online.  Once the action has started, or the deadline for it to
+// start has passed, a reconcilable event is generated to kick the reconciler.
+type actionStartedMonitor struct {
+	manager.CommonMonitorBody
+	hostID   string
+	deadline time.Time
+}
+
+// NewActionStartedMonitor defines a convenience function to instantiate a new
+// action started monitor with all required attributes.
+func NewActionStartedMonitor(instance *starlingxv1.Host, id string, deadline time.Time) *manager.Monitor {
+	logger := logHost.WithName("action-started-monitor")
+	return &manager.Monitor{
+		MonitorBody: &actionStartedMonitor{
+			hostID:   id,
+			deadline: deadline,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultActionStartedMonitorInterval,
+	}
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *actionStartedMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	host, err := hosts.Get(client, m.hostID).Extract()
+	if err != nil {
+		m.SetState("failed to get host %q: %s", m.hostID, err.Error())
+		return false, err
+	}
+
+	if !actionStarted(host) {
+		if time.Now().After(m.deadline) {
+			m.SetState("host has not started running action in time")
+			return true, nil
+		}
+
+		m.SetState("waiting for host to start running action")
+		return false, nil
+	}
+
+	m.SetState("host has started running action: %s", host.AvailabilityStatus)
+
+	return true, nil
+}
@@ -19,8 +19,58 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/ethernetports"
 )
 
+// selectPort returns the single host port that matches a port selector.  Ports
+// that already back another Ethernet interface are not considered.
+func selectPort(ifname string, selector *starlingxv1.PortSelector, ports []ethernetports.EthernetPort, claimed map[string]bool) (*ethernetports.EthernetPort, error) {
+	return selectOne("port", "port selector of interface "+ifname, ports,
+		func(p *ethernetports.EthernetPort) string { return p.Name },
+		func(p *ethernetports.EthernetPort) bool {
+			return selector.Matches(p.PCIAddress, p.MAC, p.VendorID(), p.DeviceID(), p.Driver, p.NUMANode)
+		},
+		claimed)
+}
+
+// ResolvePortSelectors converts the port selectors of the Ethernet interfaces
+// of the profile into port names based on the port attributes reported by the
+// host inventory.  A port can only back a single Ethernet interface, so ports
+// referenced by an explicit name and ports resolved by an earlier selector are
+// excluded from later selectors.  Selectors must be resolved before profiles
+// are merged since Ethernet interfaces are keyed by their port name.
+func ResolvePortSelectors(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	if profile.Interfaces == nil {
+		return nil
+	}
+
+	claimed := make(map[string]bool)
+	for _, ethInfo := range profile.Interfaces.Ethernet {
+		if ethInfo.Port.Selector == nil {
+			claimed[ethInfo.Port.Name] = true
+		}
+	}
+
+	for i := range profile.Interfaces.Ethernet {
+		ethInfo := &profile.Interfaces.Ethernet[i]
+		if ethInfo.Port.Selector == nil {
+			continue
+		}
+
+		port, err := selectPort(ethInfo.Name, ethInfo.Port.Selector, host.PortAttributes, claimed)
+		if err != nil {
+			return err
+		}
+
+		logHost.V(2).Info("resolved port selector", "interface", ethInfo.Name, "port", port.Name)
+		claimed[port.Name] = true
+		ethInfo.Port.Name = port.Name
+		ethInfo.Port.Selector = nil
+	}
+
+	return nil
+}
+
 // findConfiguredBondInterface is a utility function that searches the current
 // set of configured interfaces to determine whether current system interface
 // still exists in the current configured interface list.  Determine whether
@@ -15,6 +15,7 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaces"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/ethernetports"
 
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
 )
@@ -1029,3 +1030,105 @@ var _ = Describe("Networking utils", func() {
 		})
 	})
 })
+
+var _ = Describe("ResolvePortSelectors", func() {
+	const (
+		intelDevice = "Ethernet Controller XL710 for 40GbE QSFP+ [1583]"
+		intelVendor = "Intel Corporation [8086]"
+	)
+	var host *v1info.HostInfo
+	numa0, numa1 := 0, 1
+
+	ethernet := func(name string, port starlingxv1.EthernetPortInfo) starlingxv1.EthernetInfo {
+		return starlingxv1.EthernetInfo{
+			CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{Name: name},
+			Port:                port,
+		}
+	}
+
+	BeforeEach(func() {
+		host = &v1info.HostInfo{
+			PortAttributes: []ethernetports.EthernetPort{
+				{ID: "p1", Name: "enp24s0f0", PCIAddress: "0000:18:00.0", MAC: "3c:fd:fe:a0:00:01",
+					Vendor: intelVendor, Device: intelDevice, Driver: "i40e", NUMANode: &numa0},
+				{ID: "p2", Name: "enp24s0f1", PCIAddress: "0000:18:00.1", MAC: "3c:fd:fe:a0:00:02",
+					Vendor: intelVendor, Device: intelDevice, Driver: "i40e", NUMANode: &numa0},
+				{ID: "p3", Name: "enp175s0f0", PCIAddress: "0000:af:00.0", MAC: "3c:fd:fe:b0:00:01",
+					Vendor: intelVendor, Device: intelDevice, Driver: "i40e", NUMANode: &numa1},
+			},
+		}
+	})
+
+	It("should resolve selectors into port names", func() {
+		address, mac := "0000:AF:00.0", "^3C:FD:FE:A0:00:02$"
+		profile := &starlingxv1.HostProfileSpec{
+			Interfaces: &starlingxv1.InterfaceInfo{
+				Ethernet: starlingxv1.EthernetList{
+					ethernet("data0", starlingxv1.EthernetPortInfo{
+						Selector: &starlingxv1.PortSelector{PCIAddress: &address}}),
+					ethernet("data1", starlingxv1.EthernetPortInfo{
+						Selector: &starlingxv1.PortSelector{MAC: &mac}}),
+				},
+			},
+		}
+
+		err := ResolvePortSelectors(profile, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(profile.Interfaces.Ethernet[0].Port.Name).To(Equal("enp175s0f0"))
+		Expect(profile.Interfaces.Ethernet[0].Port.Selector).To(BeNil())
+		Expect(profile.Interfaces.Ethernet[1].Port.Name).To(Equal("enp24s0f1"))
+	})
+
+	It("should exclude ports that are already claimed", func() {
+		vendorID, driver := "8086", "i40e"
+		profile := &starlingxv1.HostProfileSpec{
+			Interfaces: &starlingxv1.InterfaceInfo{
+				Ethernet: starlingxv1.EthernetList{
+					ethernet("mgmt0", starlingxv1.EthernetPortInfo{Name: "enp24s0f0"}),
+					ethernet("data0", starlingxv1.EthernetPortInfo{
+						Selector: &starlingxv1.PortSelector{VendorID: &vendorID, NUMANode: &numa0}}),
+					ethernet("data1", starlingxv1.EthernetPortInfo{
+						Selector: &starlingxv1.PortSelector{Driver: &driver}}),
+				},
+			},
+		}
+
+		err := ResolvePortSelectors(profile, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(profile.Interfaces.Ethernet[1].Port.Name).To(Equal("enp24s0f1"))
+		Expect(profile.Interfaces.Ethernet[2].Port.Name).To(Equal("enp175s0f0"))
+	})
+
+	It("should fail when a selector is ambiguous", func() {
+		deviceID := "1583"
+		profile := &starlingxv1.HostProfileSpec{
+			Interfaces: &starlingxv1.InterfaceInfo{
+				Ethernet: starlingxv1.EthernetList{
+					ethernet("data0", starlingxv1.EthernetPortInfo{
+						Selector: &starlingxv1.PortSelector{DeviceID: &deviceID, NUMANode: &numa0}}),
+				},
+			},
+		}
+
+		err := ResolvePortSelectors(profile, host)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("enp24s0f0"))
+		Expect(err.Error()).To(ContainSubstring("enp24s0f1"))
+	})
+
+	It("should fail when no port matches a selector", func() {
+		driver := "ice"
+		profile := &starlingxv1.HostProfileSpec{
+			Interfaces: &starlingxv1.InterfaceInfo{
+				Ethernet: starlingxv1.EthernetList{
+					ethernet("data0", starlingxv1.EthernetPortInfo{
+						Selector: &starlingxv1.PortSelector{Driver: &driver}}),
+				},
+			},
+		}
+
+		err := ResolvePortSelectors(profile, host)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("data0"))
+	})
+})
@@ -1350,6 +1350,21 @@ func (r *HostReconciler) ReconcilePlatformNetworkPrincipal(
 	addrpool_instance *starlingxv1.AddressPool,
 	system_info *cloudManager.SystemInfo) error {
 
+	switch network_instance.Spec.Type {
+	case cloudManager.OAMNetworkType, cloudManager.MgmtNetworkType, cloudManager.AdminNetworkType:
+		// A NetworkReconfiguration which is paused or blocked by a failed
+		// check holds the update of the system address pools.
+		reconfiguration, err := r.GetHoldingNetworkReconfiguration(host_instance.Namespace, network_instance.Name)
+		if err != nil {
+			return err
+		} else if reconfiguration != nil {
+			r.WarningEvent(host_instance, common.ResourceDependency,
+				"reconfiguration of network %s is held by NetworkReconfiguration %s",
+				network_instance.Name, reconfiguration.Name)
+			return nil
+		}
+	}
+
 	if system_info.SystemType == cloudManager.SystemTypeAllInOne &&
 		system_info.SystemMode == cloudManager.SystemModeSimplex {
 
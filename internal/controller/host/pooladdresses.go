@@ -0,0 +1,320 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package host
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresses"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// normalizeAddress returns the canonical string representation of an IP
+// address so that differently formatted IPv6 addresses compare equal.
+func normalizeAddress(address string) string {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return address
+	}
+	return ip.String()
+}
+
+// allocatePoolAddress returns the first address of the pool allocation ranges
+// which is not reserved.  The controller, floating and gateway addresses of
+// the pool as well as every address already allocated from it are always
+// considered reserved.
+func allocatePoolAddress(pool *starlingxv1.AddressPool, reserved map[string]bool) (string, error) {
+	excluded := make(map[string]bool, len(reserved))
+	for address := range reserved {
+		excluded[normalizeAddress(address)] = true
+	}
+
+	for _, address := range []*string{pool.Spec.Controller0Address, pool.Spec.Controller1Address,
+		pool.Spec.FloatingAddress, pool.Spec.Gateway} {
+		if address != nil {
+			excluded[normalizeAddress(*address)] = true
+		}
+	}
+
+	for _, alloc := range pool.Status.Allocations {
+		excluded[normalizeAddress(alloc.Address)] = true
+	}
+
+	ranges, err := common.PoolAddressRanges(pool)
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range ranges {
+		for ip := r[0]; bytes.Compare(ip, r[1]) <= 0; ip = common.NextAddress(ip) {
+			if !excluded[ip.String()] {
+				return ip.String(), nil
+			}
+
+			if ip.Equal(r[1]) {
+				// Stop before wrapping around past the last address.
+				break
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// systemAddressOwner identifies the host interface on which an address is
+// configured on the system.
+type systemAddressOwner struct {
+	host  string
+	iface string
+}
+
+// listSystemAddresses is a utility method which returns the owner of every
+// address configured on the hosts of the system keyed by its normalized
+// address.
+func (r *HostReconciler) listSystemAddresses(client *gophercloud.ServiceClient) (map[string]systemAddressOwner, error) {
+	result := make(map[string]systemAddressOwner)
+	for _, h := range r.hosts {
+		if h.Hostname == "" {
+			continue
+		}
+
+		objects, err := addresses.ListAddresses(client, h.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list addresses for host %s", h.Hostname)
+			return nil, err
+		}
+
+		for _, addr := range objects {
+			result[normalizeAddress(addr.Address)] = systemAddressOwner{
+				host:  h.Hostname,
+				iface: addr.InterfaceName,
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// recoverPoolAddress returns the address of the pool subnet which is already
+// configured on the system for an interface of a host, if any, so that an
+// allocation lost along with the pool status (e.g., when the pool is
+// restored from a backup or re-applied) is rebuilt rather than replaced by a
+// different address.  An address recorded as allocated to another interface
+// is never recovered.
+func recoverPoolAddress(pool *starlingxv1.AddressPool, configured map[string]systemAddressOwner, host, iface string) string {
+	network, err := common.PoolSubnet(pool)
+	if err != nil {
+		return ""
+	}
+
+	allocated := make(map[string]bool, len(pool.Status.Allocations))
+	for _, alloc := range pool.Status.Allocations {
+		allocated[normalizeAddress(alloc.Address)] = true
+	}
+
+	for address, owner := range configured {
+		if owner.host != host || owner.iface != iface || allocated[address] {
+			continue
+		}
+
+		if ip := net.ParseIP(address); ip != nil && network.Contains(ip) {
+			return address
+		}
+	}
+
+	return ""
+}
+
+// poolInterfaces returns the common attributes of every interface of the
+// profile which references at least one address pool.
+func poolInterfaces(profile *starlingxv1.HostProfileSpec) []*starlingxv1.CommonInterfaceInfo {
+	result := common.ProfileInterfaces(profile)
+
+	filtered := result[:0]
+	for _, iface := range result {
+		if len(iface.AddressPools) > 0 {
+			filtered = append(filtered, iface)
+		}
+	}
+
+	return filtered
+}
+
+// ReconcilePoolAddresses converts the address pool references of the
+// interfaces of the profile into static addresses.  Each interface keeps the
+// address recorded in the status of the address pool so that it is stable
+// across reconciles; a new address is only drawn from the pool the first time
+// that an interface references it.  Since the pool status is not preserved
+// when a pool is restored or re-applied, the addresses configured on the
+// system are consulted before any address is drawn; an address already
+// configured on the interface is recorded again, and no address configured on
+// any host is ever handed out.  Allocations held by the host for interfaces
+// which no longer reference a pool are released.  Allocations are recorded
+// before the addresses are configured on the system so that two hosts can
+// never be given the same address; a concurrent allocation causes the pool
+// status update to fail and the host to be reconciled again.
+func (r *HostReconciler) ReconcilePoolAddresses(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec) error {
+	wanted := make(map[string][]*starlingxv1.CommonInterfaceInfo)
+	for _, iface := range poolInterfaces(profile) {
+		for _, name := range iface.AddressPools {
+			wanted[name] = append(wanted[name], iface)
+		}
+	}
+
+	pools := &starlingxv1.AddressPoolList{}
+	err := r.List(context.TODO(), pools, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list address pools")
+		return err
+	}
+
+	// Static addresses written out explicitly are never handed out.
+	reserved := make(map[string]bool)
+	for _, addrInfo := range profile.Addresses {
+		reserved[addrInfo.Address] = true
+	}
+
+	// The system addresses are only listed once an allocation is missing
+	// since it requires a request per host.
+	var configured map[string]systemAddressOwner
+
+	found := make(map[string]bool)
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+		found[pool.Name] = true
+
+		ifaces := wanted[pool.Name]
+		names := make(map[string]bool, len(ifaces))
+		for _, iface := range ifaces {
+			names[iface.Name] = true
+		}
+
+		updated := false
+		allocations := make([]starlingxv1.AddressAllocation, 0, len(pool.Status.Allocations))
+		for _, alloc := range pool.Status.Allocations {
+			if alloc.Host == instance.Name && !names[alloc.Interface] {
+				logHost.Info("releasing pool address", "pool", pool.Name,
+					"interface", alloc.Interface, "address", alloc.Address)
+				updated = true
+				continue
+			}
+			allocations = append(allocations, alloc)
+		}
+		pool.Status.Allocations = allocations
+
+		for _, iface := range ifaces {
+			address, ok := pool.FindAllocation(instance.Name, iface.Name)
+			if !ok {
+				if configured == nil {
+					configured, err = r.listSystemAddresses(client)
+					if err != nil {
+						return err
+					}
+
+					for addr := range configured {
+						reserved[addr] = true
+					}
+				}
+
+				address = recoverPoolAddress(pool, configured, instance.Name, iface.Name)
+				if address == "" {
+					address, err = allocatePoolAddress(pool, reserved)
+					if err != nil {
+						return err
+					}
+				}
+
+				if address == "" {
+					msg := fmt.Sprintf("address pool %s has no free address for interface %s",
+						pool.Name, iface.Name)
+					return common.NewUserDataError(msg)
+				}
+
+				pool.Status.Allocations = append(pool.Status.Allocations, starlingxv1.AddressAllocation{
+					Host:      instance.Name,
+					Interface: iface.Name,
+					Address:   address,
+				})
+				updated = true
+
+				r.NormalEvent(instance, common.ResourceUpdated,
+					"address %s allocated from pool %q for interface %s", address, pool.Name, iface.Name)
+			}
+
+			profile.Addresses = append(profile.Addresses, starlingxv1.AddressInfo{
+				Interface: iface.Name,
+				Address:   address,
+				Prefix:    pool.Spec.Prefix,
+			})
+		}
+
+		if updated {
+			err = r.Status().Update(context.TODO(), pool)
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to update address pool status: %s", pool.Name)
+				return err
+			}
+		}
+	}
+
+	for name, ifaces := range wanted {
+		if !found[name] {
+			msg := fmt.Sprintf("address pool %s referenced by interface %s does not exist", name, ifaces[0].Name)
+			return common.NewMissingKubernetesResource(msg)
+		}
+	}
+
+	// The pool references are not part of the system configuration so they
+	// are dropped once converted to addresses.
+	for _, iface := range poolInterfaces(profile) {
+		iface.AddressPools = nil
+	}
+
+	return nil
+}
+
+// ReleasePoolAddresses releases every address allocated to a host from the
+// address pools of its namespace.
+func (r *HostReconciler) ReleasePoolAddresses(instance *starlingxv1.Host) error {
+	pools := &starlingxv1.AddressPoolList{}
+	err := r.List(context.TODO(), pools, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list address pools")
+		return err
+	}
+
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+
+		allocations := make([]starlingxv1.AddressAllocation, 0, len(pool.Status.Allocations))
+		for _, alloc := range pool.Status.Allocations {
+			if alloc.Host != instance.Name {
+				allocations = append(allocations, alloc)
+			}
+		}
+
+		if len(allocations) == len(pool.Status.Allocations) {
+			continue
+		}
+
+		pool.Status.Allocations = allocations
+
+		err = r.Status().Update(context.TODO(), pool)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update address pool status: %s", pool.Name)
+			return err
+		}
+
+		logHost.Info("released pool addresses", "pool", pool.Name)
+	}
+
+	return nil
+}
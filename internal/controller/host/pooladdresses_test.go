@@ -0,0 +1,203 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package host
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+var _ = Describe("allocatePoolAddress", func() {
+	strPtr := func(s string) *string { return &s }
+
+	It("should skip the reserved addresses of the pool", func() {
+		pool := &starlingxv1.AddressPool{
+			Spec: starlingxv1.AddressPoolSpec{
+				Subnet:             "192.168.100.0",
+				Prefix:             24,
+				Gateway:            strPtr("192.168.100.1"),
+				FloatingAddress:    strPtr("192.168.100.2"),
+				Controller0Address: strPtr("192.168.100.3"),
+				Controller1Address: strPtr("192.168.100.4"),
+			},
+			Status: starlingxv1.AddressPoolStatus{
+				Allocations: []starlingxv1.AddressAllocation{
+					{Host: "worker-0", Interface: "data0", Address: "192.168.100.5"},
+				},
+			},
+		}
+
+		address, err := allocatePoolAddress(pool, map[string]bool{"192.168.100.6": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(address).To(Equal("192.168.100.7"))
+	})
+
+	It("should allocate from the allocation ranges", func() {
+		pool := &starlingxv1.AddressPool{
+			Spec: starlingxv1.AddressPoolSpec{
+				Subnet: "fd00:10::",
+				Prefix: 64,
+				Allocation: starlingxv1.AllocationInfo{
+					Ranges: []starlingxv1.AllocationRange{
+						{Start: "fd00:10::100", End: "fd00:10::100"},
+						{Start: "fd00:10::200", End: "fd00:10::2ff"},
+					},
+				},
+			},
+		}
+
+		address, err := allocatePoolAddress(pool, map[string]bool{"fd00:10:0:0::100": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(address).To(Equal("fd00:10::200"))
+	})
+
+	It("should allocate the last address of an allocation range", func() {
+		pool := &starlingxv1.AddressPool{
+			Spec: starlingxv1.AddressPoolSpec{
+				Subnet: "10.10.10.0",
+				Prefix: 24,
+				Allocation: starlingxv1.AllocationInfo{
+					Ranges: []starlingxv1.AllocationRange{
+						{Start: "10.10.10.254", End: "10.10.10.255"},
+					},
+				},
+			},
+		}
+
+		address, err := allocatePoolAddress(pool, map[string]bool{"10.10.10.254": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(address).To(Equal("10.10.10.255"))
+	})
+
+	It("should return no address once the pool is exhausted", func() {
+		pool := &starlingxv1.AddressPool{
+			Spec: starlingxv1.AddressPoolSpec{
+				Subnet:  "10.10.10.0",
+				Prefix:  30,
+				Gateway: strPtr("10.10.10.1"),
+			},
+		}
+
+		address, err := allocatePoolAddress(pool, map[string]bool{"10.10.10.2": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(address).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ReconcilePoolAddresses", func() {
+	var pool *starlingxv1.AddressPool
+
+	BeforeEach(func() {
+		pool = &starlingxv1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-v4", Namespace: "default"},
+			Spec: starlingxv1.AddressPoolSpec{
+				Subnet: "10.20.0.0",
+				Prefix: 24,
+				Allocation: starlingxv1.AllocationInfo{
+					Ranges: []starlingxv1.AllocationRange{{Start: "10.20.0.10", End: "10.20.0.20"}},
+				},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), pool)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(context.Background(), pool)).To(Succeed())
+	})
+
+	newProfile := func(pools ...string) *starlingxv1.HostProfileSpec {
+		return &starlingxv1.HostProfileSpec{
+			Interfaces: &starlingxv1.InterfaceInfo{
+				Ethernet: starlingxv1.EthernetList{
+					{
+						CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+							Name:         "data0",
+							Class:        "data",
+							AddressPools: pools,
+						},
+						Port: starlingxv1.EthernetPortInfo{Name: "enp24s0f0"},
+					},
+				},
+			},
+		}
+	}
+
+	getPool := func() *starlingxv1.AddressPool {
+		result := &starlingxv1.AddressPool{}
+		key := types.NamespacedName{Namespace: pool.Namespace, Name: pool.Name}
+		Expect(k8sClient.Get(context.Background(), key, result)).To(Succeed())
+		return result
+	}
+
+	It("should allocate a stable address and release it", func() {
+		r := newTestHostReconciler(nil)
+		instance := newHostInstance("worker-0", "default", true, nil)
+
+		profile := newProfile("data-v4")
+		Expect(r.ReconcilePoolAddresses(nil, instance, profile)).To(Succeed())
+		Expect(profile.Addresses).To(ConsistOf(starlingxv1.AddressInfo{
+			Interface: "data0", Address: "10.20.0.10", Prefix: 24}))
+		Expect(profile.Interfaces.Ethernet[0].AddressPools).To(BeNil())
+		Expect(getPool().Status.Allocations).To(HaveLen(1))
+
+		profile = newProfile("data-v4")
+		Expect(r.ReconcilePoolAddresses(nil, instance, profile)).To(Succeed())
+		Expect(profile.Addresses[0].Address).To(Equal("10.20.0.10"))
+
+		other := newHostInstance("worker-1", "default", true, nil)
+		profile = newProfile("data-v4")
+		Expect(r.ReconcilePoolAddresses(nil, other, profile)).To(Succeed())
+		Expect(profile.Addresses[0].Address).To(Equal("10.20.0.11"))
+
+		Expect(r.ReconcilePoolAddresses(nil, instance, newProfile())).To(Succeed())
+		Expect(r.ReleasePoolAddresses(other)).To(Succeed())
+		Expect(getPool().Status.Allocations).To(BeEmpty())
+	})
+
+	It("should fail when the address pool does not exist", func() {
+		r := newTestHostReconciler(nil)
+		instance := newHostInstance("worker-0", "default", true, nil)
+
+		err := r.ReconcilePoolAddresses(nil, instance, newProfile("missing"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("missing"))
+	})
+})
+
+var _ = Describe("recoverPoolAddress", func() {
+	pool := &starlingxv1.AddressPool{
+		Spec: starlingxv1.AddressPoolSpec{
+			Subnet: "10.20.0.0",
+			Prefix: 24,
+		},
+		Status: starlingxv1.AddressPoolStatus{
+			Allocations: []starlingxv1.AddressAllocation{
+				{Host: "worker-1", Interface: "data0", Address: "10.20.0.11"},
+			},
+		},
+	}
+
+	configured := map[string]systemAddressOwner{
+		"10.20.0.10":  {host: "worker-0", iface: "data0"},
+		"10.20.0.11":  {host: "worker-0", iface: "data1"},
+		"192.168.1.5": {host: "worker-0", iface: "data2"},
+	}
+
+	It("should recover the address configured on the interface", func() {
+		Expect(recoverPoolAddress(pool, configured, "worker-0", "data0")).To(Equal("10.20.0.10"))
+	})
+
+	It("should not recover an address allocated to another interface", func() {
+		Expect(recoverPoolAddress(pool, configured, "worker-0", "data1")).To(BeEmpty())
+	})
+
+	It("should not recover an address outside of the pool subnet", func() {
+		Expect(recoverPoolAddress(pool, configured, "worker-0", "data2")).To(BeEmpty())
+	})
+})
@@ -4,7 +4,10 @@
 package host
 
 import (
+	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/cpus"
@@ -53,6 +56,127 @@ func (r *HostReconciler) GetCPUUpdateOpts(profile *starlingxv1.HostProfileSpec,
 	return opts, updateRequired
 }
 
+// resolveProcessorPolicy converts a processor allocation policy into a core
+// count for a single node.  The platform count and the cores allocated to the
+// other functions are taken from the profile when the function is listed and
+// from the current host configuration otherwise.  Cores allocated to the
+// application function are never considered allocated since the application
+// function is given all cores that are not assigned elsewhere.
+func resolveProcessorPolicy(nodeInfo *starlingxv1.ProcessorInfo, f *starlingxv1.ProcessorFunctionInfo, counts map[string]int, host *v1info.HostInfo) (int, error) {
+	allocated := 0
+	current := make(map[string]int)
+	for _, c := range host.CPU {
+		if c.Thread == 0 && c.Processor == nodeInfo.Node {
+			current[strings.ToLower(c.Function)]++
+		}
+	}
+
+	for name, count := range current {
+		if _, ok := counts[name]; !ok && name != f.Function && name != cpus.CPUFunctionApplication {
+			allocated += count
+		}
+	}
+
+	for name, count := range counts {
+		if name != f.Function && name != cpus.CPUFunctionApplication {
+			allocated += count
+		}
+	}
+
+	platform, ok := counts[cpus.CPUFunctionPlatform]
+	if !ok {
+		platform = current[cpus.CPUFunctionPlatform]
+	}
+
+	count := f.Policy.Resolve(host.CountCPUByNode(nodeInfo.Node), allocated, platform)
+	if count < 0 {
+		msg := fmt.Sprintf("insufficient cores on node %d to satisfy the %s allocation policy",
+			nodeInfo.Node, f.Function)
+		return 0, common.NewUserDataError(msg)
+	}
+
+	return count, nil
+}
+
+// ResolveProcessorPolicies converts the processor allocation policies of the
+// profile into core counts based on the CPU topology of the host.  Within a
+// node, percentages and per-socket amounts are resolved before policies that
+// request the remaining cores so that the latter account for the former.  The
+// resolved allocations are returned so that they can be reported in the host
+// status.
+func ResolveProcessorPolicies(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) (starlingxv1.ProcessorNodeList, error) {
+	var resolved starlingxv1.ProcessorNodeList
+
+	for i := range profile.Processors {
+		nodeInfo := &profile.Processors[i]
+
+		counts := make(map[string]int)
+		for _, f := range nodeInfo.Functions {
+			if f.Policy == nil {
+				counts[f.Function] = f.Count
+			}
+		}
+
+		result := starlingxv1.ProcessorInfo{Node: nodeInfo.Node}
+		for _, remaining := range []bool{false, true} {
+			for j := range nodeInfo.Functions {
+				f := &nodeInfo.Functions[j]
+				if f.Policy == nil || (f.Policy.Remaining != nil && *f.Policy.Remaining) != remaining {
+					continue
+				}
+
+				count, err := resolveProcessorPolicy(nodeInfo, f, counts, host)
+				if err != nil {
+					return nil, err
+				}
+
+				logHost.V(2).Info("resolved processor allocation policy",
+					"node", nodeInfo.Node, "function", f.Function, "count", count)
+				counts[f.Function] = count
+				f.Count = count
+				f.Policy = nil
+				result.Functions = append(result.Functions, *f)
+			}
+		}
+
+		if len(result.Functions) > 0 {
+			resolved = append(resolved, result)
+		}
+	}
+
+	return resolved, nil
+}
+
+// ResolveAllocationPolicies converts the processor and memory allocation
+// policies of the profile into absolute values and records the resolved values
+// in the host status whenever they change.
+func (r *HostReconciler) ResolveAllocationPolicies(instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	processors, err := ResolveProcessorPolicies(profile, host)
+	if err != nil {
+		return err
+	}
+
+	memories, err := ResolveMemoryPolicies(profile, host)
+	if err != nil {
+		return err
+	}
+
+	if instance.Status.Processors.DeepEqual(&processors) && instance.Status.Memory.DeepEqual(&memories) {
+		return nil
+	}
+
+	instance.Status.Processors = processors
+	instance.Status.Memory = memories
+	err = r.Status().Update(context.TODO(), instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update status: %s",
+			common.FormatStruct(instance.Status))
+		return err
+	}
+
+	return nil
+}
+
 // ReconcileProcessors is responsible for reconciling the CPU configuration of a
 // host resource.
 func (r *HostReconciler) ReconcileProcessors(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
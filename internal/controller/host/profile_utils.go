@@ -12,7 +12,6 @@ import (
 
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaces"
-	"github.com/imdario/mergo"
 	perrors "github.com/pkg/errors"
 	"github.com/samber/lo"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
@@ -25,51 +24,6 @@ import (
 
 var logProfileUtils = log.Log.WithName("profile-utils")
 
-// MergeProfiles invokes the mergo.Merge API with our desired modifiers.
-func MergeProfiles(a, b *starlingxv1.HostProfileSpec) (*starlingxv1.HostProfileSpec, error) {
-	t := common.DefaultMergeTransformer
-	err := mergo.Merge(a, b, mergo.WithOverride, mergo.WithTransformers(t))
-	if err != nil {
-		err = perrors.Wrap(err, "mergo.Merge failed to merge profiles")
-		return nil, err
-	}
-
-	if b.Storage != nil && b.Storage.VolumeGroups != nil &&
-		a.Storage != nil && a.Storage.VolumeGroups != nil {
-		a.Storage.VolumeGroups = MergeVolumeGroups(a.Storage, b.Storage)
-	}
-
-	return a, nil
-}
-
-// MergeVolumeGroups uses b (user profile) as source of truth for which VGs
-// should exist. VGs in b that match by name in a (defaults) get their nil
-// fields filled from defaults. VGs in b with no match in a are new and
-// included as-is (to be created on the system).
-func MergeVolumeGroups(a, b *starlingxv1.ProfileStorageInfo) starlingxv1.VolumeGroupList {
-	filtered := make(starlingxv1.VolumeGroupList, 0, len(b.VolumeGroups))
-	for _, srcVG := range b.VolumeGroups {
-		for _, vg := range a.VolumeGroups {
-			if vg.Name == srcVG.Name {
-				if srcVG.LVMFunction == nil && vg.LVMFunction != nil {
-					srcVG.LVMFunction = vg.LVMFunction
-				}
-				if srcVG.LVMFunction != nil && *srcVG.LVMFunction != common.LVMFunctionNone {
-					if srcVG.LVMType == nil && vg.LVMType != nil {
-						srcVG.LVMType = vg.LVMType
-					}
-					if srcVG.LVMPoolSize == nil && vg.LVMPoolSize != nil {
-						srcVG.LVMPoolSize = vg.LVMPoolSize
-					}
-				}
-				break
-			}
-		}
-		filtered = append(filtered, srcVG)
-	}
-	return filtered
-}
-
 // FixProfileAttributes makes some adjustments to profile attributes
 func FixProfileAttributes(a, b, c *starlingxv1.HostProfileSpec, hostInfo *v1info.HostInfo) {
 	// To compare the BootMAC's we need to lowercase the values
@@ -294,21 +248,7 @@ func (r *HostReconciler) GetHostProfileSpec(namespace, profile string) (*starlin
 
 // GetHostProfile retrieves a HostProfile from the kubernetes API
 func (r *HostReconciler) GetHostProfile(namespace, profile string) (*starlingxv1.HostProfile, error) {
-	instance := &starlingxv1.HostProfile{}
-	name := types.NamespacedName{Namespace: namespace, Name: profile}
-
-	err := r.Get(context.TODO(), name, instance)
-	if err != nil {
-		if !errors.IsNotFound(err) {
-			err = perrors.Wrapf(err, "failed to get profile: %s", name)
-			return nil, err
-		} else {
-			msg := fmt.Sprintf("host profile %q not present", name)
-			return nil, common.NewResourceConfigurationDependency(msg)
-		}
-	}
-
-	return instance, nil
+	return common.GetHostProfile(r.Client, namespace, profile)
 }
 
 // DeleteHostProfile deletes a HostProfile from the kubernetes API
@@ -332,36 +272,6 @@ func (r *HostReconciler) DeleteHostProfile(namespace, profile string) error {
 	return nil
 }
 
-// mergeProfileChain merges the profile attributes from each profile in the
-// inheritance chain.  This is done recursively and fields set in lower profiles
-// take precedence over its parent/base profile attributes.  Arrays are handled
-// by looking for equivalent entries in the base profile attribute and replacing
-// their values.  Array entries that are not found in the base profile are
-// added to the array.
-func (r *HostReconciler) mergeProfileChain(namespace string, current *starlingxv1.HostProfileSpec, visited map[string]bool) (*starlingxv1.HostProfileSpec, error) {
-	if current.Base != nil {
-		if value, ok := visited[*current.Base]; ok && value {
-			msg := fmt.Sprintf("profile loop detected at: %s", *current.Base)
-			return nil, common.NewValidationError(msg)
-		}
-
-		parent, err := r.GetHostProfileSpec(namespace, *current.Base)
-		if err != nil {
-			return nil, err
-		}
-
-		parent, err = r.mergeProfileChain(namespace, parent, visited)
-		if err != nil {
-			return nil, err
-		}
-
-		return MergeProfiles(parent, current)
-	}
-
-	defaultCopy := DefaultHostProfile.DeepCopy()
-	return MergeProfiles(defaultCopy, current)
-}
-
 // BuildAndValidateCompositeProfile combines the methods of BuildCompositeProfile
 // and ValidateProfile, returns a combined profile which is validated
 func (r *HostReconciler) BuildAndValidateCompositeProfile(
@@ -387,34 +297,13 @@ func (r *HostReconciler) BuildAndValidateCompositeProfile(
 // chain, and host specific overrides to form a final composite profile that
 // will be applied to the host at configuration time.
 func (r *HostReconciler) BuildCompositeProfile(host *starlingxv1.Host) (*starlingxv1.HostProfileSpec, error) {
-	// Start with the explicit profile attached to the host.
-	profile, err := r.GetHostProfileSpec(host.Namespace, host.Spec.Profile)
-	if err != nil {
-		return nil, err
-	}
-
-	// Initialize map to track which profiles have already been visited so
-	// that we can catch loops.
-	visited := make(map[string]bool)
-
-	// Traverse the list of profiles until the root profile is found.
-	// Attributes from lower profiles (those closest to the host level) are
-	// merged into the higher level profile.
-	composite, err := r.mergeProfileChain(host.Namespace, profile, visited)
+	// Merge the profile inheritance chain and any per-host overrides over
+	// the default profile.
+	composite, err := common.MergeHostProfile(r.Client, host, DefaultHostProfile.DeepCopy())
 	if err != nil {
 		return composite, err
 	}
 
-	// Finally, if the user had provided any per-host overrides then apply
-	// over the composite profile.
-	if host.Spec.Overrides != nil {
-		// Merge the host overrides into the composite profile
-		composite, err = MergeProfiles(composite, host.Spec.Overrides)
-		if err != nil {
-			return composite, err
-		}
-	}
-
 	if composite.Interfaces != nil && len(composite.Interfaces.Ethernet) == 0 {
 		// In some cases it is necessary to set the "ethernet" attribute to
 		// an empty array in order to override the list of interfaces from a
@@ -747,3 +636,40 @@ func getCommonInterfaceInfo[T any](iface *T) *starlingxv1.CommonInterfaceInfo {
 		return nil
 	}
 }
+
+// selectOne returns the single item that matches a selector.  Items are
+// identified by the value returned by key which is used to skip items that are
+// already claimed and to list the candidates of an ambiguous selector.  An
+// error is returned if no item or more than one item matches so that an
+// ambiguous selector is never resolved arbitrarily.
+func selectOne[T any](noun, selector string, items []T, key func(*T) string, matches func(*T) bool, claimed map[string]bool) (*T, error) {
+	var found []*T
+	for i := range items {
+		item := &items[i]
+		if claimed[key(item)] {
+			continue
+		}
+
+		if matches(item) {
+			found = append(found, item)
+		}
+	}
+
+	if len(found) == 0 {
+		msg := fmt.Sprintf("no %s matches the %s", noun, selector)
+		return nil, common.NewUserDataError(msg)
+	}
+
+	if len(found) > 1 {
+		keys := make([]string, 0, len(found))
+		for _, item := range found {
+			keys = append(keys, key(item))
+		}
+
+		msg := fmt.Sprintf("the %s is ambiguous; it matches %ss: %s",
+			selector, noun, strings.Join(keys, ", "))
+		return nil, common.NewUserDataError(msg)
+	}
+
+	return found[0], nil
+}
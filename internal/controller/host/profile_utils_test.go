@@ -451,7 +451,7 @@ var _ = Describe("Profile utils", func() {
 					},
 				}
 				for _, tt := range tests {
-					got, err := MergeProfiles(tt.args.a, tt.args.b)
+					got, err := common.MergeProfiles(tt.args.a, tt.args.b)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(reflect.DeepEqual(got, tt.want)).To(BeTrue())
 					Expect(got).NotTo(BeNil())
@@ -509,7 +509,7 @@ var _ = Describe("Profile utils", func() {
 					},
 				}
 
-				result := MergeVolumeGroups(hostStorage, appliedStorage)
+				result := common.MergeVolumeGroups(hostStorage, appliedStorage)
 
 				Expect(result).To(HaveLen(2))
 				Expect(result[0].Name).To(Equal(common.LVG_CGTS_VG))
@@ -557,7 +557,7 @@ var _ = Describe("Profile utils", func() {
 					},
 				}
 
-				result := MergeVolumeGroups(hostStorage, appliedStorage)
+				result := common.MergeVolumeGroups(hostStorage, appliedStorage)
 
 				Expect(result).To(HaveLen(1))
 				Expect(result[0].Name).To(Equal(common.LVG_CGTS_VG))
@@ -1292,7 +1292,7 @@ var _ = Describe("Profile utils", func() {
 					{Interface: "eth0", Network: "10.10.10.0", Prefix: 24, Gateway: "10.10.10.2"},
 				},
 			}
-			merged, err := MergeProfiles(profileA, profileB)
+			merged, err := common.MergeProfiles(profileA, profileB)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(merged).NotTo(BeNil())
 			// Both routes should be preserved as distinct entries
@@ -1314,7 +1314,7 @@ var _ = Describe("Profile utils", func() {
 					{Interface: "eth0", Network: "10.0.0.0", Prefix: 8, Gateway: "10.0.0.1", Metric: &metric2},
 				},
 			}
-			merged, err := MergeProfiles(profileA, profileB)
+			merged, err := common.MergeProfiles(profileA, profileB)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(merged).NotTo(BeNil())
 			// Only one route should remain (same key), with metric overwritten
@@ -1336,7 +1336,7 @@ var _ = Describe("Profile utils", func() {
 					{Interface: "eth1", Network: "10.0.0.0", Prefix: 8, Gateway: "10.0.0.1"},
 				},
 			}
-			merged, err := MergeProfiles(profileA, profileB)
+			merged, err := common.MergeProfiles(profileA, profileB)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(merged).NotTo(BeNil())
 			Expect(merged.Routes).To(HaveLen(2))
@@ -1355,7 +1355,7 @@ var _ = Describe("Profile utils", func() {
 					{Interface: "eth0", Network: "192.168.0.0", Prefix: 8, Gateway: "192.168.0.1"},
 				},
 			}
-			merged, err := MergeProfiles(profileA, profileB)
+			merged, err := common.MergeProfiles(profileA, profileB)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(merged).NotTo(BeNil())
 			Expect(merged.Routes).To(HaveLen(2))
@@ -1374,7 +1374,7 @@ var _ = Describe("Profile utils", func() {
 					{Interface: "eth0", Network: "10.0.0.0", Prefix: 24, Gateway: "10.0.0.1"},
 				},
 			}
-			merged, err := MergeProfiles(profileA, profileB)
+			merged, err := common.MergeProfiles(profileA, profileB)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(merged).NotTo(BeNil())
 			Expect(merged.Routes).To(HaveLen(2))
@@ -1395,7 +1395,7 @@ var _ = Describe("Profile utils", func() {
 					{Interface: "eth3", Network: "fd00::", Prefix: 64, Gateway: "fd00::1"},
 				},
 			}
-			merged, err := MergeProfiles(profileA, profileB)
+			merged, err := common.MergeProfiles(profileA, profileB)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(merged).NotTo(BeNil())
 			Expect(merged.Routes).To(HaveLen(4))
@@ -1417,7 +1417,7 @@ var _ = Describe("MergeVolumeGroups LVM fields", func() {
 				},
 			}
 
-			result := MergeVolumeGroups(defaults, user)
+			result := common.MergeVolumeGroups(defaults, user)
 
 			Expect(result).To(HaveLen(1))
 			Expect(result[0].LVMPoolSize).NotTo(BeNil())
@@ -1440,7 +1440,7 @@ var _ = Describe("MergeVolumeGroups LVM fields", func() {
 				},
 			}
 
-			result := MergeVolumeGroups(defaults, user)
+			result := common.MergeVolumeGroups(defaults, user)
 
 			Expect(result).To(HaveLen(1))
 			Expect(*result[0].LVMFunction).To(Equal(common.LVMFunctionNone))
@@ -15,7 +15,6 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/osds"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/partitions"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/physicalvolumes"
-	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagetiers"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/volumegroups"
 	perrors "github.com/pkg/errors"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
@@ -23,6 +22,7 @@ import (
 	ctrlcommon "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/idisks"
 )
 
 // ReconcileMonitor is responsible for reconciling the Ceph storage monitor
@@ -491,8 +491,8 @@ func osdUpdateRequired(osdInfo *starlingxv1.OSDInfo, osd *osds.OSD) (opts osds.O
 }
 
 // ReconcileStaleOSDs is responsible for removing any OSD resources that are
-// either no longer in the configured list or their function or journal has
-// changed.
+// either no longer in the configured list or their function, journal or tier
+// has changed.
 func (r *HostReconciler) ReconcileStaleOSDs(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
 	present := make(map[string]bool)
 	updated := make(map[string]bool)
@@ -520,6 +520,13 @@ func (r *HostReconciler) ReconcileStaleOSDs(client *gophercloud.ServiceClient, i
 					updated[osd.ID] = true
 				}
 			}
+
+			tier := host.FindStorageTier(osdInfo.GetClusterName(), osdInfo.GetTierName())
+			if osd.Function == osds.FunctionOSD && tier != nil && osd.TierUUID != "" && osd.TierUUID != tier.ID {
+				// The system API does not support moving an OSD to another
+				// tier so delete it so that it can be re-added.
+				updated[osd.ID] = true
+			}
 		}
 	}
 
@@ -609,9 +616,10 @@ func (r *HostReconciler) OSDProvisioningAllowed(instance *starlingxv1.Host, osdI
 
 	if tierUUID == nil {
 		// The storage tier has not yet been allocated so wait and retry.
+		tierName := osdInfo.GetTierName()
 		msg := fmt.Sprintf("waiting for the %q %s tier to be created",
-			clusterName, storagetiers.StorageTierName)
-		m := NewStorageTierMonitor(instance, cluster.ID, storagetiers.StorageTierName)
+			clusterName, tierName)
+		m := NewStorageTierMonitor(instance, cluster.ID, tierName)
 		return r.StartMonitor(m, msg)
 	}
 
@@ -650,7 +658,7 @@ func buildOSDOpts(host *v1info.HostInfo, osdInfo starlingxv1.OSDInfo) (osds.OSDO
 		opts.JournalSize = &size
 	}
 
-	tier := host.StorageTiers[osdInfo.GetClusterName()]
+	tier := host.FindStorageTier(osdInfo.GetClusterName(), osdInfo.GetTierName())
 	if tier != nil {
 		opts.TierUUID = &tier.ID
 	}
@@ -869,6 +877,150 @@ func (r *HostReconciler) ReconcileFileSystemTypes(client *gophercloud.ServiceCli
 	return nil
 }
 
+// selectDisk returns the single host disk that matches a disk selector.  Disks
+// that are already claimed by another storage resource are not considered.
+func selectDisk(kind string, selector *starlingxv1.DiskSelector, disks []idisks.Disk, claimed map[string]bool) (*idisks.Disk, error) {
+	return selectOne("disk", kind+" disk selector", disks,
+		func(d *idisks.Disk) string { return d.DevicePath },
+		func(d *idisks.Disk) bool {
+			return selector.Matches(d.SizeGiB(), d.DeviceType, d.Capabilities.ModelNumber, d.SerialID, d.WWN)
+		},
+		claimed)
+}
+
+// ResolveDiskSelectors converts the disk selectors of the profile into disk
+// device paths based on the disk attributes reported by the host inventory.
+// Disks consumed entirely by an OSD or a disk physical volume can only be
+// selected once, so disks referenced by an explicit path and disks resolved by
+// an earlier selector are excluded from later selectors.  Selectors must be
+// resolved before profiles are merged since storage resources are keyed by
+// their device path.
+func ResolveDiskSelectors(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
+	claimed := make(map[string]bool)
+	claim := func(path string) {
+		if d, ok := host.FindDiskByPath(path); ok {
+			claimed[d.DevicePath] = true
+		} else if d, ok := host.FindDiskByNode(path); ok {
+			claimed[d.DevicePath] = true
+		}
+	}
+
+	if profile.Storage != nil {
+		for _, osdInfo := range profile.Storage.OSDs {
+			if osdInfo.Selector == nil {
+				claim(osdInfo.Path)
+			}
+		}
+
+		for _, vgInfo := range profile.Storage.VolumeGroups {
+			for _, pvInfo := range vgInfo.PhysicalVolumes {
+				if pvInfo.Selector == nil && pvInfo.Type == physicalvolumes.PVTypeDisk {
+					claim(pvInfo.Path)
+				}
+			}
+		}
+
+		for i := range profile.Storage.OSDs {
+			osdInfo := &profile.Storage.OSDs[i]
+			if osdInfo.Selector == nil {
+				continue
+			}
+
+			disk, err := selectDisk("OSD", osdInfo.Selector, host.DiskAttributes, claimed)
+			if err != nil {
+				return err
+			}
+
+			logHost.V(2).Info("resolved OSD disk selector", "path", disk.DevicePath)
+			claimed[disk.DevicePath] = true
+			osdInfo.Path = disk.DevicePath
+			osdInfo.Selector = nil
+		}
+
+		for i := range profile.Storage.VolumeGroups {
+			vgInfo := &profile.Storage.VolumeGroups[i]
+			for j := range vgInfo.PhysicalVolumes {
+				pvInfo := &vgInfo.PhysicalVolumes[j]
+				if pvInfo.Selector == nil {
+					continue
+				}
+
+				kind := fmt.Sprintf("%s physical volume", vgInfo.Name)
+				disk, err := selectDisk(kind, pvInfo.Selector, host.DiskAttributes, claimed)
+				if err != nil {
+					return err
+				}
+
+				logHost.V(2).Info("resolved physical volume disk selector",
+					"group", vgInfo.Name, "path", disk.DevicePath)
+				if pvInfo.Type == physicalvolumes.PVTypeDisk {
+					// Partitions may share a disk but whole disks may not.
+					claimed[disk.DevicePath] = true
+				}
+				pvInfo.Path = disk.DevicePath
+				pvInfo.Selector = nil
+			}
+		}
+	}
+
+	// The root and boot devices typically share their disk with partitions
+	// so they are resolved against the full list of disks.
+	if profile.RootDeviceSelector != nil {
+		disk, err := selectDisk("root device", profile.RootDeviceSelector, host.DiskAttributes, nil)
+		if err != nil {
+			return err
+		}
+
+		profile.RootDevice = &disk.DevicePath
+		profile.RootDeviceSelector = nil
+	}
+
+	if profile.BootDeviceSelector != nil {
+		disk, err := selectDisk("boot device", profile.BootDeviceSelector, host.DiskAttributes, nil)
+		if err != nil {
+			return err
+		}
+
+		profile.BootDevice = &disk.DevicePath
+		profile.BootDeviceSelector = nil
+	}
+
+	return nil
+}
+
+// ResolveFileSystemSizes converts the size policies of the host filesystems
+// into absolute sizes based on the current filesystem sizes and the space
+// left in the cgts-vg volume group.  Filesystems are resolved in order and
+// the growth of each one reduces the space left for the next ones.
+func ResolveFileSystemSizes(profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) {
+	if profile.Storage == nil {
+		return
+	}
+
+	available := host.VolumeGroupAvailableSize(ctrlcommon.LVG_CGTS_VG)
+	for i := range profile.Storage.FileSystems {
+		fsInfo := &profile.Storage.FileSystems[i]
+		if fsInfo.SizePolicy == nil {
+			continue
+		}
+
+		current := 0
+		for _, fs := range host.FileSystems {
+			if fs.Name == fsInfo.Name {
+				current = fs.Size
+				break
+			}
+		}
+
+		size := fsInfo.SizePolicy.Resolve(fsInfo.Size, current, available)
+		available = max(available-(size-current), 0)
+
+		logHost.V(2).Info("resolved filesystem size policy", "name", fsInfo.Name, "size", size)
+		fsInfo.Size = size
+		fsInfo.SizePolicy = nil
+	}
+}
+
 // ReconcileFileSystemSizes is responsible for reconciling the storage file system
 // configuration of a host resource.
 func (r *HostReconciler) ReconcileFileSystemSizes(client *gophercloud.ServiceClient, instance *starlingxv1.Host, profile *starlingxv1.HostProfileSpec, host *v1info.HostInfo) error {
@@ -7,6 +7,8 @@ import (
 	"net/http"
 
 	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/disks"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hostFilesystems"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/osds"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/volumegroups"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -16,6 +18,8 @@ import (
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	ctrlcommon "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/idisks"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/lvgs"
 	"k8s.io/client-go/tools/record"
 )
 
@@ -212,3 +216,129 @@ var _ = Describe("osdUpdateRequired", func() {
 		})
 	})
 })
+
+var _ = Describe("ResolveFileSystemSizes", func() {
+	It("should convert size policies into absolute sizes", func() {
+		percent, maxAvailable := 50, true
+		profile := &starlingxv1.HostProfileSpec{
+			Storage: &starlingxv1.ProfileStorageInfo{
+				FileSystems: starlingxv1.FileSystemList{
+					{Name: "backup", Size: 25},
+					{Name: "docker", Size: 30, SizePolicy: &starlingxv1.FileSystemSizePolicy{Percent: &percent}},
+					{Name: "instances", SizePolicy: &starlingxv1.FileSystemSizePolicy{MaxAvailable: &maxAvailable}},
+				},
+			},
+		}
+		host := &v1info.HostInfo{
+			FileSystems: []hostFilesystems.FileSystem{
+				{Name: "backup", Size: 25},
+				{Name: "docker", Size: 30},
+			},
+			VolumeGroupUsage: []lvgs.VolumeGroup{
+				{Name: ctrlcommon.LVG_CGTS_VG, Size: 100 * 1024 * 1024 * 1024, TotalPE: 100, FreePE: 90},
+			},
+		}
+
+		ResolveFileSystemSizes(profile, host)
+
+		Expect(profile.Storage.FileSystems).To(Equal(starlingxv1.FileSystemList{
+			{Name: "backup", Size: 25},
+			{Name: "docker", Size: 60},
+			{Name: "instances", Size: 60},
+		}))
+	})
+})
+
+var _ = Describe("ResolveDiskSelectors", func() {
+	const (
+		sdaPath  = "/dev/disk/by-path/pci-0000:00:1f.2-ata-1.0"
+		sdbPath  = "/dev/disk/by-path/pci-0000:00:1f.2-ata-2.0"
+		nvmePath = "/dev/disk/by-path/pci-0000:3b:00.0-nvme-1"
+	)
+	var host *v1info.HostInfo
+
+	BeforeEach(func() {
+		host = &v1info.HostInfo{
+			Disks: []disks.Disk{
+				{ID: "d1", DeviceNode: "/dev/sda", DevicePath: sdaPath},
+				{ID: "d2", DeviceNode: "/dev/sdb", DevicePath: sdbPath},
+				{ID: "d3", DeviceNode: "/dev/nvme0n1", DevicePath: nvmePath},
+			},
+			DiskAttributes: []idisks.Disk{
+				{ID: "d1", DeviceNode: "/dev/sda", DevicePath: sdaPath, DeviceType: idisks.DiskTypeSSD, Size: 480 * 1024, SerialID: "S1"},
+				{ID: "d2", DeviceNode: "/dev/sdb", DevicePath: sdbPath, DeviceType: idisks.DiskTypeSSD, Size: 480 * 1024, SerialID: "S2"},
+				{ID: "d3", DeviceNode: "/dev/nvme0n1", DevicePath: nvmePath, DeviceType: idisks.DiskTypeNVME, Size: 1900 * 1024, SerialID: "N1"},
+			},
+		}
+	})
+
+	It("should resolve selectors into device paths", func() {
+		nvme, serial, minSize := idisks.DiskTypeNVME, "S1", 400
+		profile := &starlingxv1.HostProfileSpec{
+			Storage: &starlingxv1.ProfileStorageInfo{
+				OSDs: starlingxv1.OSDList{
+					{Function: "osd", Selector: &starlingxv1.DiskSelector{Type: &nvme}},
+				},
+			},
+		}
+		profile.RootDeviceSelector = &starlingxv1.DiskSelector{Serial: &serial, MinSize: &minSize}
+
+		err := ResolveDiskSelectors(profile, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(profile.Storage.OSDs[0].Path).To(Equal(nvmePath))
+		Expect(profile.Storage.OSDs[0].Selector).To(BeNil())
+		Expect(*profile.RootDevice).To(Equal(sdaPath))
+		Expect(profile.RootDeviceSelector).To(BeNil())
+	})
+
+	It("should exclude disks that are already claimed", func() {
+		ssd := idisks.DiskTypeSSD
+		profile := &starlingxv1.HostProfileSpec{
+			Storage: &starlingxv1.ProfileStorageInfo{
+				OSDs: starlingxv1.OSDList{
+					{Function: "osd", Path: "/dev/sda"},
+					{Function: "osd", Selector: &starlingxv1.DiskSelector{Type: &ssd}},
+				},
+			},
+		}
+
+		err := ResolveDiskSelectors(profile, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(profile.Storage.OSDs[1].Path).To(Equal(sdbPath))
+	})
+
+	It("should fail when a selector is ambiguous", func() {
+		ssd := idisks.DiskTypeSSD
+		profile := &starlingxv1.HostProfileSpec{
+			Storage: &starlingxv1.ProfileStorageInfo{
+				OSDs: starlingxv1.OSDList{
+					{Function: "osd", Selector: &starlingxv1.DiskSelector{Type: &ssd}},
+				},
+			},
+		}
+
+		err := ResolveDiskSelectors(profile, host)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(sdaPath))
+		Expect(err.Error()).To(ContainSubstring(sdbPath))
+	})
+
+	It("should fail when no disk matches a selector", func() {
+		hdd := idisks.DiskTypeHDD
+		profile := &starlingxv1.HostProfileSpec{
+			Storage: &starlingxv1.ProfileStorageInfo{
+				VolumeGroups: starlingxv1.VolumeGroupList{
+					{
+						Name: "nova-local",
+						PhysicalVolumes: starlingxv1.PhysicalVolumeList{
+							{Type: "disk", Selector: &starlingxv1.DiskSelector{Type: &hdd}},
+						},
+					},
+				},
+			},
+		}
+
+		err := ResolveDiskSelectors(profile, host)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,544 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logHostPool = log.Log.WithName("controller").WithName("hostpool")
+
+const HostPoolControllerName = "hostpool-controller"
+
+var _ reconcile.Reconciler = &HostPoolReconciler{}
+
+// HostPoolReconciler reconciles a HostPool object
+type HostPoolReconciler struct {
+	client.Client
+	// APIReader reads host resources directly from the API server so that
+	// boot MAC claims are never evaluated against a stale cache.
+	APIReader client.Reader
+	Log       logr.Logger
+	Scheme    *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// hostPoolSelector defines the compiled form of the selector of a host pool.
+type hostPoolSelector struct {
+	bootMACPrefixes []string
+	serialNumber    *regexp.Regexp
+	assetTag        *regexp.Regexp
+	bmType          *string
+}
+
+// normalizeMAC is a utility function which converts a MAC address, or a
+// prefix of one, to the lower case colon separated form reported by the
+// system.
+func normalizeMAC(mac string) string {
+	return strings.ToLower(strings.ReplaceAll(mac, "-", ":"))
+}
+
+// bootMACLabelValue is a utility function which converts a normalized MAC
+// address to the value of the boot MAC claim label.
+func bootMACLabelValue(mac string) string {
+	return strings.ReplaceAll(mac, ":", "-")
+}
+
+// newHostPoolSelector is a utility function which compiles the selector of a
+// host pool.  An invalid regular expression is reported as a user data error
+// since retrying is pointless until the resource is corrected.
+func newHostPoolSelector(spec *starlingxv1.HostPoolSelector) (*hostPoolSelector, error) {
+	var err error
+
+	result := &hostPoolSelector{bmType: spec.BoardManagementType}
+
+	for _, prefix := range spec.BootMACPrefixes {
+		result.bootMACPrefixes = append(result.bootMACPrefixes, normalizeMAC(prefix))
+	}
+
+	if spec.SerialNumber != nil {
+		result.serialNumber, err = regexp.Compile(*spec.SerialNumber)
+		if err != nil {
+			msg := fmt.Sprintf("invalid serial number expression %q: %s", *spec.SerialNumber, err.Error())
+			return nil, common.NewUserDataError(msg)
+		}
+	}
+
+	if spec.AssetTag != nil {
+		result.assetTag, err = regexp.Compile(*spec.AssetTag)
+		if err != nil {
+			msg := fmt.Sprintf("invalid asset tag expression %q: %s", *spec.AssetTag, err.Error())
+			return nil, common.NewUserDataError(msg)
+		}
+	}
+
+	return result, nil
+}
+
+// Matches determines whether a host has all of the attributes required by
+// the selector.  Attributes which are not reported by the system never match.
+func (s *hostPoolSelector) Matches(h *hosts.Host) bool {
+	if len(s.bootMACPrefixes) > 0 {
+		mac := normalizeMAC(h.BootMAC)
+		found := false
+		for _, prefix := range s.bootMACPrefixes {
+			if strings.HasPrefix(mac, prefix) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if s.serialNumber != nil {
+		if h.SerialNumber == nil || !s.serialNumber.MatchString(*h.SerialNumber) {
+			return false
+		}
+	}
+
+	if s.assetTag != nil {
+		if h.AssetTag == nil || !s.assetTag.MatchString(*h.AssetTag) {
+			return false
+		}
+	}
+
+	if s.bmType != nil {
+		if h.BMType == nil || !strings.EqualFold(*h.BMType, *s.bmType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hostResourceBootMAC is a utility function which returns the boot MAC
+// address that a host resource uses to match its system host, if any.
+func hostResourceBootMAC(instance *starlingxv1.Host) string {
+	if instance.Spec.Match != nil && instance.Spec.Match.BootMAC != nil {
+		return normalizeMAC(*instance.Spec.Match.BootMAC)
+	}
+
+	if instance.Spec.Overrides != nil && instance.Spec.Overrides.BootMAC != nil {
+		return normalizeMAC(*instance.Spec.Overrides.BootMAC)
+	}
+
+	return ""
+}
+
+// hostPoolInventory defines the state of the hosts and host resources that
+// is relevant to enrolling hosts in a pool.
+type hostPoolInventory struct {
+	// members defines the host resources previously created by the pool.
+	members []starlingxv1.HostPoolMember
+
+	// names defines the host resource names and system hostnames in use.
+	names map[string]bool
+
+	// candidates defines the unprovisioned hosts which match the pool
+	// selector and are not yet claimed by any host resource.
+	candidates []hosts.Host
+
+	// unprovisioned defines the boot MAC addresses of all unprovisioned
+	// hosts known at the time the inventory was built.
+	unprovisioned map[string]bool
+}
+
+// buildHostPoolInventory is a utility function which collects the members of
+// a pool and the unprovisioned hosts that are candidates for enrollment.
+// Hosts already claimed by a host resource, whether created by a pool or
+// not, are never candidates.
+func buildHostPoolInventory(instance *starlingxv1.HostPool, selector *hostPoolSelector, objects []hosts.Host, resources []starlingxv1.Host) *hostPoolInventory {
+	result := &hostPoolInventory{
+		members:       make([]starlingxv1.HostPoolMember, 0),
+		names:         make(map[string]bool),
+		unprovisioned: make(map[string]bool),
+	}
+
+	claimed := make(map[string]bool)
+	for i := range resources {
+		resource := &resources[i]
+		result.names[resource.Name] = true
+
+		mac := hostResourceBootMAC(resource)
+		if mac != "" {
+			claimed[mac] = true
+		}
+
+		if resource.Labels[starlingxv1.HostPoolLabel] == instance.Name {
+			result.members = append(result.members, starlingxv1.HostPoolMember{
+				Name:    resource.Name,
+				BootMAC: mac,
+			})
+		}
+	}
+
+	for _, h := range objects {
+		if h.Hostname != "" {
+			result.names[h.Hostname] = true
+			continue
+		}
+
+		if h.BootMAC == "" {
+			continue
+		}
+
+		mac := normalizeMAC(h.BootMAC)
+		result.unprovisioned[mac] = true
+
+		if claimed[mac] {
+			continue
+		}
+
+		if selector.Matches(&h) {
+			result.candidates = append(result.candidates, h)
+		}
+	}
+
+	sort.SliceStable(result.members, func(i, j int) bool {
+		return result.members[i].Name < result.members[j].Name
+	})
+
+	sort.SliceStable(result.candidates, func(i, j int) bool {
+		return normalizeMAC(result.candidates[i].BootMAC) < normalizeMAC(result.candidates[j].BootMAC)
+	})
+
+	return result
+}
+
+// allocateHostname is a utility function which returns the lowest unused
+// hostname of a pool and records it as being in use.
+func allocateHostname(instance *starlingxv1.HostPool, names map[string]bool) string {
+	allocation := &instance.Spec.Hostnames
+	for index := allocation.FirstIndex(); ; index++ {
+		name := allocation.Hostname(index)
+		if !names[name] {
+			names[name] = true
+			return name
+		}
+	}
+}
+
+// newPoolHost is a utility function which builds the host resource used to
+// enroll an unprovisioned host in a pool.  The resource is deliberately not
+// owned by the pool so that deleting the pool does not delete the host from
+// the system.
+func newPoolHost(instance *starlingxv1.HostPool, name string, h *hosts.Host) *starlingxv1.Host {
+	mac := normalizeMAC(h.BootMAC)
+	return &starlingxv1.Host{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: instance.Namespace,
+			Labels: map[string]string{
+				starlingxv1.HostPoolLabel:        instance.Name,
+				starlingxv1.HostPoolBootMACLabel: bootMACLabelValue(mac),
+			},
+		},
+		Spec: starlingxv1.HostSpec{
+			Profile: instance.Spec.Profile,
+			Match:   &starlingxv1.MatchInfo{BootMAC: &mac},
+		},
+	}
+}
+
+// bootMACClaimed is a method which determines whether any pool has already
+// created a host resource for a boot MAC address.  Pools may overlap, and the
+// resources created while reconciling another pool may not have reached the
+// cache yet, so the API server is queried directly.
+func (r *HostPoolReconciler) bootMACClaimed(namespace, mac string) (bool, error) {
+	resources := &starlingxv1.HostList{}
+	err := r.APIReader.List(context.TODO(), resources, client.InNamespace(namespace),
+		client.MatchingLabels{starlingxv1.HostPoolBootMACLabel: bootMACLabelValue(mac)})
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to list host resources for boot MAC: %s", mac)
+		return false, err
+	}
+
+	return len(resources.Items) > 0, nil
+}
+
+// ReconcileMembers is a method which enrolls the candidate hosts of a pool
+// by creating a host resource for each of them until the pool is full.  The
+// boot MAC address of a candidate is claimed before its host resource is
+// created so that overlapping pools never enroll the same host twice.
+func (r *HostPoolReconciler) ReconcileMembers(instance *starlingxv1.HostPool, inventory *hostPoolInventory) error {
+	for i := range inventory.candidates {
+		h := &inventory.candidates[i]
+
+		if instance.Spec.MaxHosts != nil && len(inventory.members) >= *instance.Spec.MaxHosts {
+			logHostPool.Info("host pool is full", "pending", len(inventory.candidates)-i)
+			break
+		}
+
+		claimed, err := r.bootMACClaimed(instance.Namespace, normalizeMAC(h.BootMAC))
+		if err != nil {
+			return err
+		}
+
+		if claimed {
+			logHostPool.Info("boot MAC already claimed", "bootMAC", h.BootMAC)
+			continue
+		}
+
+		name := allocateHostname(instance, inventory.names)
+		resource := newPoolHost(instance, name, h)
+
+		logHostPool.Info("enrolling host", "name", name, "bootMAC", h.BootMAC)
+
+		err = r.Create(context.TODO(), resource)
+		if err != nil {
+			if errors.IsAlreadyExists(err) {
+				// The name was taken since the resources were listed so
+				// try again with fresh data on the next reconciliation.
+				msg := fmt.Sprintf("host resource %q already exists", name)
+				return common.NewResourceConfigurationDependency(msg)
+			}
+			err = perrors.Wrapf(err, "failed to create host: %s", name)
+			return err
+		}
+
+		inventory.members = append(inventory.members, starlingxv1.HostPoolMember{
+			Name:    name,
+			BootMAC: normalizeMAC(h.BootMAC),
+		})
+
+		r.NormalEvent(instance, common.ResourceCreated,
+			"host %q has been enrolled with boot MAC %s", name, h.BootMAC)
+	}
+
+	sort.SliceStable(inventory.members, func(i, j int) bool {
+		return inventory.members[i].Name < inventory.members[j].Name
+	})
+
+	return nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an update
+// is required to the host pool status attribute.  Updating this unnecessarily
+// will result in an infinite reconciliation loop.
+func (r *HostPoolReconciler) statusUpdateRequired(instance *starlingxv1.HostPool, original *starlingxv1.HostPoolStatus, inSync bool) bool {
+	status := &instance.Status
+
+	status.InSync = inSync
+
+	if status.InSync && !status.Reconciled {
+		// Record the fact that we have reached inSync at least once.
+		status.Reconciled = true
+	}
+
+	status.ObservedGeneration = instance.Generation
+
+	return !status.DeepEqual(original)
+}
+
+// ReconcileResource interacts with the system API in order to enroll the
+// unprovisioned hosts which match the pool selector.  New hosts appear in the
+// inventory without any event being raised therefore a monitor is left
+// running to trigger a new reconciliation whenever the candidates or the
+// members of the pool change.
+func (r *HostPoolReconciler) ReconcileResource(platformClient *gophercloud.ServiceClient, instance *starlingxv1.HostPool) error {
+	if !instance.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	original := instance.Status.DeepCopy()
+
+	var inventory *hostPoolInventory
+
+	selector, err := newHostPoolSelector(&instance.Spec.Selector)
+	if err == nil {
+		var objects []hosts.Host
+		objects, err = hosts.ListHosts(platformClient)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to list hosts")
+		} else {
+			resources := &starlingxv1.HostList{}
+			err = r.List(context.TODO(), resources, client.InNamespace(instance.Namespace))
+			if err != nil {
+				err = perrors.Wrap(err, "failed to list host resources")
+			} else {
+				inventory = buildHostPoolInventory(instance, selector, objects, resources.Items)
+				err = r.ReconcileMembers(instance, inventory)
+			}
+		}
+	}
+
+	if inventory != nil {
+		instance.Status.Members = inventory.members
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, original, inSync) {
+		logHostPool.Info("updating host pool", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return r.StartMonitor(NewHostPoolMonitor(instance, selector, inventory),
+		"waiting for hosts to enroll")
+}
+
+// Reconcile reads that state of the cluster for a HostPool object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hostpools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hostpools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts,verbs=get;list;watch;create
+func (r *HostPoolReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logHostPool
+	logHostPool = logHostPool.WithName(request.String())
+	defer func() { logHostPool = savedLog }()
+
+	// Fetch the HostPool instance
+	instance := &starlingxv1.HostPool{}
+	err := r.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logHostPool.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system API requests without executing them.
+		// Host resources are not created while in plan mode so that no host
+		// is enrolled.
+		if !utils.IsReconcilerEnabled(utils.HostPool) {
+			return reconcile.Result{}, nil
+		}
+
+		platformClient := r.GetPlatformClient(request.Namespace)
+		if platformClient == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		err = r.ReconcilePlan(platformClient, instance)
+		return reconcile.Result{}, err
+	}
+
+	if !utils.IsReconcilerEnabled(utils.HostPool) {
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !r.GetSystemReady(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
+	err = r.ReconcileResource(platformClient, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ReconcilePlan runs the host pool reconciliation against plan mode clients
+// and publishes the system API requests that it would have issued in the
+// resource status.
+func (r *HostPoolReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.HostPool) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logHostPool)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy())
+
+	return p.Publish(r.Client, instance, result)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *HostPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.APIReader = mgr.GetAPIReader()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logHostPool}
+	r.ReconcilerEventLogger = &common.EventLogger{
+		EventRecorder: mgr.GetEventRecorderFor(HostPoolControllerName),
+		Logger:        logHostPool}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.HostPool{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.HostPoolList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
+		Complete(r)
+}
@@ -0,0 +1,165 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package controller
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+)
+
+func newHostPoolReconciler(dm *cloudManager.Dummymanager) *HostPoolReconciler {
+	logger := log.Log.WithName("test")
+	return &HostPoolReconciler{
+		Client:       k8sClient,
+		APIReader:    k8sClient,
+		CloudManager: dm,
+		ReconcilerErrorHandler: &common.ErrorHandler{
+			CloudManager: dm,
+			Logger:       logger,
+		},
+		ReconcilerEventLogger: &common.EventLogger{
+			EventRecorder: record.NewFakeRecorder(100),
+			Logger:        logger,
+		},
+	}
+}
+
+var _ = Describe("HostPool controller", func() {
+	var (
+		instance *starlingxv1.HostPool
+		objects  []hosts.Host
+	)
+
+	BeforeEach(func() {
+		instance = &starlingxv1.HostPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "workers", Namespace: "default"},
+			Spec: starlingxv1.HostPoolSpec{
+				Profile:   "worker-profile",
+				Hostnames: starlingxv1.HostnameAllocationInfo{Prefix: "worker-"},
+				Selector: starlingxv1.HostPoolSelector{
+					BootMACPrefixes: []string{"3C-FD-FE"},
+					SerialNumber:    ptr.To("^SN"),
+				},
+			},
+		}
+		objects = []hosts.Host{
+			{ID: "1", Hostname: "controller-0", BootMAC: "3c:fd:fe:00:00:01"},
+			{ID: "2", Hostname: "worker-0", BootMAC: "3c:fd:fe:00:00:02"},
+			{ID: "3", BootMAC: "3c:fd:fe:00:00:04", SerialNumber: ptr.To("SN0004")},
+			{ID: "4", BootMAC: "3c:fd:fe:00:00:03", SerialNumber: ptr.To("SN0003")},
+			{ID: "5", BootMAC: "00:11:22:00:00:05", SerialNumber: ptr.To("SN0005")},
+			{ID: "6", BootMAC: "3c:fd:fe:00:00:06"},
+		}
+	})
+
+	Describe("newHostPoolSelector", func() {
+		It("should reject an invalid expression", func() {
+			instance.Spec.Selector.AssetTag = ptr.To("(")
+			_, err := newHostPoolSelector(&instance.Spec.Selector)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require every attribute to match", func() {
+			selector, err := newHostPoolSelector(&instance.Spec.Selector)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selector.Matches(&objects[2])).To(BeTrue())
+			Expect(selector.Matches(&objects[4])).To(BeFalse())
+			Expect(selector.Matches(&objects[5])).To(BeFalse())
+		})
+	})
+
+	Describe("buildHostPoolInventory", func() {
+		It("should skip provisioned and claimed hosts", func() {
+			selector, err := newHostPoolSelector(&instance.Spec.Selector)
+			Expect(err).ToNot(HaveOccurred())
+			resources := []starlingxv1.Host{{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker-1"},
+				Spec: starlingxv1.HostSpec{
+					Match: &starlingxv1.MatchInfo{BootMAC: ptr.To("3C:FD:FE:00:00:04")},
+				},
+			}}
+			inventory := buildHostPoolInventory(instance, selector, objects, resources)
+			Expect(inventory.candidates).To(HaveLen(1))
+			Expect(inventory.candidates[0].ID).To(Equal("4"))
+			Expect(inventory.members).To(BeEmpty())
+			Expect(allocateHostname(instance, inventory.names)).To(Equal("worker-2"))
+		})
+	})
+
+	Describe("ReconcileMembers", func() {
+		var reconciler *HostPoolReconciler
+
+		BeforeEach(func() {
+			reconciler = newHostPoolReconciler(&cloudManager.Dummymanager{})
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.DeleteAllOf(context.Background(), &starlingxv1.Host{},
+				client.InNamespace("default"),
+				client.MatchingLabels{starlingxv1.HostPoolLabel: instance.Name})).To(Succeed())
+		})
+
+		It("should create a host resource for each candidate", func() {
+			selector, err := newHostPoolSelector(&instance.Spec.Selector)
+			Expect(err).ToNot(HaveOccurred())
+			inventory := buildHostPoolInventory(instance, selector, objects, nil)
+			Expect(reconciler.ReconcileMembers(instance, inventory)).To(Succeed())
+			Expect(inventory.members).To(Equal([]starlingxv1.HostPoolMember{
+				{Name: "worker-1", BootMAC: "3c:fd:fe:00:00:03"},
+				{Name: "worker-2", BootMAC: "3c:fd:fe:00:00:04"},
+			}))
+
+			host := &starlingxv1.Host{}
+			key := client.ObjectKey{Namespace: "default", Name: "worker-1"}
+			Expect(k8sClient.Get(context.Background(), key, host)).To(Succeed())
+			Expect(host.Spec.Profile).To(Equal("worker-profile"))
+			Expect(*host.Spec.Match.BootMAC).To(Equal("3c:fd:fe:00:00:03"))
+			Expect(host.Labels).To(HaveKeyWithValue(starlingxv1.HostPoolBootMACLabel, "3c-fd-fe-00-00-03"))
+		})
+
+		It("should skip a boot MAC claimed by an overlapping pool", func() {
+			other := &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "compute-0",
+					Namespace: "default",
+					Labels: map[string]string{
+						starlingxv1.HostPoolLabel:        "computes",
+						starlingxv1.HostPoolBootMACLabel: "3c-fd-fe-00-00-03",
+					},
+				},
+				Spec: starlingxv1.HostSpec{Profile: "compute-profile"},
+			}
+			Expect(k8sClient.Create(context.Background(), other)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, context.Background(), other)
+
+			selector, err := newHostPoolSelector(&instance.Spec.Selector)
+			Expect(err).ToNot(HaveOccurred())
+			inventory := buildHostPoolInventory(instance, selector, objects, nil)
+			Expect(reconciler.ReconcileMembers(instance, inventory)).To(Succeed())
+			Expect(inventory.members).To(Equal([]starlingxv1.HostPoolMember{
+				{Name: "worker-1", BootMAC: "3c:fd:fe:00:00:04"},
+			}))
+		})
+
+		It("should stop once the pool is full", func() {
+			instance.Spec.MaxHosts = ptr.To(1)
+			selector, err := newHostPoolSelector(&instance.Spec.Selector)
+			Expect(err).ToNot(HaveOccurred())
+			inventory := buildHostPoolInventory(instance, selector, objects, nil)
+			Expect(reconciler.ReconcileMembers(instance, inventory)).To(Succeed())
+			Expect(inventory.members).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultHostPoolMonitorInterval represents the default interval between
+// polling attempts to check whether new hosts can be enrolled in a pool.
+const DefaultHostPoolMonitorInterval = 30 * time.Second
+
+// hostPoolMonitor waits for the enrollment state of a pool to change.  A
+// reconcilable event is generated whenever a new candidate host appears in
+// the inventory or whenever the number of host resources created by the pool
+// changes (e.g., one was deleted to make room in a full pool).
+type hostPoolMonitor struct {
+	manager.CommonMonitorBody
+	manager       manager.CloudManager
+	namespace     string
+	name          string
+	selector      *hostPoolSelector
+	unprovisioned map[string]bool
+	members       int
+}
+
+// NewHostPoolMonitor defines a convenience function to instantiate a new host
+// pool monitor with all required attributes.  The unprovisioned hosts already
+// known to the reconciler, whether they were enrolled, claimed by another
+// resource, or left pending in a full pool, do not trigger a reconciliation.
+func NewHostPoolMonitor(instance *starlingxv1.HostPool, selector *hostPoolSelector, inventory *hostPoolInventory) *manager.Monitor {
+	logger := logHostPool.WithName("hostpool-monitor")
+
+	return &manager.Monitor{
+		MonitorBody: &hostPoolMonitor{
+			namespace:     instance.Namespace,
+			name:          instance.Name,
+			selector:      selector,
+			unprovisioned: inventory.unprovisioned,
+			members:       len(inventory.members),
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultHostPoolMonitorInterval,
+	}
+}
+
+// SetManager implements the MonitorManager interface so that the monitor can
+// list the host resources of the pool.
+func (m *hostPoolMonitor) SetManager(manager manager.CloudManager) {
+	m.manager = manager
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *hostPoolMonitor) Run(platformClient *gophercloud.ServiceClient) (stop bool, err error) {
+	resources := &starlingxv1.HostList{}
+	err = m.manager.GetKubernetesClient().List(context.TODO(), resources,
+		client.InNamespace(m.namespace),
+		client.MatchingLabels{starlingxv1.HostPoolLabel: m.name})
+	if err != nil {
+		m.SetState("failed to list host resources: %s", err.Error())
+		return false, err
+	}
+
+	if len(resources.Items) != m.members {
+		m.SetState("host pool %s now has %d member(s)", m.name, len(resources.Items))
+		return true, nil
+	}
+
+	objects, err := hosts.ListHosts(platformClient)
+	if err != nil {
+		m.SetState("failed to query host list: %s", err.Error())
+		return false, err
+	}
+
+	for _, h := range objects {
+		if h.Hostname != "" || h.BootMAC == "" || m.unprovisioned[normalizeMAC(h.BootMAC)] {
+			continue
+		}
+
+		if m.selector.Matches(&h) {
+			m.SetState("host %s is a new candidate for pool %s", h.BootMAC, m.name)
+			return true, nil
+		}
+	}
+
+	m.SetState("waiting for new hosts to enroll in pool %s", m.name)
+
+	return false, nil
+}
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2023, 2026 Wind River Systems, Inc. */
 
 package manager
 
@@ -19,6 +19,8 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
 	perrors "github.com/pkg/errors"
 	common "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/identity"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -42,15 +44,24 @@ const (
 	ProjectNameKey                 = "OS_PROJECT_NAME"
 	InterfaceKey                   = "OS_INTERFACE"
 	DebugKey                       = "OS_DEBUG"
+
+	// PTPNotificationURLKey is the optional URL of the PTP notification
+	// service API of the system (e.g., http://127.0.0.1:8080).  The PTP
+	// operational state is only collected when it is defined.
+	PTPNotificationURLKey = "PTP_NOTIFICATION_URL"
 )
 
 const (
 	// Well-known openstack API attribute values for the system API
-	SystemEndpointName  = "sysinv"
-	SystemEndpointType  = "platform"
-	VimEndpointName     = "vim"
-	VimEndpointType     = "nfv"
-	KeystoneEndpointURL = "http://controller:5000/v3"
+	SystemEndpointName    = "sysinv"
+	SystemEndpointType    = "platform"
+	VimEndpointName       = "vim"
+	VimEndpointType       = "nfv"
+	DCManagerEndpointName = "dcmanager"
+	DCManagerEndpointType = "dcmanager"
+	KeystoneEndpointName  = "keystone"
+	KeystoneEndpointType  = "identity"
+	KeystoneEndpointURL   = "http://controller:5000/v3"
 )
 
 // Builds the client authentication options from a given secret which should
@@ -381,6 +392,59 @@ func (m *PlatformManager) BuildPlatformClient(namespace string, endpointName str
 			err = perrors.Wrap(err, "failed to test vim client connection")
 			return nil, err
 		}
+	case DCManagerEndpointName:
+		// Test the client because the authentication endpoint is different from
+		// the resource endpoint therefore there is no guarantee that it works.
+		_, err = subclouds.List(c).Extract()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to test dcmanager client connection")
+			return nil, err
+		}
+	case KeystoneEndpointName:
+		// Test the client because the authentication endpoint is different from
+		// the resource endpoint therefore there is no guarantee that it works.
+		_, err = identity.ListRoles(c).Extract()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to test keystone client connection")
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// BuildPTPNotificationClient builds a client for the PTP notification service
+// API of the system.  The service does not require authentication, so the
+// client is built from the URL stored in the system endpoint secret.  A nil
+// client is returned if the URL is not defined.
+func (m *PlatformManager) BuildPTPNotificationClient(namespace string) (*gophercloud.ServiceClient, error) {
+	secret := &v1.Secret{}
+	secretName := types.NamespacedName{Namespace: namespace, Name: SystemEndpointSecretName}
+
+	err := m.GetClient().Get(context.TODO(), secretName, secret)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to find system endpoint secret")
+		return nil, err
+	}
+
+	endpoint := strings.TrimSpace(string(secret.Data[PTPNotificationURLKey]))
+	if endpoint == "" {
+		return nil, nil
+	}
+
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+
+	c := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       endpoint,
+		ResourceBase:   endpoint}
+
+	debug, err := strconv.ParseBool(string(secret.Data[DebugKey]))
+	if err == nil && debug {
+		// Debug is enabled so log all API requests/responses
+		c.HTTPClient.Transport = &clients.LogRoundTripper{Rt: http.DefaultTransport}
 	}
 
 	return c, nil
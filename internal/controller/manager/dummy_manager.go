@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2024 Wind River Systems, Inc. */
+/* Copyright(c) 2024, 2026 Wind River Systems, Inc. */
 
 package manager
 
@@ -42,6 +42,10 @@ type Dummymanager struct {
 
 	MonitorStarted bool   // Track if StartMonitor was called
 	MonitorMessage string // Track the message passed to StartMonitor
+
+	UpgradeInProgress bool // Simulate an upgrade being orchestrated
+
+	PTPNotificationClient *gophercloud.ServiceClient // Simulate the PTP notification service
 }
 
 func (m *Dummymanager) ResetPlatformClient(namespace string) error {
@@ -58,6 +62,9 @@ func (m *Dummymanager) BuildPlatformClient(namespace string, endpointName string
 	c := &gophercloud.ServiceClient{}
 	return c, nil
 }
+func (m *Dummymanager) BuildPTPNotificationClient(namespace string) (*gophercloud.ServiceClient, error) {
+	return m.PTPNotificationClient, nil
+}
 func (m *Dummymanager) NotifySystemDependencies(namespace string) error {
 	return nil
 }
@@ -66,6 +73,12 @@ func (m *Dummymanager) NotifyResource(object client.Object) error {
 }
 func (m *Dummymanager) SetSystemReady(namespace string, value bool) {
 
+}
+func (m *Dummymanager) SetUpgradeInProgress(namespace string, value bool) {
+	m.UpgradeInProgress = value
+}
+func (m *Dummymanager) GetUpgradeInProgress(namespace string) bool {
+	return m.UpgradeInProgress
 }
 func (m *Dummymanager) GetSystemReady(namespace string) bool {
 	return true
@@ -93,6 +106,9 @@ func (m *Dummymanager) GetSystemInfo(namespace string, client *gophercloud.Servi
 }
 func (m *Dummymanager) SetResourceInfo(resourcetype string, personality string, resourcename string, reconciled bool, required string) {
 
+}
+func (m *Dummymanager) SetResourceMaintenance(resourcename string, maintenance bool) {
+
 }
 func (m *Dummymanager) GetStrategyRequiredList() map[string]*ResourceInfo {
 	return m.Resource
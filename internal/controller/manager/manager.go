@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2024, 2026 Wind River Systems, Inc. */
 
 package manager
 
@@ -48,8 +48,8 @@ const (
 )
 
 const (
-	ScopeBootstrap = "bootstrap"
-	ScopePrincipal = "principal"
+	ScopeBootstrap = v1.ScopeBootstrap
+	ScopePrincipal = v1.ScopePrincipal
 )
 
 // TODO: Assign these consts in platform network controller instead.
@@ -110,12 +110,15 @@ type CloudManager interface {
 	SetDefaultGetPlatformClient()
 	GetKubernetesClient() client.Client
 	BuildPlatformClient(namespace string, endpointName string, endpointType string) (*gophercloud.ServiceClient, error)
+	BuildPTPNotificationClient(namespace string) (*gophercloud.ServiceClient, error)
 	NotifySystemDependencies(namespace string) error
 	NotifyResource(object client.Object) error
 	SetSystemReady(namespace string, value bool)
 	GetSystemReady(namespace string) bool
 	SetSystemType(namespace string, value SystemType)
 	GetSystemType(namespace string) SystemType
+	SetUpgradeInProgress(namespace string, value bool)
+	GetUpgradeInProgress(namespace string) bool
 	StartMonitor(monitor *Monitor, message string) error
 	CancelMonitor(object client.Object)
 	GetHostByPersonality(namespace string, client *gophercloud.ServiceClient, personality string) (*v1.Host, *hosts.Host, error)
@@ -123,6 +126,7 @@ type CloudManager interface {
 
 	// Strategy related methods
 	SetResourceInfo(resourcetype string, personality string, resourcename string, reconciled bool, required string)
+	SetResourceMaintenance(resourcename string, maintenance bool)
 	GetStrategyRequiredList() map[string]*ResourceInfo
 	ListStrategyRequired() string
 	UpdateConfigVersion()
@@ -177,6 +181,7 @@ type SystemNamespace struct {
 	client     *gophercloud.ServiceClient
 	ready      bool
 	systemType SystemType
+	upgrading  bool
 }
 
 type SystemInfo struct {
@@ -207,6 +212,7 @@ type ResourceInfo struct {
 	Name             string
 	Reconciled       bool
 	StrategyRequired string
+	Maintenance      bool
 }
 
 type StrategyStatus struct {
@@ -600,6 +606,52 @@ func (m *PlatformManager) GetSystemType(namespace string) SystemType {
 	}
 }
 
+// SetUpgradeInProgress allows setting whether a platform upgrade is being
+// orchestrated for a given namespace.
+func (m *PlatformManager) SetUpgradeInProgress(namespace string, value bool) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if obj, ok := m.systems[namespace]; !ok {
+		m.systems[namespace] = &SystemNamespace{upgrading: value}
+	} else if obj.upgrading != value {
+		obj.upgrading = value
+		log.Info("upgrade in progress has been updated", "namespace", namespace, "value", value)
+	}
+}
+
+// GetUpgradeInProgress returns whether a platform upgrade is being
+// orchestrated for the specified namespace.  Controllers must not modify the
+// system configuration while an upgrade is in progress.  The flag set by the
+// PlatformUpgrade controller only covers the upgrade it is reconciling and is
+// lost on a restart therefore the status of every PlatformUpgrade of the
+// namespace is consulted as well.
+func (m *PlatformManager) GetUpgradeInProgress(namespace string) bool {
+	m.lock.Lock()
+	obj, ok := m.systems[namespace]
+	upgrading := ok && obj.upgrading
+	m.lock.Unlock()
+
+	if upgrading || m.Manager == nil {
+		return upgrading
+	}
+
+	upgrades := &v1.PlatformUpgradeList{}
+	err := m.GetClient().List(context.TODO(), upgrades, client.InNamespace(namespace))
+	if err != nil {
+		log.Error(err, "failed to list platform upgrades", "namespace", namespace)
+		return false
+	}
+
+	for _, upgrade := range upgrades.Items {
+		if upgrade.Status.InProgress {
+			return true
+		}
+	}
+
+	return false
+}
+
 // StartMonitor starts the specified monitor, generates an event, and then
 // return an error suitable to stop the reconciler from running until the
 // monitor has explicitly triggered a new reconcilable event.
@@ -728,6 +780,26 @@ func (m *PlatformManager) SetResourceInfo(resourcetype string, personality strin
 	}
 }
 
+// SetResourceMaintenance to exclude a resource under maintenance from the
+// strategy.  A resource leaving maintenance is marked as not reconciled so
+// that no strategy is sent until it has been fully reconciled again.
+func (m *PlatformManager) SetResourceMaintenance(resourcename string, maintenance bool) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	info, ok := m.strategyStatus.ResourceInfo[resourcename]
+	if !ok {
+		// Resources unknown to the strategy do not need to be excluded.
+		return
+	}
+
+	info.Maintenance = maintenance
+	if !maintenance {
+		info.Reconciled = false
+	}
+	log.Info("Resource maintenance is updated", "Resource Name", resourcename, "Maintenance", maintenance)
+}
+
 // GetStrategyRequiredList returns the current strategy required list
 func (m *PlatformManager) GetStrategyRequiredList() map[string]*ResourceInfo {
 	m.lock.Lock()
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2023, 2026 Wind River Systems, Inc. */
 
 package manager
 
@@ -244,6 +244,27 @@ func StrategyRequiredMonitor(management CloudManager) {
 	log.Info("StrategyRequiredMonitor ends")
 }
 
+// IsStrategyInProgress determines whether a VIM strategy is in a transient
+// state and therefore must be polled until it settles.
+func IsStrategyInProgress(state string) bool {
+	switch state {
+	case StrategyInitial, StrategyBuilding, StrategyApplying, StrategyAborting:
+		return true
+	}
+	return false
+}
+
+// IsStrategyFailed determines whether a VIM strategy has reached a terminal
+// state without being successfully applied.
+func IsStrategyFailed(state string) bool {
+	switch state {
+	case StrategyBuildFailed, StrategyBuildTimeout, StrategyApplyFailed, StrategyApplyTimeout,
+		StrategyAbortFailed, StrategyAbortTimeout, StrategyAborted:
+		return true
+	}
+	return false
+}
+
 func deleteStrategy(management CloudManager, c *gophercloud.ServiceClient) {
 	log.Info("Deleting strategy")
 	// Delete strategy
@@ -419,6 +440,12 @@ func ManageStrategy(management CloudManager) bool {
 	request.WorkerApplyType = "ignore"
 	request_needed := false
 	for _, r := range resource {
+		if r.Maintenance {
+			// Resource is under maintenance, leave it out of the strategy.
+			log.V(2).Info("Skipping resource under maintenance", "name", r.Name)
+			continue
+		}
+
 		if r.StrategyRequired == StrategyNotRequired && !r.Reconciled {
 			// Resource is under reconcile, wait until reconciled.
 			log.Info("Waiting reconciled", "name", r.Name)
@@ -459,6 +486,13 @@ func ManageStrategy(management CloudManager) bool {
 		}
 	}
 	if request_needed {
+		if management.GetUpgradeInProgress(management.GetNamespace()) {
+			// Only a single VIM strategy can exist at a time therefore
+			// wait for the upgrade strategy to be removed.
+			log.Info("Upgrade in progress. Wait")
+			return false
+		}
+
 		client := management.GetVimClient()
 		if client == nil {
 			log.Info("Vim client is not ready. Wait")
@@ -149,6 +149,23 @@ var _ = Describe("Monitor", func() {
 				Expect(dm.strategyCreated).To(BeFalse())
 			})
 		})
+		Context("when a host under maintenance is not reconciled", func() {
+			It("should ignore the host and return true", func() {
+				rsc := map[string]*ResourceInfo{
+					"worker-0": {
+						ResourceType:     ResourceHost,
+						Personality:      PersonalityWorker,
+						StrategyRequired: StrategyLockRequired,
+						Reconciled:       false,
+						Maintenance:      true,
+					},
+				}
+				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true}
+				got := ManageStrategy(dm)
+				Expect(got).To(BeTrue())
+				Expect(dm.strategyCreated).To(BeFalse())
+			})
+		})
 		Context("when reconcile is finished but no strategy required", func() {
 			It("should return false and strategy not created", func() {
 				rsc := map[string]*ResourceInfo{
@@ -182,6 +199,21 @@ var _ = Describe("Monitor", func() {
 				Expect(dm.strategyCreateRequest.StorageApplyType).To(Equal("ignore"))
 			})
 		})
+		Context("when lock is required while an upgrade is in progress", func() {
+			It("should return false and strategy not created", func() {
+				rsc := map[string]*ResourceInfo{
+					"controller-0": {
+						ResourceType:     ResourceSystem,
+						StrategyRequired: StrategyLockRequired,
+						Reconciled:       true,
+					},
+				}
+				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true, UpgradeInProgress: true}
+				got := ManageStrategy(dm)
+				Expect(got).To(BeFalse())
+				Expect(dm.strategyCreated).To(BeFalse())
+			})
+		})
 		Context("when lock is required for controller", func() {
 			It("should return false and strategy created and sent", func() {
 				rsc := map[string]*ResourceInfo{
@@ -289,4 +321,19 @@ var _ = Describe("Monitor", func() {
 			})
 		})
 	})
+
+	Describe("Check strategy state classification", func() {
+		It("should report transient states as in progress", func() {
+			Expect(IsStrategyInProgress(StrategyBuilding)).To(BeTrue())
+			Expect(IsStrategyInProgress(StrategyApplying)).To(BeTrue())
+			Expect(IsStrategyInProgress(StrategyReadyToApply)).To(BeFalse())
+			Expect(IsStrategyInProgress(StrategyApplied)).To(BeFalse())
+		})
+		It("should report terminal errors as failed", func() {
+			Expect(IsStrategyFailed(StrategyBuildFailed)).To(BeTrue())
+			Expect(IsStrategyFailed(StrategyAborted)).To(BeTrue())
+			Expect(IsStrategyFailed(StrategyApplied)).To(BeFalse())
+			Expect(IsStrategyFailed(StrategyApplying)).To(BeFalse())
+		})
+	})
 })
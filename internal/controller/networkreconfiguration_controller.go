@@ -0,0 +1,898 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/networkAddressPools"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/networks"
+	perrors "github.com/pkg/errors"
+	"github.com/samber/lo"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logNetworkReconfiguration = log.Log.WithName("controller").WithName("networkreconfiguration")
+
+const NetworkReconfigurationControllerName = "networkreconfiguration-controller"
+
+var _ reconcile.Reconciler = &NetworkReconfigurationReconciler{}
+
+// Defines the names of the checks evaluated before a network reconfiguration.
+const (
+	ReconfigurationCheckNetwork         = "platform-network"
+	ReconfigurationCheckNetworkType     = "network-type"
+	ReconfigurationCheckDeploymentScope = "deployment-scope"
+	ReconfigurationCheckAddressPools    = "address-pools"
+	ReconfigurationCheckDualStack       = "dual-stack"
+	ReconfigurationCheckPoolComplete    = "pool-complete"
+)
+
+// NetworkReconfigurationReconciler reconciles a NetworkReconfiguration object
+type NetworkReconfigurationReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// reconfigurationHost defines the state of a host which takes part in the
+// lock and unlock sequence of a network reconfiguration.
+type reconfigurationHost struct {
+	name             string
+	locked           bool
+	unlocked         bool
+	strategyRequired string
+}
+
+// isReconfigurableNetworkType returns whether the reconfiguration of a
+// platform network type is supported.
+func isReconfigurableNetworkType(networkType string) bool {
+	switch networkType {
+	case cloudManager.OAMNetworkType, cloudManager.MgmtNetworkType, cloudManager.AdminNetworkType:
+		return true
+	}
+	return false
+}
+
+// reconfigurationHostOrder returns the names of the hosts which must be
+// locked and unlocked to apply the reconfiguration of a network type, in the
+// order in which the orchestration strategy handles them: the standby
+// controller, the active controller, then the storage and worker hosts.
+func reconfigurationHostOrder(networkType string, simplex bool, objects []hosts.Host) []string {
+	rank := func(h hosts.Host) int {
+		if h.Capabilities.Personality != nil {
+			if strings.EqualFold(*h.Capabilities.Personality, cloudManager.StandbyController) {
+				return 0
+			} else if strings.EqualFold(*h.Capabilities.Personality, cloudManager.ActiveController) {
+				return 1
+			}
+		}
+		switch h.Personality {
+		case cloudManager.PersonalityController:
+			return 1
+		case cloudManager.PersonalityStorage:
+			return 2
+		}
+		return 3
+	}
+
+	candidates := make([]hosts.Host, 0)
+	for _, h := range objects {
+		if h.Hostname == "" {
+			continue
+		}
+
+		switch {
+		case networkType == cloudManager.MgmtNetworkType && !simplex:
+			candidates = append(candidates, h)
+		case networkType == cloudManager.MgmtNetworkType,
+			networkType == cloudManager.OAMNetworkType && !simplex:
+			if h.Personality == cloudManager.PersonalityController {
+				candidates = append(candidates, h)
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ri, rj := rank(candidates[i]), rank(candidates[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return candidates[i].Hostname < candidates[j].Hostname
+	})
+
+	result := make([]string, 0, len(candidates))
+	for _, h := range candidates {
+		result = append(result, h.Hostname)
+	}
+
+	return result
+}
+
+// reconfigurationSteps returns the ordered steps of the reconfiguration of a
+// network type.  These mirror the sequence followed by the host controller:
+// on AIO-SX the management network is reconfigured while the controller is
+// locked, on multinode systems the oam and management networks are updated
+// first and the affected hosts are then locked and unlocked by the
+// orchestration strategy, and all other cases are updated in place.
+func reconfigurationSteps(networkType string, simplex bool, hostOrder []string) []starlingxv1.ReconfigurationStep {
+	update := starlingxv1.ReconfigurationStep{
+		Name:        starlingxv1.ReconfigurationStepUpdatePools,
+		Description: "Update the system address pools of the network.",
+		State:       starlingxv1.ReconfigurationStepPending,
+	}
+
+	switch {
+	case networkType == cloudManager.MgmtNetworkType && simplex:
+		return []starlingxv1.ReconfigurationStep{
+			{
+				Name:        starlingxv1.ReconfigurationStepLockHosts,
+				Description: "Lock the controller so that its management network can be reconfigured.",
+				Hosts:       hostOrder,
+				State:       starlingxv1.ReconfigurationStepPending,
+			},
+			update,
+			{
+				Name:        starlingxv1.ReconfigurationStepUnlockHosts,
+				Description: "Unlock the controller to apply the new management network configuration.",
+				Hosts:       hostOrder,
+				State:       starlingxv1.ReconfigurationStepPending,
+			},
+		}
+
+	case len(hostOrder) > 0:
+		return []starlingxv1.ReconfigurationStep{
+			{
+				Name:        starlingxv1.ReconfigurationStepLockRequired,
+				Description: "Mark the hosts as requiring a lock so that the orchestration strategy includes them.",
+				Hosts:       hostOrder,
+				State:       starlingxv1.ReconfigurationStepPending,
+			},
+			update,
+			{
+				Name:        starlingxv1.ReconfigurationStepLockUnlockHosts,
+				Description: "Lock and unlock each host, in order, to apply the new network configuration.",
+				Hosts:       hostOrder,
+				State:       starlingxv1.ReconfigurationStepPending,
+			},
+		}
+	}
+
+	return []starlingxv1.ReconfigurationStep{update}
+}
+
+// evaluateReconfigurationSteps updates the state of each step from the
+// observed state of the system address pools and of the hosts.
+func evaluateReconfigurationSteps(steps []starlingxv1.ReconfigurationStep, poolsUpdated bool, states map[string]reconfigurationHost) {
+	allHosts := func(hostNames []string, fn func(reconfigurationHost) bool) bool {
+		for _, name := range hostNames {
+			if !fn(states[name]) {
+				return false
+			}
+		}
+		return true
+	}
+	anyHost := func(hostNames []string, fn func(reconfigurationHost) bool) bool {
+		for _, name := range hostNames {
+			if fn(states[name]) {
+				return true
+			}
+		}
+		return false
+	}
+	locked := func(h reconfigurationHost) bool { return h.locked }
+	unlocked := func(h reconfigurationHost) bool { return h.unlocked }
+	lockRequired := func(h reconfigurationHost) bool {
+		return h.strategyRequired == cloudManager.StrategyLockRequired
+	}
+	settled := func(h reconfigurationHost) bool {
+		return h.unlocked && h.strategyRequired != cloudManager.StrategyLockRequired
+	}
+
+	hostNames := make([]string, 0)
+	for _, step := range steps {
+		if len(step.Hosts) > 0 {
+			hostNames = step.Hosts
+			break
+		}
+	}
+
+	for i := range steps {
+		step := &steps[i]
+		state := starlingxv1.ReconfigurationStepPending
+
+		switch step.Name {
+		case starlingxv1.ReconfigurationStepLockHosts:
+			if poolsUpdated || allHosts(step.Hosts, locked) {
+				state = starlingxv1.ReconfigurationStepCompleted
+			} else if anyHost(step.Hosts, lockRequired) {
+				state = starlingxv1.ReconfigurationStepInProgress
+			}
+
+		case starlingxv1.ReconfigurationStepLockRequired:
+			if poolsUpdated || anyHost(step.Hosts, lockRequired) {
+				state = starlingxv1.ReconfigurationStepCompleted
+			}
+
+		case starlingxv1.ReconfigurationStepUpdatePools:
+			if poolsUpdated {
+				state = starlingxv1.ReconfigurationStepCompleted
+			} else if len(hostNames) > 0 && (allHosts(hostNames, locked) || anyHost(hostNames, lockRequired)) {
+				state = starlingxv1.ReconfigurationStepInProgress
+			}
+
+		case starlingxv1.ReconfigurationStepUnlockHosts:
+			if poolsUpdated {
+				state = starlingxv1.ReconfigurationStepInProgress
+				if allHosts(step.Hosts, unlocked) {
+					state = starlingxv1.ReconfigurationStepCompleted
+				}
+			}
+
+		case starlingxv1.ReconfigurationStepLockUnlockHosts:
+			if poolsUpdated {
+				state = starlingxv1.ReconfigurationStepInProgress
+				if allHosts(step.Hosts, settled) {
+					state = starlingxv1.ReconfigurationStepCompleted
+				}
+			}
+		}
+
+		step.State = state
+	}
+}
+
+// reconfigurationPhase returns the phase of a reconfiguration from the state
+// of its checks and steps.  The reconfiguration is only held while the system
+// address pools have not been updated yet.
+func reconfigurationPhase(paused bool, checks []starlingxv1.ReconfigurationCheck, steps []starlingxv1.ReconfigurationStep, poolsUpdated bool) string {
+	failed := false
+	for _, check := range checks {
+		if !check.Passed {
+			failed = true
+		}
+	}
+
+	completed, started := len(steps) > 0, false
+	for _, step := range steps {
+		if step.State != starlingxv1.ReconfigurationStepCompleted {
+			completed = false
+		}
+		if step.State != starlingxv1.ReconfigurationStepPending {
+			started = true
+		}
+	}
+
+	switch {
+	case completed && !failed:
+		return starlingxv1.ReconfigurationPhaseCompleted
+	case !poolsUpdated && paused:
+		return starlingxv1.ReconfigurationPhasePaused
+	case !poolsUpdated && failed:
+		return starlingxv1.ReconfigurationPhaseBlocked
+	case started:
+		return starlingxv1.ReconfigurationPhaseApplying
+	}
+
+	return starlingxv1.ReconfigurationPhasePending
+}
+
+// addressPoolMatches returns whether a system address pool already holds the
+// configuration of an AddressPool resource.
+func addressPoolMatches(pool *starlingxv1.AddressPool, current *addresspools.AddressPool) bool {
+	if current == nil {
+		return false
+	}
+
+	spec := pool.Spec
+	if !utils.IsIPAddressSame(spec.Subnet, current.Network) || spec.Prefix != current.Prefix {
+		return false
+	}
+
+	for _, pair := range [][2]*string{
+		{spec.FloatingAddress, &current.FloatingAddress},
+		{spec.Controller0Address, &current.Controller0Address},
+		{spec.Controller1Address, &current.Controller1Address},
+		{spec.Gateway, current.Gateway},
+	} {
+		if pair[0] == nil {
+			continue
+		}
+		if pair[1] == nil || !utils.IsIPAddressSame(*pair[0], *pair[1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// snapshotAddressPool records the system configuration of an address pool.
+func snapshotAddressPool(name string, current *addresspools.AddressPool) starlingxv1.AddressPoolSnapshot {
+	snapshot := starlingxv1.AddressPoolSnapshot{
+		Name:               name,
+		Subnet:             current.Network,
+		Prefix:             current.Prefix,
+		FloatingAddress:    current.FloatingAddress,
+		Controller0Address: current.Controller0Address,
+		Controller1Address: current.Controller1Address,
+	}
+
+	if current.Gateway != nil {
+		gateway := *current.Gateway
+		snapshot.Gateway = &gateway
+	}
+
+	return snapshot
+}
+
+// reconfigurationRollback returns the actions required to return a network
+// to the configuration recorded before its address pools were updated.
+func reconfigurationRollback(networkType string, simplex bool, hostOrder []string, previous []starlingxv1.AddressPoolSnapshot) []string {
+	if len(previous) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0)
+	for _, p := range previous {
+		var action strings.Builder
+		fmt.Fprintf(&action, "Restore AddressPool %s to subnet %s/%d", p.Name, p.Subnet, p.Prefix)
+		if p.FloatingAddress != "" {
+			fmt.Fprintf(&action, ", floating address %s", p.FloatingAddress)
+		}
+		if p.Controller0Address != "" {
+			fmt.Fprintf(&action, ", controller-0 address %s", p.Controller0Address)
+		}
+		if p.Controller1Address != "" {
+			fmt.Fprintf(&action, ", controller-1 address %s", p.Controller1Address)
+		}
+		if p.Gateway != nil {
+			fmt.Fprintf(&action, ", gateway %s", *p.Gateway)
+		}
+		result = append(result, action.String()+".")
+	}
+
+	hostList := strings.Join(hostOrder, ", ")
+	switch {
+	case networkType == cloudManager.MgmtNetworkType && simplex:
+		result = append(result, fmt.Sprintf(
+			"Keep %s locked until the address pool is restored, then unlock it.", hostList))
+	case len(hostOrder) > 0:
+		result = append(result, fmt.Sprintf(
+			"Once the address pool is restored, lock and unlock the hosts again in order: %s.", hostList))
+	default:
+		result = append(result, "The address pool is updated in place; no host needs to be locked.")
+	}
+
+	return result
+}
+
+// networkDualStackCheck verifies that the address pools associated to a
+// network hold at most one pool per address family and that a network which
+// is dual-stack on the system stays dual-stack.
+func networkDualStackCheck(pools []*starlingxv1.AddressPool, configured int) starlingxv1.ReconfigurationCheck {
+	check := starlingxv1.ReconfigurationCheck{Name: ReconfigurationCheckDualStack, Passed: true}
+
+	families := make(map[bool]string)
+	for _, pool := range pools {
+		ipv6 := utils.IsIPv6(pool.Spec.Subnet)
+		if other, ok := families[ipv6]; ok {
+			check.Passed = false
+			check.Message = fmt.Sprintf("address pools %s and %s belong to the same address family", other, pool.Name)
+			return check
+		}
+		families[ipv6] = pool.Name
+	}
+
+	if configured > len(families) {
+		check.Passed = false
+		check.Message = fmt.Sprintf("the system network has %d address pools but only %d are associated",
+			configured, len(families))
+		return check
+	}
+
+	if len(families) == cloudManager.NumDualStack {
+		check.Message = "dual-stack configuration is consistent"
+	} else {
+		check.Message = "single-stack configuration is consistent"
+	}
+
+	return check
+}
+
+// poolCompleteCheck verifies that each address pool defines the addresses
+// required by its network type.  The gateway is optional for every network
+// type since the system accepts an oam address pool without one.  System
+// address pools which are missing
+// controller addresses defined by their resource are reported since the
+// reconfiguration restores them.
+func poolCompleteCheck(networkType string, simplex bool, pools []*starlingxv1.AddressPool, current map[string]*addresspools.AddressPool) starlingxv1.ReconfigurationCheck {
+	check := starlingxv1.ReconfigurationCheck{Name: ReconfigurationCheckPoolComplete, Passed: true}
+
+	missing := make([]string, 0)
+	incomplete := make([]string, 0)
+	for _, pool := range pools {
+		spec := pool.Spec
+
+		required := map[string]*string{}
+		switch networkType {
+		case cloudManager.OAMNetworkType:
+			required["floatingAddress"] = spec.FloatingAddress
+			if !simplex {
+				required["controller0Address"] = spec.Controller0Address
+				required["controller1Address"] = spec.Controller1Address
+			}
+		case cloudManager.MgmtNetworkType:
+			required["floatingAddress"] = spec.FloatingAddress
+			required["controller0Address"] = spec.Controller0Address
+			required["controller1Address"] = spec.Controller1Address
+		}
+
+		names := make([]string, 0)
+		for name, value := range required {
+			if value == nil {
+				names = append(names, name)
+			}
+		}
+		if len(names) > 0 {
+			sort.Strings(names)
+			missing = append(missing, fmt.Sprintf("%s (%s)", pool.Name, strings.Join(names, ", ")))
+		}
+
+		if sys := current[pool.Name]; sys != nil {
+			if (spec.Controller0Address != nil && sys.Controller0Address == "") ||
+				(spec.Controller1Address != nil && sys.Controller1Address == "") {
+				incomplete = append(incomplete, pool.Name)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		check.Passed = false
+		check.Message = "missing required addresses: " + strings.Join(missing, "; ")
+	} else if len(incomplete) > 0 {
+		check.Message = "controller addresses missing from system address pools will be restored: " +
+			strings.Join(incomplete, ", ")
+	} else {
+		check.Message = "all required addresses are defined"
+	}
+
+	return check
+}
+
+// statusUpdateRequired is a utility function which determines whether an update
+// is required to the network reconfiguration status attribute.  Updating this
+// unnecessarily will result in an infinite reconciliation loop.
+func (r *NetworkReconfigurationReconciler) statusUpdateRequired(instance *starlingxv1.NetworkReconfiguration, original *starlingxv1.NetworkReconfigurationStatus) bool {
+	status := &instance.Status
+
+	status.InSync = status.Phase != nil && *status.Phase == starlingxv1.ReconfigurationPhaseCompleted
+
+	if status.InSync && !status.Reconciled {
+		// Record the fact that we have reached inSync at least once.
+		status.Reconciled = true
+	}
+
+	status.ObservedGeneration = instance.Generation
+
+	return !status.DeepEqual(original)
+}
+
+// systemAddressPools returns the system address pool matching each
+// AddressPool resource, keyed by the resource name.
+func systemAddressPools(platformClient *gophercloud.ServiceClient, pools []*starlingxv1.AddressPool) (map[string]*addresspools.AddressPool, error) {
+	objects, err := addresspools.ListAddressPools(platformClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list address pools")
+		return nil, err
+	}
+
+	result := make(map[string]*addresspools.AddressPool)
+	for _, pool := range pools {
+		var found *addresspools.AddressPool
+		if pool.Status.ID != nil {
+			found = utils.GetSystemAddrPoolByUUID(objects, *pool.Status.ID)
+		}
+		if found == nil {
+			found = utils.GetSystemAddrPoolByName(objects, pool.Name)
+		}
+		if found != nil {
+			result[pool.Name] = found
+		}
+	}
+
+	return result, nil
+}
+
+// systemNetworkPoolCount returns the number of address pools associated to a
+// network on the system.
+func systemNetworkPoolCount(platformClient *gophercloud.ServiceClient, network *starlingxv1.PlatformNetwork) (int, error) {
+	objects, err := networks.ListNetworks(platformClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list networks")
+		return 0, err
+	}
+
+	var found *networks.Network
+	if network.Status.ID != nil {
+		found = utils.GetSystemNetworkByUUID(objects, *network.Status.ID)
+	}
+	if found == nil {
+		found = utils.GetSystemNetworkByName(objects, network.Name)
+	}
+	if found == nil {
+		return 0, nil
+	}
+
+	associations, err := networkAddressPools.ListNetworkAddressPools(platformClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list network address pools")
+		return 0, err
+	}
+
+	count := 0
+	for _, association := range associations {
+		if association.NetworkUUID == found.UUID {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// ReconcileResource evaluates the checks and steps of a network
+// reconfiguration from the state of the platform network, its address pools
+// and the hosts, and publishes them in the resource status.  The state is
+// entirely derived from what is observed therefore the workflow resumes
+// where it stood after a restart of the deployment manager.
+func (r *NetworkReconfigurationReconciler) ReconcileResource(platformClient *gophercloud.ServiceClient, instance *starlingxv1.NetworkReconfiguration) error {
+	original := instance.Status.DeepCopy()
+	status := &instance.Status
+
+	checks := make([]starlingxv1.ReconfigurationCheck, 0)
+	steps := make([]starlingxv1.ReconfigurationStep, 0)
+	poolsUpdated := false
+
+	network := &starlingxv1.PlatformNetwork{}
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.PlatformNetwork}
+	err := r.Get(context.TODO(), key, network)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			err = perrors.Wrapf(err, "failed to get platform network: %s", key)
+			return err
+		}
+
+		checks = append(checks, starlingxv1.ReconfigurationCheck{
+			Name:    ReconfigurationCheckNetwork,
+			Message: fmt.Sprintf("platform network %s does not exist", instance.Spec.PlatformNetwork)})
+		network = nil
+	}
+
+	if network != nil {
+		status.NetworkType = network.Spec.Type
+
+		systemInfo, err := r.GetSystemInfo(instance.Namespace, platformClient)
+		if err != nil {
+			return err
+		}
+
+		simplex := systemInfo.SystemType == cloudManager.SystemTypeAllInOne &&
+			systemInfo.SystemMode == cloudManager.SystemModeSimplex
+
+		checks = append(checks, starlingxv1.ReconfigurationCheck{
+			Name:    ReconfigurationCheckNetworkType,
+			Passed:  isReconfigurableNetworkType(network.Spec.Type),
+			Message: fmt.Sprintf("network type %s", network.Spec.Type)})
+
+		checks = append(checks, starlingxv1.ReconfigurationCheck{
+			Name:    ReconfigurationCheckDeploymentScope,
+			Passed:  network.Status.DeploymentScope == cloudManager.ScopePrincipal,
+			Message: fmt.Sprintf("deployment scope %s", network.Status.DeploymentScope)})
+
+		pools := make([]*starlingxv1.AddressPool, 0)
+		missing := make([]string, 0)
+		for _, name := range network.Spec.AssociatedAddressPools {
+			pool := &starlingxv1.AddressPool{}
+			key := types.NamespacedName{Namespace: instance.Namespace, Name: name}
+			err = r.Get(context.TODO(), key, pool)
+			if err != nil {
+				if !errors.IsNotFound(err) {
+					err = perrors.Wrapf(err, "failed to get address pool: %s", key)
+					return err
+				}
+				missing = append(missing, name)
+				continue
+			}
+			pools = append(pools, pool)
+		}
+
+		poolCheck := starlingxv1.ReconfigurationCheck{Name: ReconfigurationCheckAddressPools, Passed: true,
+			Message: fmt.Sprintf("%d address pools associated", len(pools))}
+		if len(missing) > 0 {
+			poolCheck.Passed = false
+			poolCheck.Message = "missing address pools: " + strings.Join(missing, ", ")
+		} else if len(pools) == 0 {
+			poolCheck.Passed = false
+			poolCheck.Message = "no address pool is associated to the network"
+		}
+		checks = append(checks, poolCheck)
+
+		current, err := systemAddressPools(platformClient, pools)
+		if err != nil {
+			return err
+		}
+
+		configured, err := systemNetworkPoolCount(platformClient, network)
+		if err != nil {
+			return err
+		}
+
+		checks = append(checks, networkDualStackCheck(pools, configured))
+		checks = append(checks, poolCompleteCheck(network.Spec.Type, simplex, pools, current))
+
+		poolsUpdated = len(pools) > 0 && len(missing) == 0
+		for _, pool := range pools {
+			if !addressPoolMatches(pool, current[pool.Name]) {
+				poolsUpdated = false
+			}
+		}
+
+		objects, err := hosts.ListHosts(platformClient)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to list hosts")
+			return err
+		}
+
+		hostOrder := reconfigurationHostOrder(network.Spec.Type, simplex, objects)
+
+		states := make(map[string]reconfigurationHost)
+		for _, name := range hostOrder {
+			state := reconfigurationHost{name: name}
+
+			host := &starlingxv1.Host{}
+			key := types.NamespacedName{Namespace: instance.Namespace, Name: name}
+			err = r.Get(context.TODO(), key, host)
+			if err == nil {
+				admin := host.Status.AdministrativeState
+				state.locked = admin != nil && *admin == hosts.AdminLocked
+				state.unlocked = admin != nil && *admin == hosts.AdminUnlocked
+				state.strategyRequired = host.Status.StrategyRequired
+			} else if !errors.IsNotFound(err) {
+				err = perrors.Wrapf(err, "failed to get host: %s", key)
+				return err
+			}
+
+			states[name] = state
+		}
+
+		if isReconfigurableNetworkType(network.Spec.Type) {
+			steps = reconfigurationSteps(network.Spec.Type, simplex, hostOrder)
+			evaluateReconfigurationSteps(steps, poolsUpdated, states)
+		}
+
+		// The previous configuration is recorded before the system address
+		// pools are updated, and again whenever a new reconfiguration starts
+		// after a completed one.
+		if !poolsUpdated && (len(status.Previous) == 0 ||
+			(status.Phase != nil && *status.Phase == starlingxv1.ReconfigurationPhaseCompleted)) {
+			previous := make([]starlingxv1.AddressPoolSnapshot, 0)
+			for _, pool := range pools {
+				if sys := current[pool.Name]; sys != nil {
+					previous = append(previous, snapshotAddressPool(pool.Name, sys))
+				}
+			}
+			if len(previous) > 0 {
+				status.Previous = previous
+			}
+		}
+
+		status.Rollback = reconfigurationRollback(network.Spec.Type, simplex, hostOrder, status.Previous)
+	}
+
+	phase := reconfigurationPhase(instance.Spec.Paused, checks, steps, poolsUpdated)
+	if network == nil {
+		phase = starlingxv1.ReconfigurationPhaseBlocked
+	}
+
+	if status.Phase == nil || *status.Phase != phase {
+		r.NormalEvent(instance, common.ResourceUpdated, "reconfiguration phase has changed to: %s", phase)
+	}
+
+	status.Phase = &phase
+	status.Checks = checks
+	status.Steps = steps
+	status.CurrentStep = nil
+	for _, step := range steps {
+		if step.State != starlingxv1.ReconfigurationStepCompleted {
+			name := step.Name
+			status.CurrentStep = &name
+			break
+		}
+	}
+
+	if r.statusUpdateRequired(instance, original) {
+		logNetworkReconfiguration.Info("updating network reconfiguration", "status", instance.Status)
+
+		err = r.Client.Status().Update(context.TODO(), instance)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update status: %s",
+				instance.Name)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconfigurationChangedPredicate filters the host, platform network and
+// address pool events which can change the progress of a network
+// reconfiguration.  Those are the changes to their specification and the
+// status changes from which the checks and steps are evaluated; other status
+// updates, which are frequent for hosts, are ignored.
+var reconfigurationChangedPredicate = predicate.Or[client.Object](
+	predicate.GenerationChangedPredicate{},
+	predicate.Funcs{UpdateFunc: reconfigurationStateChanged})
+
+// reconfigurationStateChanged determines whether an update changed the
+// administrative state or the strategy requirement of a host, the deployment
+// scope of a platform network, or the synchronization of an address pool.
+func reconfigurationStateChanged(e event.UpdateEvent) bool {
+	switch before := e.ObjectOld.(type) {
+	case *starlingxv1.Host:
+		after, ok := e.ObjectNew.(*starlingxv1.Host)
+		if !ok {
+			return false
+		}
+		return lo.FromPtr(before.Status.AdministrativeState) != lo.FromPtr(after.Status.AdministrativeState) ||
+			before.Status.StrategyRequired != after.Status.StrategyRequired
+
+	case *starlingxv1.PlatformNetwork:
+		after, ok := e.ObjectNew.(*starlingxv1.PlatformNetwork)
+		if !ok {
+			return false
+		}
+		return before.Status.DeploymentScope != after.Status.DeploymentScope
+
+	case *starlingxv1.AddressPool:
+		after, ok := e.ObjectNew.(*starlingxv1.AddressPool)
+		if !ok {
+			return false
+		}
+		return before.Status.InSync != after.Status.InSync ||
+			before.Status.Reconciled != after.Status.Reconciled
+	}
+
+	return false
+}
+
+// reconfigurationsForObject maps a change to a host, platform network or
+// address pool to a reconcile request for every network reconfiguration of
+// its namespace so that their progress is refreshed.
+func (r *NetworkReconfigurationReconciler) reconfigurationsForObject(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &starlingxv1.NetworkReconfigurationList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		logNetworkReconfiguration.Error(err, "failed to list network reconfigurations", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: item.Namespace, Name: item.Name}})
+	}
+
+	return requests
+}
+
+// Reconcile reads that state of the cluster for a NetworkReconfiguration object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=networkreconfigurations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=networkreconfigurations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=hosts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformnetworks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=addresspools,verbs=get;list;watch
+func (r *NetworkReconfigurationReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logNetworkReconfiguration
+	logNetworkReconfiguration = logNetworkReconfiguration.WithName(request.String())
+	defer func() { logNetworkReconfiguration = savedLog }()
+
+	// Fetch the NetworkReconfiguration instance
+	instance := &starlingxv1.NetworkReconfiguration{}
+	err := r.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logNetworkReconfiguration.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	if !utils.IsReconcilerEnabled(utils.NetworkReconfiguration) {
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	if !r.GetSystemReady(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
+	err = r.ReconcileResource(platformClient, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NetworkReconfigurationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logNetworkReconfiguration}
+	r.ReconcilerEventLogger = &common.EventLogger{
+		EventRecorder: mgr.GetEventRecorderFor(NetworkReconfigurationControllerName),
+		Logger:        logNetworkReconfiguration}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.NetworkReconfiguration{}).
+		Watches(&starlingxv1.Host{}, handler.EnqueueRequestsFromMapFunc(r.reconfigurationsForObject),
+			builder.WithPredicates(reconfigurationChangedPredicate)).
+		Watches(&starlingxv1.PlatformNetwork{}, handler.EnqueueRequestsFromMapFunc(r.reconfigurationsForObject),
+			builder.WithPredicates(reconfigurationChangedPredicate)).
+		Watches(&starlingxv1.AddressPool{}, handler.EnqueueRequestsFromMapFunc(r.reconfigurationsForObject),
+			builder.WithPredicates(reconfigurationChangedPredicate)).
+		Complete(r)
+}
@@ -0,0 +1,230 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package controller
+
+import (
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresspools"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+)
+
+var _ = Describe("NetworkReconfiguration controller", func() {
+	strPtr := func(s string) *string { return &s }
+
+	stepStates := func(steps []starlingxv1.ReconfigurationStep) []string {
+		result := make([]string, 0, len(steps))
+		for _, step := range steps {
+			result = append(result, step.State)
+		}
+		return result
+	}
+
+	Describe("reconfigurationHostOrder", func() {
+		objects := []hosts.Host{
+			{Hostname: "worker-1", Personality: cloudManager.PersonalityWorker},
+			{Hostname: "controller-0", Personality: cloudManager.PersonalityController,
+				Capabilities: hosts.Capabilities{Personality: strPtr(cloudManager.ActiveController)}},
+			{Hostname: "storage-0", Personality: cloudManager.PersonalityStorage},
+			{Hostname: "worker-0", Personality: cloudManager.PersonalityWorker},
+			{Hostname: "controller-1", Personality: cloudManager.PersonalityController,
+				Capabilities: hosts.Capabilities{Personality: strPtr(cloudManager.StandbyController)}},
+		}
+
+		It("should order every host for the management network", func() {
+			Expect(reconfigurationHostOrder(cloudManager.MgmtNetworkType, false, objects)).To(Equal(
+				[]string{"controller-1", "controller-0", "storage-0", "worker-0", "worker-1"}))
+		})
+
+		It("should only include the controllers for the oam network", func() {
+			Expect(reconfigurationHostOrder(cloudManager.OAMNetworkType, false, objects)).To(Equal(
+				[]string{"controller-1", "controller-0"}))
+		})
+
+		It("should not include any host for the oam network on AIO-SX", func() {
+			Expect(reconfigurationHostOrder(cloudManager.OAMNetworkType, true, objects[1:2])).To(BeEmpty())
+		})
+	})
+
+	Describe("evaluateReconfigurationSteps", func() {
+		hostOrder := []string{"controller-1", "controller-0"}
+
+		It("should follow the lock and unlock sequence on multinode systems", func() {
+			steps := reconfigurationSteps(cloudManager.OAMNetworkType, false, hostOrder)
+			Expect(steps).To(HaveLen(3))
+
+			states := map[string]reconfigurationHost{
+				"controller-0": {unlocked: true},
+				"controller-1": {unlocked: true},
+			}
+			evaluateReconfigurationSteps(steps, false, states)
+			Expect(stepStates(steps)).To(Equal([]string{
+				starlingxv1.ReconfigurationStepPending,
+				starlingxv1.ReconfigurationStepPending,
+				starlingxv1.ReconfigurationStepPending}))
+
+			states["controller-0"] = reconfigurationHost{unlocked: true, strategyRequired: cloudManager.StrategyLockRequired}
+			evaluateReconfigurationSteps(steps, true, states)
+			Expect(stepStates(steps)).To(Equal([]string{
+				starlingxv1.ReconfigurationStepCompleted,
+				starlingxv1.ReconfigurationStepCompleted,
+				starlingxv1.ReconfigurationStepInProgress}))
+
+			states["controller-0"] = reconfigurationHost{unlocked: true}
+			evaluateReconfigurationSteps(steps, true, states)
+			Expect(stepStates(steps)).To(Equal([]string{
+				starlingxv1.ReconfigurationStepCompleted,
+				starlingxv1.ReconfigurationStepCompleted,
+				starlingxv1.ReconfigurationStepCompleted}))
+		})
+
+		It("should wait for the controller to be locked on AIO-SX", func() {
+			steps := reconfigurationSteps(cloudManager.MgmtNetworkType, true, []string{"controller-0"})
+			Expect(steps[0].Name).To(Equal(starlingxv1.ReconfigurationStepLockHosts))
+
+			states := map[string]reconfigurationHost{"controller-0": {locked: true}}
+			evaluateReconfigurationSteps(steps, false, states)
+			Expect(stepStates(steps)).To(Equal([]string{
+				starlingxv1.ReconfigurationStepCompleted,
+				starlingxv1.ReconfigurationStepInProgress,
+				starlingxv1.ReconfigurationStepPending}))
+		})
+
+		It("should only update the address pools of the admin network", func() {
+			steps := reconfigurationSteps(cloudManager.AdminNetworkType, false, nil)
+			Expect(steps).To(HaveLen(1))
+			Expect(steps[0].Name).To(Equal(starlingxv1.ReconfigurationStepUpdatePools))
+		})
+	})
+
+	Describe("reconfigurationPhase", func() {
+		passed := []starlingxv1.ReconfigurationCheck{{Name: ReconfigurationCheckDualStack, Passed: true}}
+		failed := []starlingxv1.ReconfigurationCheck{{Name: ReconfigurationCheckDualStack}}
+		pending := []starlingxv1.ReconfigurationStep{{State: starlingxv1.ReconfigurationStepPending}}
+		started := []starlingxv1.ReconfigurationStep{{State: starlingxv1.ReconfigurationStepInProgress}}
+		completed := []starlingxv1.ReconfigurationStep{{State: starlingxv1.ReconfigurationStepCompleted}}
+
+		It("should report the phase from the checks and steps", func() {
+			Expect(reconfigurationPhase(false, passed, pending, false)).To(Equal(starlingxv1.ReconfigurationPhasePending))
+			Expect(reconfigurationPhase(false, passed, started, false)).To(Equal(starlingxv1.ReconfigurationPhaseApplying))
+			Expect(reconfigurationPhase(false, failed, pending, false)).To(Equal(starlingxv1.ReconfigurationPhaseBlocked))
+			Expect(reconfigurationPhase(true, passed, started, false)).To(Equal(starlingxv1.ReconfigurationPhasePaused))
+			Expect(reconfigurationPhase(false, passed, completed, true)).To(Equal(starlingxv1.ReconfigurationPhaseCompleted))
+		})
+
+		It("should not hold a reconfiguration once the address pools are updated", func() {
+			Expect(reconfigurationPhase(true, failed, started, true)).To(Equal(starlingxv1.ReconfigurationPhaseApplying))
+		})
+	})
+
+	Describe("addressPoolMatches", func() {
+		pool := &starlingxv1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "oam-ipv4"},
+			Spec: starlingxv1.AddressPoolSpec{
+				Subnet:          "10.10.10.0",
+				Prefix:          24,
+				FloatingAddress: strPtr("10.10.10.2"),
+				Gateway:         strPtr("10.10.10.1"),
+			},
+		}
+
+		It("should compare the subnet and the defined addresses", func() {
+			current := &addresspools.AddressPool{
+				Network:         "10.10.10.0",
+				Prefix:          24,
+				FloatingAddress: "10.10.10.2",
+				Gateway:         strPtr("10.10.10.1"),
+			}
+			Expect(addressPoolMatches(pool, current)).To(BeTrue())
+
+			current.Prefix = 16
+			Expect(addressPoolMatches(pool, current)).To(BeFalse())
+
+			current.Prefix = 24
+			current.Gateway = nil
+			Expect(addressPoolMatches(pool, current)).To(BeFalse())
+			Expect(addressPoolMatches(pool, nil)).To(BeFalse())
+		})
+	})
+
+	Describe("networkDualStackCheck", func() {
+		ipv4 := &starlingxv1.AddressPool{ObjectMeta: metav1.ObjectMeta{Name: "mgmt-ipv4"},
+			Spec: starlingxv1.AddressPoolSpec{Subnet: "192.168.204.0"}}
+		ipv6 := &starlingxv1.AddressPool{ObjectMeta: metav1.ObjectMeta{Name: "mgmt-ipv6"},
+			Spec: starlingxv1.AddressPoolSpec{Subnet: "fd01::"}}
+		other := &starlingxv1.AddressPool{ObjectMeta: metav1.ObjectMeta{Name: "mgmt-other"},
+			Spec: starlingxv1.AddressPoolSpec{Subnet: "192.168.206.0"}}
+
+		It("should reject two pools of the same family", func() {
+			Expect(networkDualStackCheck([]*starlingxv1.AddressPool{ipv4, other}, 1).Passed).To(BeFalse())
+		})
+
+		It("should reject dropping a family of a dual-stack network", func() {
+			Expect(networkDualStackCheck([]*starlingxv1.AddressPool{ipv4}, 2).Passed).To(BeFalse())
+			Expect(networkDualStackCheck([]*starlingxv1.AddressPool{ipv4, ipv6}, 2).Passed).To(BeTrue())
+		})
+	})
+
+	Describe("poolCompleteCheck", func() {
+		It("should not require a gateway for the oam network", func() {
+			pool := &starlingxv1.AddressPool{ObjectMeta: metav1.ObjectMeta{Name: "oam-ipv4"},
+				Spec: starlingxv1.AddressPoolSpec{
+					Subnet:             "10.10.20.0",
+					FloatingAddress:    strPtr("10.10.20.2"),
+					Controller0Address: strPtr("10.10.20.3"),
+					Controller1Address: strPtr("10.10.20.4"),
+				}}
+
+			check := poolCompleteCheck(cloudManager.OAMNetworkType, false, []*starlingxv1.AddressPool{pool}, nil)
+			Expect(check.Passed).To(BeTrue())
+
+			pool.Spec.Controller1Address = nil
+			check = poolCompleteCheck(cloudManager.OAMNetworkType, false, []*starlingxv1.AddressPool{pool}, nil)
+			Expect(check.Passed).To(BeFalse())
+			Expect(check.Message).To(Equal("missing required addresses: oam-ipv4 (controller1Address)"))
+		})
+	})
+
+	Describe("reconfigurationChangedPredicate", func() {
+		It("should ignore host status updates which do not affect the reconfiguration", func() {
+			unlocked := hosts.AdminUnlocked
+			before := &starlingxv1.Host{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+			before.Status.AdministrativeState = &unlocked
+			after := before.DeepCopy()
+			after.Status.InSync = true
+
+			Expect(reconfigurationChangedPredicate.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after})).To(BeFalse())
+
+			locked := hosts.AdminLocked
+			after.Status.AdministrativeState = &locked
+			Expect(reconfigurationChangedPredicate.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after})).To(BeTrue())
+
+			after = before.DeepCopy()
+			after.Status.StrategyRequired = cloudManager.StrategyLockRequired
+			Expect(reconfigurationChangedPredicate.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after})).To(BeTrue())
+		})
+	})
+
+	Describe("reconfigurationRollback", func() {
+		It("should restore the pools before locking and unlocking the hosts", func() {
+			previous := []starlingxv1.AddressPoolSnapshot{{
+				Name:            "oam-ipv4",
+				Subnet:          "10.10.10.0",
+				Prefix:          24,
+				FloatingAddress: "10.10.10.2",
+				Gateway:         strPtr("10.10.10.1"),
+			}}
+
+			Expect(reconfigurationRollback(cloudManager.OAMNetworkType, false, []string{"controller-1", "controller-0"}, previous)).To(Equal([]string{
+				"Restore AddressPool oam-ipv4 to subnet 10.10.10.0/24, floating address 10.10.10.2, gateway 10.10.10.1.",
+				"Once the address pool is restored, lock and unlock the hosts again in order: controller-1, controller-0.",
+			}))
+			Expect(reconfigurationRollback(cloudManager.OAMNetworkType, false, nil, nil)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,646 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/applications"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logPlatformApplication = log.Log.WithName("controller").WithName("platformapplication")
+
+const PlatformApplicationControllerName = "platformapplication-controller"
+
+const PlatformApplicationFinalizerName = "platformapplication.finalizers.windriver.com"
+
+var _ reconcile.Reconciler = &PlatformApplicationReconciler{}
+
+// PlatformApplicationReconciler reconciles a PlatformApplication object
+type PlatformApplicationReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+}
+
+// overridesEqual is a utility function which compares two sets of Helm
+// override values.  The system normalizes the YAML documents that it stores
+// therefore the values are compared semantically rather than textually.
+func overridesEqual(a string, b string) bool {
+	var x, y interface{}
+
+	if strings.TrimSpace(a) == strings.TrimSpace(b) {
+		return true
+	}
+
+	if err := yaml.Unmarshal([]byte(a), &x); err != nil {
+		return false
+	}
+
+	if err := yaml.Unmarshal([]byte(b), &y); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(x, y)
+}
+
+// checkPlatformDependencies ensures that the System and Host resources of the
+// namespace of a resource are in sync.  It is used by the reconcilers of
+// resources that must not act on a partially configured platform.
+func checkPlatformDependencies(c client.Client, events common.ReconcilerEventLogger, instance client.Object) error {
+	opts := client.ListOptions{Namespace: instance.GetNamespace()}
+
+	systems := &starlingxv1.SystemList{}
+	if err := c.List(context.TODO(), systems, &opts); err != nil {
+		return err
+	}
+
+	for _, s := range systems.Items {
+		if !s.Status.InSync {
+			msg := fmt.Sprintf("waiting for system %q to be in sync", s.Name)
+			events.NormalEvent(instance, common.ResourceDependency, msg)
+			return common.NewResourceStatusDependency(msg)
+		}
+	}
+
+	hosts := &starlingxv1.HostList{}
+	if err := c.List(context.TODO(), hosts, &opts); err != nil {
+		return err
+	}
+
+	for _, h := range hosts.Items {
+		if !h.Status.InSync {
+			msg := fmt.Sprintf("waiting for host %q to be in sync", h.Name)
+			events.NormalEvent(instance, common.ResourceDependency, msg)
+			return common.NewResourceStatusDependency(msg)
+		}
+	}
+
+	return nil
+}
+
+// checkDependencies ensures that the System and Host resources of the
+// namespace are in sync before any application lifecycle operation is
+// requested since applications depend on the platform configuration (e.g.,
+// labels, storage backends, and file systems).
+func (r *PlatformApplicationReconciler) checkDependencies(instance *starlingxv1.PlatformApplication) error {
+	return checkPlatformDependencies(r.Client, r.ReconcilerEventLogger, instance)
+}
+
+// waitForApplication launches a monitor which triggers a new reconciliation
+// once the application has finished its current lifecycle operation.
+func (r *PlatformApplicationReconciler) waitForApplication(instance *starlingxv1.PlatformApplication, app *applications.Application) error {
+	msg := fmt.Sprintf("waiting for application to finish %s", app.Status)
+	return r.StartMonitor(NewApplicationMonitor(instance), msg)
+}
+
+// ReconcileNew is a method which handles reconciling a new platform
+// application by uploading it to the system thru the system API.
+func (r *PlatformApplicationReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformApplication) (*applications.Application, error) {
+	if instance.Spec.Tarball == nil {
+		msg := "application is not known to the system and no tarball was provided"
+		return nil, common.NewUserDataError(msg)
+	}
+
+	opts := applications.UploadOpts{
+		Name:    &instance.Name,
+		Version: instance.Spec.Version,
+		Tarball: *instance.Spec.Tarball,
+	}
+
+	logPlatformApplication.Info("uploading application", "opts", opts)
+
+	app, err := applications.Upload(client, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to upload: %s", common.FormatStruct(opts))
+		return nil, err
+	}
+
+	r.NormalEvent(instance, common.ResourceCreated, "application upload has started")
+
+	return app, r.waitForApplication(instance, app)
+}
+
+// ReconcileOverrides is a method which sets the user Helm override values of
+// each chart listed in the resource.  It returns whether any of the charts
+// had to be updated.
+func (r *PlatformApplicationReconciler) ReconcileOverrides(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformApplication, delta *strings.Builder) (updated bool, err error) {
+	for _, o := range instance.Spec.Overrides {
+		current, err := applications.GetOverrides(client, instance.Name, o.Chart, o.Namespace).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to get overrides of chart: %s", o.Chart)
+			return updated, err
+		}
+
+		if overridesEqual(current.UserOverrides, o.Values) {
+			continue
+		}
+
+		fmt.Fprintf(delta, "\t+Overrides: %s/%s\n", o.Namespace, o.Chart)
+
+		opts := applications.OverridesOpts{
+			Flag:   applications.OverridesReset,
+			Values: applications.OverrideValues{Files: []string{o.Values}},
+		}
+
+		logPlatformApplication.Info("updating chart overrides", "chart", o.Chart, "namespace", o.Namespace)
+
+		_, err = applications.UpdateOverrides(client, instance.Name, o.Chart, o.Namespace, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update overrides of chart: %s", o.Chart)
+			return updated, err
+		}
+
+		updated = true
+	}
+
+	if updated {
+		r.NormalEvent(instance, common.ResourceUpdated, "application overrides have been updated")
+	}
+
+	return updated, nil
+}
+
+// ReconcileUpdated is a method which handles reconciling an existing platform
+// application and drives it thru the system API towards the desired state.
+// Only a single lifecycle operation is requested at a time; the reconciler
+// waits for each operation to complete before requesting the next one.
+func (r *PlatformApplicationReconciler) ReconcileUpdated(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformApplication, app *applications.Application) (*applications.Application, error) {
+	var delta strings.Builder
+
+	defer func() {
+		deltaString := delta.String()
+		if deltaString != "" {
+			deltaString = "\n" + strings.TrimSuffix(deltaString, "\n")
+			logPlatformApplication.Info(fmt.Sprintf("delta configuration:%s\n", deltaString))
+		}
+		instance.Status.Delta = deltaString
+	}()
+
+	if app.InProgress() {
+		return app, r.waitForApplication(instance, app)
+	}
+
+	desired := instance.DesiredState()
+	spec := instance.Spec
+
+	if app.Status == applications.StatusUploadFailed {
+		// A failed upload must be deleted before it can be attempted again.
+		err := applications.Delete(client, app.Name).ExtractErr()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to delete application")
+			return app, err
+		}
+
+		r.WarningEvent(instance, common.ResourceUpdated,
+			"application upload failed: %s", app.Progress)
+		return nil, common.NewUserDataError("application upload failed")
+	}
+
+	if desired != starlingxv1.ApplicationStateRemoved && spec.Version != nil && *spec.Version != app.Version {
+		fmt.Fprintf(&delta, "\t+Version: %s\n", *spec.Version)
+
+		if spec.Tarball == nil {
+			msg := fmt.Sprintf("a tarball is required to update the application to version %s", *spec.Version)
+			return app, common.NewUserDataError(msg)
+		}
+
+		if app.Status != applications.StatusApplied {
+			// Only applied applications can be updated so replace the
+			// uploaded application with the requested version instead.
+			err := applications.Delete(client, app.Name).ExtractErr()
+			if err != nil {
+				err = perrors.Wrap(err, "failed to delete application")
+				return app, err
+			}
+
+			return r.ReconcileNew(client, instance)
+		}
+
+		opts := applications.UploadOpts{
+			Name:    &instance.Name,
+			Version: spec.Version,
+			Tarball: *spec.Tarball,
+		}
+
+		logPlatformApplication.Info("updating application", "opts", opts)
+
+		result, err := applications.Update(client, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update: %s", common.FormatStruct(opts))
+			return app, err
+		}
+
+		r.NormalEvent(instance, common.ResourceUpdated, "application update has started")
+
+		return result, r.waitForApplication(instance, result)
+	}
+
+	overridesUpdated := false
+	if desired != starlingxv1.ApplicationStateRemoved {
+		var err error
+		overridesUpdated, err = r.ReconcileOverrides(client, instance, &delta)
+		if err != nil {
+			return app, err
+		}
+	}
+
+	switch desired {
+	case starlingxv1.ApplicationStateApplied:
+		if app.Status == applications.StatusApplied && !overridesUpdated {
+			return app, nil
+		}
+
+		if app.Status == applications.StatusApplyFailed && !overridesUpdated {
+			// Do not retry a failed apply until something has changed.
+			msg := fmt.Sprintf("application apply failed: %s", app.Progress)
+			return app, common.NewUserDataError(msg)
+		}
+
+		fmt.Fprintf(&delta, "\t+State: %s\n", desired)
+
+		logPlatformApplication.Info("applying application", "status", app.Status)
+
+		result, err := applications.Apply(client, app.Name).Extract()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to apply application")
+			return app, err
+		}
+
+		r.NormalEvent(instance, common.ResourceUpdated, "application apply has started")
+
+		return result, r.waitForApplication(instance, result)
+
+	case starlingxv1.ApplicationStateUploaded, starlingxv1.ApplicationStateRemoved:
+		switch app.Status {
+		case applications.StatusUploaded:
+			if desired == starlingxv1.ApplicationStateUploaded {
+				return app, nil
+			}
+
+			fmt.Fprintf(&delta, "\t+State: %s\n", desired)
+
+			err := applications.Delete(client, app.Name).ExtractErr()
+			if err != nil {
+				err = perrors.Wrap(err, "failed to delete application")
+				return app, err
+			}
+
+			r.NormalEvent(instance, common.ResourceDeleted, "application has been deleted")
+
+			return nil, nil
+
+		case applications.StatusRemoveFailed:
+			msg := fmt.Sprintf("application remove failed: %s", app.Progress)
+			return app, common.NewUserDataError(msg)
+		}
+
+		fmt.Fprintf(&delta, "\t+State: %s\n", desired)
+
+		logPlatformApplication.Info("removing application", "status", app.Status)
+
+		result, err := applications.Remove(client, app.Name).Extract()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to remove application")
+			return app, err
+		}
+
+		r.NormalEvent(instance, common.ResourceUpdated, "application remove has started")
+
+		return result, r.waitForApplication(instance, result)
+	}
+
+	return app, nil
+}
+
+// Removes the platform application finalizer
+func (r *PlatformApplicationReconciler) removePlatformApplicationFinalizer(instance *starlingxv1.PlatformApplication) {
+	// Remove the finalizer so the kubernetes delete operation can continue.
+	instance.Finalizers = utils.RemoveString(instance.Finalizers, PlatformApplicationFinalizerName)
+	if err := r.Update(context.Background(), instance); err != nil {
+		logPlatformApplication.Error(err, "failed to remove the finalizer in the platform application because of the error:%v")
+	}
+}
+
+// ReconciledDeleted is a method which handles reconciling a deleted platform
+// application by removing and then deleting the application thru the system
+// API.  The finalizer is only removed once the application no longer exists.
+func (r *PlatformApplicationReconciler) ReconciledDeleted(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformApplication, app *applications.Application) error {
+	if !utils.ContainsString(instance.Finalizers, PlatformApplicationFinalizerName) {
+		return nil
+	}
+
+	if app != nil {
+		if app.InProgress() {
+			return r.waitForApplication(instance, app)
+		}
+
+		switch app.Status {
+		case applications.StatusApplied, applications.StatusApplyFailed,
+			applications.StatusRemoveFailed, applications.StatusUpdateFailed:
+			result, err := applications.Remove(client, app.Name).Extract()
+			if err != nil {
+				err = perrors.Wrap(err, "failed to remove application")
+				return err
+			}
+
+			r.NormalEvent(instance, common.ResourceUpdated, "application remove has started")
+
+			return r.waitForApplication(instance, result)
+		}
+
+		err := applications.Delete(client, app.Name).ExtractErr()
+		if err != nil {
+			err = perrors.Wrap(err, "failed to delete application")
+			return err
+		}
+
+		r.NormalEvent(instance, common.ResourceDeleted, "application has been deleted")
+	}
+
+	r.removePlatformApplicationFinalizer(instance)
+
+	return nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an update
+// is required to the application status attribute.  Updating this unnecessarily
+// will result in an infinite reconciliation loop.
+func (r *PlatformApplicationReconciler) statusUpdateRequired(instance *starlingxv1.PlatformApplication, app *applications.Application, inSync bool) (result bool) {
+	status := &instance.Status
+
+	var appStatus, version, progress *string
+	if app != nil {
+		appStatus, version, progress = &app.Status, &app.Version, &app.Progress
+	}
+
+	if !reflect.DeepEqual(status.Status, appStatus) {
+		status.Status = appStatus
+		result = true
+	}
+
+	if !reflect.DeepEqual(status.Version, version) {
+		status.Version = version
+		result = true
+	}
+
+	if !reflect.DeepEqual(status.Progress, progress) {
+		status.Progress = progress
+		result = true
+	}
+
+	if status.InSync != inSync {
+		status.InSync = inSync
+		result = true
+	}
+
+	if status.InSync && !status.Reconciled {
+		// Record the fact that we have reached inSync at least once.
+		status.Reconciled = true
+		result = true
+	}
+
+	if status.ObservedGeneration != instance.Generation {
+		status.ObservedGeneration = instance.Generation
+		result = true
+	}
+
+	return result
+}
+
+// FindExistingResource attempts to find the application with a name matching
+// the resource name.
+func (r *PlatformApplicationReconciler) FindExistingResource(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformApplication) (*applications.Application, error) {
+	app, err := applications.Get(client, instance.Name).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+
+		err = perrors.Wrapf(err, "failed to get: %s", instance.Name)
+		return nil, err
+	}
+
+	return app, nil
+}
+
+// ReconcileResource interacts with the system API in order to reconcile the
+// state of a platform application with the state stored in the k8s database.
+func (r *PlatformApplicationReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformApplication) error {
+	app, err := r.FindExistingResource(client, instance)
+	if err != nil {
+		return err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.ReconciledDeleted(client, instance, app)
+	}
+
+	err = r.checkDependencies(instance)
+	if err == nil {
+		if app == nil {
+			if instance.DesiredState() != starlingxv1.ApplicationStateRemoved {
+				app, err = r.ReconcileNew(client, instance)
+			}
+		} else {
+			app, err = r.ReconcileUpdated(client, instance, app)
+		}
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, app, inSync) {
+		logPlatformApplication.Info("updating platform application", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a PlatformApplication object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformapplications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformapplications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformapplications/finalizers,verbs=update
+func (r *PlatformApplicationReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logPlatformApplication
+	logPlatformApplication = logPlatformApplication.WithName(request.String())
+	defer func() { logPlatformApplication = savedLog }()
+
+	// Fetch the PlatformApplication instance
+	instance := &starlingxv1.PlatformApplication{}
+	err := r.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logPlatformApplication.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system nor the resource is modified.
+		if !utils.IsReconcilerEnabled(utils.PlatformApplication) {
+			return reconcile.Result{}, nil
+		}
+
+		if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+			// Nothing is removed from the system when a resource is orphaned.
+			return reconcile.Result{}, nil
+		}
+
+		platformClient := r.GetPlatformClient(request.Namespace)
+		if platformClient == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		err = r.ReconcilePlan(platformClient, instance)
+		return reconcile.Result{}, err
+	}
+
+	if instance.DeletionTimestamp.IsZero() {
+		// Ensure that the object has a finalizer setup as a pre-delete hook so
+		// that we can delete any system resources that we previously added.
+		if !utils.ContainsString(instance.Finalizers, PlatformApplicationFinalizerName) {
+			instance.Finalizers = append(instance.Finalizers, PlatformApplicationFinalizerName)
+			if err := r.Update(context.Background(), instance); err != nil {
+				return reconcile.Result{}, err
+			}
+
+			// Might as well return immediately as the update is going to cause
+			// another reconcile event for this resource and we don't want to
+			// access the system API more than necessary.
+			return reconcile.Result{}, nil
+		}
+	}
+
+	if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+		// The resource is being released without removing it from the
+		// system so the only thing left to do is to drop the finalizer.
+		if utils.ContainsString(instance.Finalizers, PlatformApplicationFinalizerName) {
+			r.NormalEvent(instance, common.ResourceDeleted,
+				"platform application orphaned; system resource left in place")
+			r.removePlatformApplicationFinalizer(instance)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if !utils.IsReconcilerEnabled(utils.PlatformApplication) {
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !r.GetSystemReady(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
+	err = r.ReconcileResource(platformClient, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ReconcilePlan runs the platform application reconciliation against plan
+// mode clients and publishes the system API requests that it would have
+// issued in the resource status.
+func (r *PlatformApplicationReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformApplication) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logPlatformApplication)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy())
+
+	return p.Publish(r.Client, instance, result)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PlatformApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logPlatformApplication}
+	r.ReconcilerEventLogger = &common.EventLogger{
+		EventRecorder: mgr.GetEventRecorderFor(PlatformApplicationControllerName),
+		Logger:        logPlatformApplication}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.PlatformApplication{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.PlatformApplicationList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
+		Complete(r)
+}
@@ -0,0 +1,240 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/applications"
+)
+
+// applicationFixture records the lifecycle requests received by a fake
+// system API server.
+type applicationFixture struct {
+	requests  []string
+	overrides string
+}
+
+func newApplicationFixtureServer(fixture *applicationFixture) (*httptest.Server, *gophercloud.ServiceClient) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/apps/", func(w http.ResponseWriter, r *http.Request) {
+		fixture.requests = append(fixture.requests, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprint(w, `{"name": "rook-ceph", "app_version": "1.0-1", "status": "uploaded"}`)
+		case http.MethodPatch:
+			_, _ = fmt.Fprint(w, `{"name": "rook-ceph", "app_version": "1.0-1", "status": "applying"}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/helm_charts/", func(w http.ResponseWriter, r *http.Request) {
+		fixture.requests = append(fixture.requests, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name": "rook-ceph-cluster", "namespace": "rook-ceph", "user_overrides": %q}`,
+			fixture.overrides)
+	})
+
+	server := httptest.NewServer(mux)
+	sc := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{TokenID: "test-token"},
+		Endpoint:       server.URL + "/",
+	}
+	return server, sc
+}
+
+func newPlatformApplicationReconciler(dm *cloudManager.Dummymanager) *PlatformApplicationReconciler {
+	logger := log.Log.WithName("test")
+	return &PlatformApplicationReconciler{
+		Client:       k8sClient,
+		CloudManager: dm,
+		ReconcilerErrorHandler: &common.ErrorHandler{
+			CloudManager: dm,
+			Logger:       logger,
+		},
+		ReconcilerEventLogger: &common.EventLogger{
+			EventRecorder: record.NewFakeRecorder(100),
+			Logger:        logger,
+		},
+	}
+}
+
+var _ = Describe("PlatformApplication controller", func() {
+	var (
+		server     *httptest.Server
+		gcClient   *gophercloud.ServiceClient
+		fixture    *applicationFixture
+		dm         *cloudManager.Dummymanager
+		reconciler *PlatformApplicationReconciler
+		instance   *starlingxv1.PlatformApplication
+	)
+
+	BeforeEach(func() {
+		fixture = &applicationFixture{}
+		server, gcClient = newApplicationFixtureServer(fixture)
+		dm = &cloudManager.Dummymanager{}
+		reconciler = newPlatformApplicationReconciler(dm)
+		instance = &starlingxv1.PlatformApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "rook-ceph", Namespace: "default"},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("overridesEqual", func() {
+		It("should compare values semantically", func() {
+			Expect(overridesEqual("a: 1\nb: [x, y]\n", "b:\n- x\n- y\na: 1")).To(BeTrue())
+			Expect(overridesEqual("a: 1\n", "a: 2\n")).To(BeFalse())
+			Expect(overridesEqual("", "a: 1\n")).To(BeFalse())
+		})
+	})
+
+	Describe("ReconcileNew", func() {
+		It("should refuse to upload without a tarball", func() {
+			app, err := reconciler.ReconcileNew(gcClient, instance)
+			Expect(err).To(HaveOccurred())
+			Expect(app).To(BeNil())
+			Expect(fixture.requests).To(BeEmpty())
+		})
+	})
+
+	Describe("ReconcileUpdated", func() {
+		It("should wait for an operation in progress", func() {
+			app := &applications.Application{Name: "rook-ceph", Status: applications.StatusApplying}
+			_, err := reconciler.ReconcileUpdated(gcClient, instance, app)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dm.MonitorStarted).To(BeTrue())
+			Expect(fixture.requests).To(BeEmpty())
+		})
+
+		It("should set the overrides and apply an uploaded application", func() {
+			instance.Spec.Overrides = []starlingxv1.HelmOverrideInfo{
+				{Chart: "rook-ceph-cluster", Namespace: "rook-ceph", Values: "replicas: 2\n"},
+			}
+			app := &applications.Application{Name: "rook-ceph", Status: applications.StatusUploaded}
+
+			result, err := reconciler.ReconcileUpdated(gcClient, instance, app)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(applications.StatusApplying))
+			Expect(dm.MonitorStarted).To(BeTrue())
+			Expect(fixture.requests).To(Equal([]string{
+				"GET /helm_charts/rook-ceph-cluster?name=rook-ceph&namespace=rook-ceph",
+				"PATCH /helm_charts/rook-ceph-cluster?name=rook-ceph&namespace=rook-ceph",
+				"PATCH /apps/rook-ceph?directive=apply",
+			}))
+			Expect(instance.Status.Delta).To(ContainSubstring("+Overrides: rook-ceph/rook-ceph-cluster"))
+		})
+
+		It("should not touch an applied application with matching overrides", func() {
+			fixture.overrides = "replicas: 2\n"
+			instance.Spec.Overrides = []starlingxv1.HelmOverrideInfo{
+				{Chart: "rook-ceph-cluster", Namespace: "rook-ceph", Values: "replicas: 2"},
+			}
+			app := &applications.Application{Name: "rook-ceph", Status: applications.StatusApplied}
+
+			_, err := reconciler.ReconcileUpdated(gcClient, instance, app)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dm.MonitorStarted).To(BeFalse())
+			Expect(fixture.requests).To(HaveLen(1))
+			Expect(instance.Status.Delta).To(BeEmpty())
+		})
+
+		It("should remove an applied application", func() {
+			instance.Spec.State = starlingxv1.ApplicationStateUploaded
+			app := &applications.Application{Name: "rook-ceph", Status: applications.StatusApplied}
+
+			_, err := reconciler.ReconcileUpdated(gcClient, instance, app)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dm.MonitorStarted).To(BeTrue())
+			Expect(fixture.requests).To(Equal([]string{"PATCH /apps/rook-ceph?directive=remove"}))
+		})
+
+		It("should delete an uploaded application that must be removed", func() {
+			instance.Spec.State = starlingxv1.ApplicationStateRemoved
+			app := &applications.Application{Name: "rook-ceph", Status: applications.StatusUploaded}
+
+			result, err := reconciler.ReconcileUpdated(gcClient, instance, app)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(fixture.requests).To(Equal([]string{"DELETE /apps/rook-ceph"}))
+		})
+
+		It("should not retry a failed apply until something changes", func() {
+			app := &applications.Application{Name: "rook-ceph", Status: applications.StatusApplyFailed}
+
+			_, err := reconciler.ReconcileUpdated(gcClient, instance, app)
+			Expect(err).To(HaveOccurred())
+			Expect(fixture.requests).To(BeEmpty())
+		})
+
+		It("should require a tarball to update the version", func() {
+			version := "2.0-1"
+			instance.Spec.Version = &version
+			app := &applications.Application{Name: "rook-ceph", Version: "1.0-1", Status: applications.StatusApplied}
+
+			_, err := reconciler.ReconcileUpdated(gcClient, instance, app)
+			Expect(err).To(HaveOccurred())
+			Expect(fixture.requests).To(BeEmpty())
+		})
+	})
+
+	Describe("ReconcilePlan", func() {
+		It("should publish the lifecycle requests without sending them", func() {
+			namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:        "plan-applications",
+				Annotations: map[string]string{starlingxv1.PlanModeAnnotation: "true"},
+			}}
+			Expect(k8sClient.Create(ctx, namespace)).To(Succeed())
+
+			instance = &starlingxv1.PlatformApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "rook-ceph", Namespace: namespace.Name},
+				Spec: starlingxv1.PlatformApplicationSpec{
+					Overrides: []starlingxv1.HelmOverrideInfo{
+						{Chart: "rook-ceph-cluster", Namespace: "rook-ceph", Values: "replicas: 2\n"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() { Expect(k8sClient.Delete(ctx, instance)).To(Succeed()) }()
+
+			planMode, err := common.IsPlanModeEnabled(k8sClient, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(planMode).To(BeTrue())
+
+			Expect(reconciler.ReconcilePlan(gcClient, instance)).To(Succeed())
+
+			for _, request := range fixture.requests {
+				Expect(request).To(HavePrefix(http.MethodGet + " "))
+			}
+			Expect(dm.MonitorStarted).To(BeFalse())
+
+			updated := &starlingxv1.PlatformApplication{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(instance), updated)).To(Succeed())
+			Expect(updated.Finalizers).To(BeEmpty())
+			Expect(updated.Status.Plan).ToNot(BeNil())
+			Expect(updated.Status.Plan.Operations).To(HaveLen(2))
+			Expect(updated.Status.Plan.Operations[0].Method).To(Equal(http.MethodPatch))
+			Expect(updated.Status.Plan.Operations[0].Path).To(Equal("/helm_charts/rook-ceph-cluster"))
+			Expect(updated.Status.Plan.Operations[1].Method).To(Equal(http.MethodPatch))
+			Expect(updated.Status.Plan.Operations[1].Path).To(Equal("/apps/rook-ceph"))
+			Expect(updated.Status.Plan.Message).To(ContainSubstring("waiting for application to finish"))
+		})
+	})
+})
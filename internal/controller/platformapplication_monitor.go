@@ -0,0 +1,67 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/applications"
+)
+
+// DefaultApplicationMonitorInterval represents the default interval between
+// polling attempts to check whether an application lifecycle operation has
+// completed.  Uploading or applying an application usually takes minutes
+// therefore there is no need to poll this frequently.
+const DefaultApplicationMonitorInterval = 30 * time.Second
+
+// applicationMonitor waits for an application to reach a stable state.  Once
+// the lifecycle operation has completed, successfully or not, a reconcilable
+// event is generated to kick the reconciler.
+type applicationMonitor struct {
+	manager.CommonMonitorBody
+	name string
+}
+
+// NewApplicationMonitor defines a convenience function to instantiate a new
+// application monitor with all required attributes.
+func NewApplicationMonitor(instance *starlingxv1.PlatformApplication) *manager.Monitor {
+	logger := logPlatformApplication.WithName("application-monitor")
+	return &manager.Monitor{
+		MonitorBody: &applicationMonitor{
+			name: instance.Name,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultApplicationMonitorInterval,
+	}
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *applicationMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	app, err := applications.Get(client, m.name).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			m.SetState("application %q no longer exists", m.name)
+			return true, nil
+		}
+
+		m.SetState("failed to get application %q: %s", m.name, err.Error())
+		return false, err
+	}
+
+	if app.InProgress() {
+		m.SetState("waiting for application %q to leave the %s state: %s",
+			m.name, app.Status, app.Progress)
+		return false, nil
+	}
+
+	m.SetState("application %q has reached the %s state", m.name, app.Status)
+
+	return true, nil
+}
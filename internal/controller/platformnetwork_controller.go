@@ -14,11 +14,13 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -277,6 +279,30 @@ func (r *PlatformNetworkReconciler) Reconcile(ctx context.Context, request ctrl.
 		return reconcile.Result{}, err
 	}
 
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system nor the resource is modified.
+		if !utils.IsReconcilerEnabled(utils.PlatformNetwork) {
+			return reconcile.Result{}, nil
+		}
+
+		platformClient := r.GetPlatformClient(request.Namespace)
+		if platformClient == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		err = r.ReconcilePlan(platformClient, instance, request.Namespace, false)
+		return reconcile.Result{}, err
+	}
+
 	err, scopeUpdated := r.UpdateDeploymentScope(instance)
 	if err != nil {
 		return r.HandleReconcilerError(request, err)
@@ -329,12 +355,23 @@ func (r *PlatformNetworkReconciler) Reconcile(ctx context.Context, request ctrl.
 		return common.RetryMissingClient, nil
 	}
 
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if !r.GetSystemReady(request.Namespace) {
 		r.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
 		return common.RetrySystemNotReady, nil
 	}
 
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
 	if !r.IsNotifyingActiveHost() {
 		r.SetNotifyingActiveHost(true)
 		err = r.ReconcileResource(platformClient, instance, request.Namespace, scopeUpdated)
@@ -350,6 +387,22 @@ func (r *PlatformNetworkReconciler) Reconcile(ctx context.Context, request ctrl.
 	return ctrl.Result{}, nil
 }
 
+// ReconcilePlan runs the platform network reconciliation against plan mode
+// clients and publishes the system API requests that it would have issued
+// in the resource status.
+func (r *PlatformNetworkReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformNetwork, reqNs string, scopeUpdated bool) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logPlatformNetwork)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy(), reqNs, scopeUpdated)
+
+	return p.Publish(r.Client, instance, result)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *PlatformNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	tMgr := cloudManager.GetInstance(mgr)
@@ -364,5 +417,7 @@ func (r *PlatformNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Logger:        logPlatformNetwork}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.PlatformNetwork{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.PlatformNetworkList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
 		Complete(r)
 }
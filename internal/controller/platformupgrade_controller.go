@@ -0,0 +1,583 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/strategies"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logPlatformUpgrade = log.Log.WithName("controller").WithName("platformupgrade")
+
+const PlatformUpgradeControllerName = "platformupgrade-controller"
+
+const PlatformUpgradeFinalizerName = "platformupgrade.finalizers.windriver.com"
+
+// Defines the default attributes of an upgrade strategy.  They match the
+// defaults used by the StarlingX CLI.
+const (
+	DefaultUpgradeApplyType         = "serial"
+	DefaultUpgradeInstanceAction    = "stop-start"
+	DefaultUpgradeAlarmRestrictions = "strict"
+)
+
+var _ reconcile.Reconciler = &PlatformUpgradeReconciler{}
+
+// PlatformUpgradeReconciler reconciles a PlatformUpgrade object
+type PlatformUpgradeReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+	vimClients map[string]*gophercloud.ServiceClient
+}
+
+// strategyType returns the type of VIM strategy used to orchestrate an
+// upgrade.  The type of a strategy that is still running is taken from the
+// status so that a change to the spec does not orphan it; otherwise the type
+// required by the spec is returned.
+func strategyType(instance *starlingxv1.PlatformUpgrade) string {
+	if instance.Status.InProgress && instance.Status.StrategyType != nil {
+		return *instance.Status.StrategyType
+	}
+	if instance.Spec.KubernetesVersion != nil {
+		return strategies.KubernetesUpgrade
+	}
+	return strategies.SoftwareDeploy
+}
+
+// currentStrategyPhase returns the progress of the phase currently being
+// executed by a strategy.
+func currentStrategyPhase(strategy *strategies.Strategy) *strategies.Phase {
+	switch strategy.CurrentPhase {
+	case "apply":
+		return &strategy.ApplyPhase
+	case "abort":
+		return &strategy.AbortPhase
+	}
+	return &strategy.BuildPhase
+}
+
+// strategyCreateOpts is a utility function which builds the request to create
+// a new upgrade strategy from the attributes of the resource.
+func strategyCreateOpts(instance *starlingxv1.PlatformUpgrade) strategies.CreateOpts {
+	opts := strategies.CreateOpts{
+		StorageApplyType:      DefaultUpgradeApplyType,
+		WorkerApplyType:       DefaultUpgradeApplyType,
+		DefaultInstanceAction: DefaultUpgradeInstanceAction,
+		AlarmRestrictions:     DefaultUpgradeAlarmRestrictions,
+	}
+
+	if instance.Spec.KubernetesVersion != nil {
+		opts.ToVersion = *instance.Spec.KubernetesVersion
+	} else {
+		opts.ControllerApplyType = DefaultUpgradeApplyType
+		opts.Release = *instance.Spec.Release
+	}
+
+	if s := instance.Spec.Strategy; s != nil {
+		if s.ControllerApplyType != nil && opts.Release != "" {
+			opts.ControllerApplyType = *s.ControllerApplyType
+		}
+		if s.StorageApplyType != nil {
+			opts.StorageApplyType = *s.StorageApplyType
+		}
+		if s.WorkerApplyType != nil {
+			opts.WorkerApplyType = *s.WorkerApplyType
+		}
+		opts.MaxParallelWorkerHosts = s.MaxParallelWorkers
+		if s.DefaultInstanceAction != nil {
+			opts.DefaultInstanceAction = *s.DefaultInstanceAction
+		}
+		if s.AlarmRestrictions != nil {
+			opts.AlarmRestrictions = *s.AlarmRestrictions
+		}
+	}
+
+	return opts
+}
+
+// setStrategyStatus is a utility function which records the progress of a
+// strategy in the resource status.
+func setStrategyStatus(status *starlingxv1.PlatformUpgradeStatus, strategy *strategies.Strategy) {
+	phase := currentStrategyPhase(strategy)
+
+	state := strategy.State
+	status.StrategyState = &state
+	status.CurrentStage = phase.CurrentStage
+	status.TotalStages = phase.TotalStages
+	status.Percentage = phase.CompletionPercentage
+
+	if phase.Reason != "" {
+		reason := phase.Reason
+		status.Reason = &reason
+	}
+}
+
+// setUpgradePhase is a utility function which records the upgrade phase and
+// whether the upgrade is still being orchestrated.
+func setUpgradePhase(status *starlingxv1.PlatformUpgradeStatus, phase string) {
+	status.Phase = &phase
+	status.InProgress = phase == starlingxv1.UpgradePhaseBuilding || phase == starlingxv1.UpgradePhaseApplying
+}
+
+// getVimClient returns the VIM client of a namespace and builds it if it
+// does not exist yet.
+func (r *PlatformUpgradeReconciler) getVimClient(namespace string) (*gophercloud.ServiceClient, error) {
+	if c, ok := r.vimClients[namespace]; ok {
+		return c, nil
+	}
+
+	c, err := r.BuildPlatformClient(namespace, cloudManager.VimEndpointName, cloudManager.VimEndpointType)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.vimClients == nil {
+		r.vimClients = make(map[string]*gophercloud.ServiceClient)
+	}
+	r.vimClients[namespace] = c
+
+	return c, nil
+}
+
+// waitForStrategy launches a monitor which triggers a new reconciliation
+// once the strategy has made progress.
+func (r *PlatformUpgradeReconciler) waitForStrategy(instance *starlingxv1.PlatformUpgrade, kind string, strategy *strategies.Strategy) error {
+	msg := fmt.Sprintf("waiting for %s strategy to leave the %s state", kind, strategy.State)
+	return r.StartMonitor(NewUpgradeMonitor(instance, kind, strategy), msg)
+}
+
+// ReconcileNew is a method which handles starting a new upgrade by requesting
+// that the VIM build an upgrade strategy.
+func (r *PlatformUpgradeReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUpgrade) error {
+	kind := strategyType(instance)
+	status := &instance.Status
+
+	if status.Phase != nil && *status.Phase == starlingxv1.UpgradePhaseFailed &&
+		status.Target != nil && *status.Target == instance.Target() &&
+		status.ObservedGeneration == instance.Generation {
+		// Do not retry a failed upgrade until something has changed.
+		msg := fmt.Sprintf("upgrade to %s failed", instance.Target())
+		return common.NewUserDataError(msg)
+	}
+
+	err := checkPlatformDependencies(r.Client, r.ReconcilerEventLogger, instance)
+	if err != nil {
+		return err
+	}
+
+	opts := strategyCreateOpts(instance)
+
+	logPlatformUpgrade.Info("creating upgrade strategy", "type", kind, "opts", opts)
+
+	strategy, err := strategies.Create(client, kind, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to create %s strategy: %s", kind, common.FormatStruct(opts))
+		return err
+	}
+
+	target := instance.Target()
+	status.Target = &target
+	status.StrategyType = &kind
+	status.Reason = nil
+	status.CurrentStage, status.TotalStages, status.Percentage = 0, 0, 0
+	setUpgradePhase(status, starlingxv1.UpgradePhaseBuilding)
+
+	r.NormalEvent(instance, common.ResourceCreated,
+		"upgrade to %s has started", target)
+
+	if strategy == nil {
+		// The strategy is built asynchronously so monitor it from its
+		// initial state.
+		strategy = &strategies.Strategy{State: cloudManager.StrategyInitial}
+	}
+
+	setStrategyStatus(status, strategy)
+
+	return r.waitForStrategy(instance, kind, strategy)
+}
+
+// ReconcileStrategy is a method which drives an existing upgrade strategy
+// towards completion.  A strategy that is ready to be applied is applied,
+// a strategy that has settled is deleted to make room for future strategies,
+// and a strategy that is still in progress is monitored.
+func (r *PlatformUpgradeReconciler) ReconcileStrategy(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUpgrade, strategy *strategies.Strategy) error {
+	kind := strategyType(instance)
+	status := &instance.Status
+
+	if status.Target == nil {
+		// The strategy was not created by this resource (or the status was
+		// lost) therefore adopt it so that it is monitored to completion.
+		target := instance.Target()
+		status.Target = &target
+	}
+
+	if status.StrategyType == nil {
+		status.StrategyType = &kind
+	}
+
+	setStrategyStatus(status, strategy)
+
+	switch {
+	case strategy.State == cloudManager.StrategyReadyToApply:
+		logPlatformUpgrade.Info("applying upgrade strategy", "type", kind)
+
+		result, err := strategies.Action(client, kind, strategies.ActionOpts{Action: strategies.ActionApplyAll}).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to apply %s strategy", kind)
+			return err
+		}
+
+		setUpgradePhase(status, starlingxv1.UpgradePhaseApplying)
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"upgrade strategy apply has started")
+
+		if result != nil {
+			strategy = result
+			setStrategyStatus(status, strategy)
+		}
+
+		return r.waitForStrategy(instance, kind, strategy)
+
+	case cloudManager.IsStrategyInProgress(strategy.State):
+		if strategy.State == cloudManager.StrategyApplying {
+			setUpgradePhase(status, starlingxv1.UpgradePhaseApplying)
+		} else if !status.InProgress {
+			setUpgradePhase(status, starlingxv1.UpgradePhaseBuilding)
+		}
+
+		return r.waitForStrategy(instance, kind, strategy)
+
+	case strategy.State == cloudManager.StrategyApplied:
+		err := strategies.Delete(client, kind).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete %s strategy", kind)
+			return err
+		}
+
+		setUpgradePhase(status, starlingxv1.UpgradePhaseCompleted)
+		status.Reason = nil
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"upgrade to %s has completed", *status.Target)
+
+		return nil
+
+	case cloudManager.IsStrategyFailed(strategy.State):
+		err := strategies.Delete(client, kind).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete %s strategy", kind)
+			return err
+		}
+
+		setUpgradePhase(status, starlingxv1.UpgradePhaseFailed)
+
+		reason := ""
+		if status.Reason != nil {
+			reason = *status.Reason
+		}
+
+		r.WarningEvent(instance, common.ResourceUpdated,
+			"upgrade strategy %s: %s", strategy.State, reason)
+
+		msg := fmt.Sprintf("upgrade to %s failed", *status.Target)
+		return common.NewUserDataError(msg)
+	}
+
+	return nil
+}
+
+// Removes the platform upgrade finalizer
+func (r *PlatformUpgradeReconciler) removePlatformUpgradeFinalizer(instance *starlingxv1.PlatformUpgrade) {
+	// Remove the finalizer so the kubernetes delete operation can continue.
+	instance.Finalizers = utils.RemoveString(instance.Finalizers, PlatformUpgradeFinalizerName)
+	if err := r.Update(context.Background(), instance); err != nil {
+		logPlatformUpgrade.Error(err, "failed to remove the finalizer in the platform upgrade because of the error:%v")
+	}
+}
+
+// ReconciledDeleted is a method which handles reconciling a deleted platform
+// upgrade.  An upgrade cannot be safely interrupted therefore the finalizer is
+// only removed once the strategy has settled and has been deleted.
+func (r *PlatformUpgradeReconciler) ReconciledDeleted(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUpgrade, strategy *strategies.Strategy) error {
+	if !utils.ContainsString(instance.Finalizers, PlatformUpgradeFinalizerName) {
+		return nil
+	}
+
+	if strategy != nil && instance.Status.Target != nil {
+		kind := strategyType(instance)
+
+		if cloudManager.IsStrategyInProgress(strategy.State) {
+			return r.waitForStrategy(instance, kind, strategy)
+		}
+
+		err := strategies.Delete(client, kind).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete %s strategy", kind)
+			return err
+		}
+
+		r.NormalEvent(instance, common.ResourceDeleted, "upgrade strategy has been deleted")
+	}
+
+	r.SetUpgradeInProgress(instance.Namespace, false)
+	r.removePlatformUpgradeFinalizer(instance)
+
+	return nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an update
+// is required to the upgrade status attribute.  Updating this unnecessarily
+// will result in an infinite reconciliation loop.
+func (r *PlatformUpgradeReconciler) statusUpdateRequired(instance *starlingxv1.PlatformUpgrade, original *starlingxv1.PlatformUpgradeStatus, inSync bool) bool {
+	status := &instance.Status
+
+	status.InSync = inSync
+
+	if status.InSync && !status.Reconciled {
+		// Record the fact that we have reached inSync at least once.
+		status.Reconciled = true
+	}
+
+	status.ObservedGeneration = instance.Generation
+
+	return !status.DeepEqual(original)
+}
+
+// ReconcileResource interacts with the VIM API in order to reconcile the
+// state of a platform upgrade with the state stored in the k8s database.
+func (r *PlatformUpgradeReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUpgrade) error {
+	kind := strategyType(instance)
+
+	strategy, err := strategies.Show(client, kind).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get %s strategy", kind)
+		return err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.ReconciledDeleted(client, instance, strategy)
+	}
+
+	original := instance.Status.DeepCopy()
+	status := &instance.Status
+
+	if instance.Target() == "" {
+		err = common.NewValidationError("exactly one of release or kubernetesVersion must be specified")
+	} else if strategy != nil {
+		err = r.ReconcileStrategy(client, instance, strategy)
+	} else if status.InProgress {
+		// The strategy was removed outside of this resource so the outcome
+		// of the upgrade is unknown; start over on the next attempt.
+		status.Phase = nil
+		status.InProgress = false
+		r.WarningEvent(instance, common.ResourceDependency,
+			"upgrade strategy was deleted before completion")
+		err = common.NewResourceStatusDependency("upgrade strategy no longer exists")
+	} else if status.Phase == nil || *status.Phase != starlingxv1.UpgradePhaseCompleted ||
+		status.Target == nil || *status.Target != instance.Target() {
+		err = r.ReconcileNew(client, instance)
+	}
+
+	r.SetUpgradeInProgress(instance.Namespace, status.InProgress)
+
+	inSync := err == nil && status.Phase != nil &&
+		*status.Phase == starlingxv1.UpgradePhaseCompleted &&
+		status.Target != nil && *status.Target == instance.Target()
+
+	if instance.Status.InSync != inSync {
+		r.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if original.InProgress && !status.InProgress {
+		// Unblock the reconcilers that were waiting for the upgrade to
+		// complete.
+		err2 := r.NotifySystemDependencies(instance.Namespace)
+		if err2 != nil {
+			logPlatformUpgrade.Error(err2, "failed to notify system dependencies")
+		}
+	}
+
+	if r.statusUpdateRequired(instance, original, inSync) {
+		logPlatformUpgrade.Info("updating platform upgrade", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a PlatformUpgrade object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformupgrades,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformupgrades/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformupgrades/finalizers,verbs=update
+func (r *PlatformUpgradeReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logPlatformUpgrade
+	logPlatformUpgrade = logPlatformUpgrade.WithName(request.String())
+	defer func() { logPlatformUpgrade = savedLog }()
+
+	// Fetch the PlatformUpgrade instance
+	instance := &starlingxv1.PlatformUpgrade{}
+	err := r.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logPlatformUpgrade.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of VIM API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system nor the resource is modified.
+		if !utils.IsReconcilerEnabled(utils.PlatformUpgrade) {
+			return reconcile.Result{}, nil
+		}
+
+		if r.GetPlatformClient(request.Namespace) == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		vimClient, err := r.getVimClient(request.Namespace)
+		if err != nil {
+			return r.HandleReconcilerError(request, err)
+		}
+
+		err = r.ReconcilePlan(vimClient, instance)
+		return reconcile.Result{}, err
+	}
+
+	if instance.DeletionTimestamp.IsZero() {
+		// Ensure that the object has a finalizer setup as a pre-delete hook so
+		// that we can delete any strategy that we previously created.
+		if !utils.ContainsString(instance.Finalizers, PlatformUpgradeFinalizerName) {
+			instance.Finalizers = append(instance.Finalizers, PlatformUpgradeFinalizerName)
+			if err := r.Update(context.Background(), instance); err != nil {
+				return reconcile.Result{}, err
+			}
+
+			// Might as well return immediately as the update is going to cause
+			// another reconcile event for this resource and we don't want to
+			// access the system API more than necessary.
+			return reconcile.Result{}, nil
+		}
+	}
+
+	if !utils.IsReconcilerEnabled(utils.PlatformUpgrade) {
+		return reconcile.Result{}, nil
+	}
+
+	if r.GetPlatformClient(request.Namespace) == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !r.GetSystemReady(request.Namespace) && !instance.Status.InProgress {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	vimClient, err := r.getVimClient(request.Namespace)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	err = r.ReconcileResource(vimClient, instance)
+	if err != nil {
+		switch perrors.Cause(err).(type) {
+		case gophercloud.ErrDefault401, *url.Error:
+			// Force the VIM client to be rebuilt on the next attempt since
+			// its credentials or endpoint are no longer valid.
+			delete(r.vimClients, request.Namespace)
+		}
+		return r.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ReconcilePlan runs the platform upgrade reconciliation against plan mode
+// clients and publishes the VIM API requests that it would have issued in
+// the resource status.
+func (r *PlatformUpgradeReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUpgrade) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logPlatformUpgrade)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy())
+
+	return p.Publish(r.Client, instance, result)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PlatformUpgradeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logPlatformUpgrade}
+	r.ReconcilerEventLogger = &common.EventLogger{
+		EventRecorder: mgr.GetEventRecorderFor(PlatformUpgradeControllerName),
+		Logger:        logPlatformUpgrade}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.PlatformUpgrade{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.PlatformUpgradeList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
+		Complete(r)
+}
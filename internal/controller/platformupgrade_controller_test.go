@@ -0,0 +1,205 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/strategies"
+)
+
+// strategyFixture records the strategy requests received by a fake VIM API
+// server.
+type strategyFixture struct {
+	requests []string
+}
+
+func newStrategyFixtureServer(fixture *strategyFixture) (*httptest.Server, *gophercloud.ServiceClient) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/orchestration/", func(w http.ResponseWriter, r *http.Request) {
+		fixture.requests = append(fixture.requests, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			_, _ = fmt.Fprint(w, `{"strategy": {"uuid": "s1", "state": "building", "current-phase": "build"}}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			_, _ = fmt.Fprint(w, `{"strategy": null}`)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	sc := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{TokenID: "test-token"},
+		Endpoint:       server.URL + "/",
+	}
+	return server, sc
+}
+
+func newPlatformUpgradeReconciler(dm *cloudManager.Dummymanager) *PlatformUpgradeReconciler {
+	logger := log.Log.WithName("test")
+	return &PlatformUpgradeReconciler{
+		Client:       k8sClient,
+		CloudManager: dm,
+		ReconcilerErrorHandler: &common.ErrorHandler{
+			CloudManager: dm,
+			Logger:       logger,
+		},
+		ReconcilerEventLogger: &common.EventLogger{
+			EventRecorder: record.NewFakeRecorder(100),
+			Logger:        logger,
+		},
+	}
+}
+
+var _ = Describe("PlatformUpgrade controller", func() {
+	var (
+		server     *httptest.Server
+		gcClient   *gophercloud.ServiceClient
+		fixture    *strategyFixture
+		dm         *cloudManager.Dummymanager
+		reconciler *PlatformUpgradeReconciler
+		instance   *starlingxv1.PlatformUpgrade
+	)
+
+	BeforeEach(func() {
+		fixture = &strategyFixture{}
+		server, gcClient = newStrategyFixtureServer(fixture)
+		dm = &cloudManager.Dummymanager{}
+		reconciler = newPlatformUpgradeReconciler(dm)
+		instance = &starlingxv1.PlatformUpgrade{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: "default", Generation: 1},
+			Spec: starlingxv1.PlatformUpgradeSpec{
+				KubernetesVersion: ptr.To("v1.29.2"),
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("strategyCreateOpts", func() {
+		It("should only set the controller apply type for software deployments", func() {
+			instance.Spec.Strategy = &starlingxv1.UpgradeStrategyInfo{
+				ControllerApplyType: ptr.To("ignore"),
+				WorkerApplyType:     ptr.To("parallel"),
+				MaxParallelWorkers:  ptr.To(4),
+			}
+			opts := strategyCreateOpts(instance)
+			Expect(opts.ToVersion).To(Equal("v1.29.2"))
+			Expect(opts.Release).To(BeEmpty())
+			Expect(opts.ControllerApplyType).To(BeEmpty())
+			Expect(opts.WorkerApplyType).To(Equal("parallel"))
+			Expect(*opts.MaxParallelWorkerHosts).To(Equal(4))
+
+			instance.Spec.KubernetesVersion = nil
+			instance.Spec.Release = ptr.To("starlingx-10.0.1")
+			opts = strategyCreateOpts(instance)
+			Expect(opts.Release).To(Equal("starlingx-10.0.1"))
+			Expect(opts.ControllerApplyType).To(Equal("ignore"))
+			Expect(opts.StorageApplyType).To(Equal(DefaultUpgradeApplyType))
+		})
+	})
+
+	Describe("strategyType", func() {
+		It("should keep tracking a running strategy when the spec changes", func() {
+			Expect(strategyType(instance)).To(Equal(strategies.KubernetesUpgrade))
+
+			instance.Status.InProgress = true
+			instance.Status.StrategyType = ptr.To(strategies.KubernetesUpgrade)
+			instance.Spec.KubernetesVersion = nil
+			instance.Spec.Release = ptr.To("starlingx-10.0.1")
+			Expect(strategyType(instance)).To(Equal(strategies.KubernetesUpgrade))
+
+			instance.Status.InProgress = false
+			Expect(strategyType(instance)).To(Equal(strategies.SoftwareDeploy))
+		})
+	})
+
+	Describe("ReconcileNew", func() {
+		It("should create the strategy and monitor it", func() {
+			err := reconciler.ReconcileNew(gcClient, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("POST /api/orchestration/kube-upgrade/strategy"))
+			Expect(*instance.Status.Phase).To(Equal(starlingxv1.UpgradePhaseBuilding))
+			Expect(*instance.Status.Target).To(Equal("v1.29.2"))
+			Expect(instance.Status.InProgress).To(BeTrue())
+			Expect(*instance.Status.StrategyType).To(Equal(strategies.KubernetesUpgrade))
+			Expect(dm.MonitorStarted).To(BeTrue())
+		})
+
+		It("should not retry a failed upgrade until the resource changes", func() {
+			instance.Status.Phase = ptr.To(starlingxv1.UpgradePhaseFailed)
+			instance.Status.Target = ptr.To("v1.29.2")
+			instance.Status.ObservedGeneration = 1
+			err := reconciler.ReconcileNew(gcClient, instance)
+			Expect(err).To(HaveOccurred())
+			Expect(fixture.requests).To(BeEmpty())
+		})
+	})
+
+	Describe("ReconcileStrategy", func() {
+		It("should apply a strategy that is ready to apply", func() {
+			strategy := &strategies.Strategy{State: cloudManager.StrategyReadyToApply}
+			err := reconciler.ReconcileStrategy(gcClient, instance, strategy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("POST /api/orchestration/kube-upgrade/strategy/actions"))
+			Expect(*instance.Status.Phase).To(Equal(starlingxv1.UpgradePhaseApplying))
+			Expect(dm.MonitorStarted).To(BeTrue())
+		})
+
+		It("should report the progress of a strategy being applied", func() {
+			strategy := &strategies.Strategy{
+				State:        cloudManager.StrategyApplying,
+				CurrentPhase: "apply",
+				ApplyPhase:   strategies.Phase{CurrentStage: 3, TotalStages: 6, CompletionPercentage: 50},
+			}
+			err := reconciler.ReconcileStrategy(gcClient, instance, strategy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(BeEmpty())
+			Expect(instance.Status.CurrentStage).To(Equal(3))
+			Expect(instance.Status.Percentage).To(Equal(50))
+			Expect(instance.Status.InProgress).To(BeTrue())
+		})
+
+		It("should delete an applied strategy and complete the upgrade", func() {
+			instance.Status.InProgress = true
+			strategy := &strategies.Strategy{State: cloudManager.StrategyApplied}
+			err := reconciler.ReconcileStrategy(gcClient, instance, strategy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("DELETE /api/orchestration/kube-upgrade/strategy"))
+			Expect(*instance.Status.Phase).To(Equal(starlingxv1.UpgradePhaseCompleted))
+			Expect(instance.Status.InProgress).To(BeFalse())
+		})
+
+		It("should delete a failed strategy and report the reason", func() {
+			instance.Status.InProgress = true
+			strategy := &strategies.Strategy{
+				State:        cloudManager.StrategyApplyFailed,
+				CurrentPhase: "apply",
+				ApplyPhase:   strategies.Phase{Reason: "host controller-1 failed to upgrade"},
+			}
+			err := reconciler.ReconcileStrategy(gcClient, instance, strategy)
+			Expect(err).To(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("DELETE /api/orchestration/kube-upgrade/strategy"))
+			Expect(*instance.Status.Phase).To(Equal(starlingxv1.UpgradePhaseFailed))
+			Expect(*instance.Status.Reason).To(Equal("host controller-1 failed to upgrade"))
+			Expect(instance.Status.InProgress).To(BeFalse())
+		})
+	})
+})
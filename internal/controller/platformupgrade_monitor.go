@@ -0,0 +1,98 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/strategies"
+)
+
+// DefaultUpgradeMonitorInterval represents the default interval between
+// polling attempts to check the progress of an upgrade strategy.  Upgrading
+// a host takes several minutes therefore there is no need to poll this
+// frequently.
+const DefaultUpgradeMonitorInterval = 30 * time.Second
+
+// upgradeMonitor waits for an upgrade strategy to make progress.  Whenever
+// the strategy moves to a new state or stage a reconcilable event is
+// generated to kick the reconciler so that the progress is reflected in the
+// resource status.
+type upgradeMonitor struct {
+	manager.CommonMonitorBody
+	manager   manager.CloudManager
+	namespace string
+	kind      string
+	state     string
+	phase     string
+	stage     int
+	vimClient *gophercloud.ServiceClient
+}
+
+// NewUpgradeMonitor defines a convenience function to instantiate a new
+// upgrade monitor with all required attributes.  The monitor stops as soon as
+// the strategy differs from the one that was last reported.
+func NewUpgradeMonitor(instance *starlingxv1.PlatformUpgrade, kind string, strategy *strategies.Strategy) *manager.Monitor {
+	logger := logPlatformUpgrade.WithName("upgrade-monitor")
+	return &manager.Monitor{
+		MonitorBody: &upgradeMonitor{
+			namespace: instance.Namespace,
+			kind:      kind,
+			state:     strategy.State,
+			phase:     strategy.CurrentPhase,
+			stage:     currentStrategyPhase(strategy).CurrentStage,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultUpgradeMonitorInterval,
+	}
+}
+
+// SetManager implements the MonitorManager interface so that the monitor can
+// build its own VIM client.
+func (m *upgradeMonitor) SetManager(manager manager.CloudManager) {
+	m.manager = manager
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.  The supplied client is a system API client therefore a VIM
+// client is built on first use.
+func (m *upgradeMonitor) Run(_ *gophercloud.ServiceClient) (stop bool, err error) {
+	if m.vimClient == nil {
+		m.vimClient, err = m.manager.BuildPlatformClient(m.namespace,
+			manager.VimEndpointName, manager.VimEndpointType)
+		if err != nil {
+			m.SetState("failed to build VIM client: %s", err.Error())
+			return false, err
+		}
+	}
+
+	strategy, err := strategies.Show(m.vimClient, m.kind).Extract()
+	if err != nil {
+		m.vimClient = nil
+		m.SetState("failed to get %s strategy: %s", m.kind, err.Error())
+		return false, err
+	}
+
+	if strategy == nil {
+		m.SetState("%s strategy no longer exists", m.kind)
+		return true, nil
+	}
+
+	phase := currentStrategyPhase(strategy)
+	if strategy.State == m.state && strategy.CurrentPhase == m.phase && phase.CurrentStage == m.stage {
+		m.SetState("waiting for %s strategy to leave the %s state: stage %d/%d",
+			m.kind, strategy.State, phase.CurrentStage, phase.TotalStages)
+		return false, nil
+	}
+
+	m.SetState("%s strategy has reached the %s state: stage %d/%d",
+		m.kind, strategy.State, phase.CurrentStage, phase.TotalStages)
+
+	return true, nil
+}
@@ -0,0 +1,807 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/serviceparameters"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/system"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/identity"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/iusers"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logPlatformUsers = log.Log.WithName("controller").WithName("platformusers")
+
+const PlatformUsersControllerName = "platformusers-controller"
+
+var _ reconcile.Reconciler = &PlatformUsersReconciler{}
+
+// PlatformUsersReconciler reconciles a PlatformUsers object
+type PlatformUsersReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+	keystoneClients map[string]*gophercloud.ServiceClient
+}
+
+// platformUsersSecretIndex is the name of the field index which maps a
+// platform users resource to the password secrets of its users.
+const platformUsersSecretIndex = "spec.users.secret"
+
+// indexPlatformUsersSecrets is the field index function which extracts the
+// names of the password secrets referenced by a platform users resource.
+func indexPlatformUsersSecrets(obj client.Object) []string {
+	instance, ok := obj.(*starlingxv1.PlatformUsers)
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(instance.Spec.Users))
+	for _, u := range instance.Spec.Users {
+		if u.Secret != "" && !utils.ContainsString(result, u.Secret) {
+			result = append(result, u.Secret)
+		}
+	}
+
+	return result
+}
+
+// platformUsersForSecret maps a change to a secret to a reconcile request for
+// every platform users resource which uses it as a password secret so that
+// password changes are sent to keystone without waiting for another event.
+func (r *PlatformUsersReconciler) platformUsersForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &starlingxv1.PlatformUsersList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{platformUsersSecretIndex: obj.GetName()})
+	if err != nil {
+		logPlatformUsers.Error(err, "failed to list platform users using secret", "secret", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: item.Namespace, Name: item.Name}})
+	}
+
+	return requests
+}
+
+// userPassword defines the password of a keystone user along with the
+// version of the secret from which it was read.
+type userPassword struct {
+	password string
+	version  string
+}
+
+// validatePlatformUsers is a utility function which checks the consistency of
+// the declared projects and users.
+func validatePlatformUsers(instance *starlingxv1.PlatformUsers) error {
+	projects := make(map[string]bool)
+	for _, p := range instance.Spec.Projects {
+		if projects[p.Name] {
+			msg := fmt.Sprintf("project %q is declared more than once", p.Name)
+			return common.NewValidationError(msg)
+		}
+		projects[p.Name] = true
+	}
+
+	users := make(map[string]bool)
+	for _, u := range instance.Spec.Users {
+		if users[u.Name] {
+			msg := fmt.Sprintf("user %q is declared more than once", u.Name)
+			return common.NewValidationError(msg)
+		}
+		users[u.Name] = true
+
+		if len(u.Roles) > 0 && u.Project == nil {
+			msg := fmt.Sprintf("user %q must have a project to be granted roles", u.Name)
+			return common.NewValidationError(msg)
+		}
+	}
+
+	return nil
+}
+
+// getKeystoneClient returns the keystone client of a namespace and builds it
+// if it does not exist yet.
+func (r *PlatformUsersReconciler) getKeystoneClient(namespace string) (*gophercloud.ServiceClient, error) {
+	if c, ok := r.keystoneClients[namespace]; ok {
+		return c, nil
+	}
+
+	c, err := r.BuildPlatformClient(namespace, cloudManager.KeystoneEndpointName, cloudManager.KeystoneEndpointType)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.keystoneClients == nil {
+		r.keystoneClients = make(map[string]*gophercloud.ServiceClient)
+	}
+	r.keystoneClients[namespace] = c
+
+	return c, nil
+}
+
+// getUserPassword is a utility to retrieve the password of a user from the
+// secret referenced by the resource.
+func (r *PlatformUsersReconciler) getUserPassword(instance *starlingxv1.PlatformUsers, user *starlingxv1.KeystoneUserInfo) (*userPassword, error) {
+	secret := &v1.Secret{}
+	secretName := types.NamespacedName{Namespace: instance.Namespace, Name: user.Secret}
+
+	err := r.Get(context.TODO(), secretName, secret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			msg := fmt.Sprintf("password secret %q of user %q not found", secretName.Name, user.Name)
+			return nil, common.NewMissingKubernetesResource(msg)
+		}
+		err = perrors.Wrapf(err, "failed to get password secret of user %q", user.Name)
+		return nil, err
+	}
+
+	password, ok := secret.Data[starlingxv1.PlatformUserPasswordKey]
+	if !ok || len(password) == 0 {
+		msg := fmt.Sprintf("missing %q key within password secret %q",
+			starlingxv1.PlatformUserPasswordKey, secretName.Name)
+		return nil, common.NewUserDataError(msg)
+	}
+
+	return &userPassword{password: string(password), version: secret.ResourceVersion}, nil
+}
+
+// ReconcileSysadmin configures the password aging policy of the sysadmin
+// account.
+func (r *PlatformUsersReconciler) ReconcileSysadmin(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUsers) error {
+	spec := instance.Spec.Sysadmin
+	if spec == nil || spec.PasswordExpiryDays == nil {
+		return nil
+	}
+
+	systemInfo, err := system.GetDefaultSystem(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to get system")
+		return err
+	}
+
+	user, err := iusers.GetSystemUser(client, systemInfo.ID)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to get sysadmin user configuration")
+		return err
+	}
+
+	if user.PasswdExpiryDays == *spec.PasswordExpiryDays {
+		return nil
+	}
+
+	opts := iusers.UserOpts{PasswdExpiryDays: spec.PasswordExpiryDays}
+
+	logPlatformUsers.Info("updating sysadmin user configuration", "opts", opts)
+
+	_, err = iusers.Update(client, user.ID, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update sysadmin user configuration: %s",
+			common.FormatStruct(opts))
+		return err
+	}
+
+	r.NormalEvent(instance, common.ResourceUpdated,
+		"sysadmin password expiry has been set to %d days", *spec.PasswordExpiryDays)
+
+	return nil
+}
+
+// ReconcileSecurityCompliance configures the keystone account lockout policy.
+// The policy is stored as identity service parameters which must be applied
+// before they take effect.  This reconciler is the only owner of these
+// parameters; the system reconciler leaves them alone while they are
+// declared here.
+func (r *PlatformUsersReconciler) ReconcileSecurityCompliance(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUsers) error {
+	desired := instance.SecurityComplianceParameters()
+	if len(desired) == 0 {
+		return nil
+	}
+
+	current, err := serviceparameters.ListServiceParameters(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list service parameters")
+		return err
+	}
+
+	service := utils.ServiceTypeIdentity
+	section := utils.ServiceParamSectionSecurityCompliance
+	updated := false
+
+	for name, value := range desired {
+		found := false
+		for _, p := range current {
+			if p.Service != service || p.Section != section || p.ParamName != name {
+				continue
+			}
+
+			found = true
+			if p.ParamValue != value {
+				opts := serviceparameters.ServiceParameterPatchOpts{ParamValue: &value}
+				_, err = serviceparameters.Update(client, p.ID, opts).Extract()
+				if err != nil {
+					err = perrors.Wrapf(err, "failed to update service parameter %q", name)
+					return err
+				}
+				updated = true
+			}
+			break
+		}
+
+		if !found {
+			params := map[string]string{name: value}
+			opts := serviceparameters.ServiceParameterOpts{
+				Service:    &service,
+				Section:    &section,
+				Parameters: &params,
+			}
+
+			_, err = serviceparameters.Create(client, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to create service parameter %q", name)
+				return err
+			}
+			updated = true
+		}
+	}
+
+	if updated {
+		opts := serviceparameters.ServiceApplyOpts{Service: &service}
+		if err := serviceparameters.Apply(client, opts).Err; err != nil {
+			err = perrors.Wrapf(err, "failed to apply %q service parameters", service)
+			return err
+		}
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"security compliance parameters have been applied")
+	}
+
+	return nil
+}
+
+// findProject is a utility function which looks up a keystone project by
+// name.  A nil project is returned if it does not exist.
+func findProject(client *gophercloud.ServiceClient, domain string, name string) (*identity.Project, error) {
+	result, err := identity.ListProjects(client, identity.ListOpts{Name: name, DomainID: domain}).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get project %q", name)
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+// findUser is a utility function which looks up a keystone user by name.  A
+// nil user is returned if it does not exist.
+func findUser(client *gophercloud.ServiceClient, domain string, name string) (*identity.User, error) {
+	result, err := identity.ListUsers(client, identity.ListOpts{Name: name, DomainID: domain}).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get user %q", name)
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+// projectUpdateOpts is a utility function which determines whether a project
+// needs to be updated and returns the attributes to be changed.
+func projectUpdateOpts(spec *starlingxv1.KeystoneProjectInfo, project *identity.Project) (opts identity.ProjectOpts, result bool) {
+	if spec.Description != nil && *spec.Description != project.Description {
+		opts.Description = spec.Description
+		result = true
+	}
+
+	if spec.Enabled != nil && *spec.Enabled != project.Enabled {
+		opts.Enabled = spec.Enabled
+		result = true
+	}
+
+	return opts, result
+}
+
+// ReconcileProjects creates or updates the declared keystone projects.  The
+// unique identifier of each project is returned so that users can refer to
+// them by name.
+func (r *PlatformUsersReconciler) ReconcileProjects(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUsers) (map[string]string, error) {
+	domain := instance.KeystoneDomain()
+	result := make(map[string]string)
+
+	for i := range instance.Spec.Projects {
+		spec := &instance.Spec.Projects[i]
+
+		project, err := findProject(client, domain, spec.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if project == nil {
+			opts := identity.ProjectOpts{
+				Name:        spec.Name,
+				DomainID:    domain,
+				Description: spec.Description,
+				Enabled:     spec.Enabled,
+			}
+
+			logPlatformUsers.Info("creating project", "opts", opts)
+
+			project, err = identity.CreateProject(client, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to create project: %s", common.FormatStruct(opts))
+				return nil, err
+			}
+
+			r.NormalEvent(instance, common.ResourceCreated, "project %q has been created", spec.Name)
+
+		} else if opts, ok := projectUpdateOpts(spec, project); ok {
+			logPlatformUsers.Info("updating project", "name", spec.Name, "opts", opts)
+
+			project, err = identity.UpdateProject(client, project.ID, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to update project: %s", common.FormatStruct(opts))
+				return nil, err
+			}
+
+			r.NormalEvent(instance, common.ResourceUpdated, "project %q has been updated", spec.Name)
+		}
+
+		result[spec.Name] = project.ID
+	}
+
+	return result, nil
+}
+
+// userUpdateOpts is a utility function which determines whether a user needs
+// to be updated and returns the attributes to be changed.  Passwords cannot be
+// read back therefore they are only compared through the version of their
+// secret.
+func userUpdateOpts(spec *starlingxv1.KeystoneUserInfo, user *identity.User, projectID *string, password *userPassword, version string) (opts identity.UserOpts, result bool) {
+	if projectID != nil && *projectID != user.DefaultProjectID {
+		opts.DefaultProjectID = projectID
+		result = true
+	}
+
+	if spec.Email != nil && *spec.Email != user.Email {
+		opts.Email = spec.Email
+		result = true
+	}
+
+	if spec.Enabled != nil && *spec.Enabled != user.Enabled {
+		opts.Enabled = spec.Enabled
+		result = true
+	}
+
+	if password.version != version {
+		opts.Password = &password.password
+		result = true
+	}
+
+	return opts, result
+}
+
+// ReconcileRoles grants the declared roles to a user on its default project.
+// Roles that are no longer declared are left in place.
+func (r *PlatformUsersReconciler) ReconcileRoles(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUsers, spec *starlingxv1.KeystoneUserInfo, userID string, projectID string, roles map[string]string) error {
+	assignments, err := identity.ListRoleAssignments(client, identity.RoleAssignmentListOpts{
+		UserID: userID, ProjectID: projectID}).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get role assignments of user %q", spec.Name)
+		return err
+	}
+
+	assigned := make(map[string]bool)
+	for _, a := range assignments {
+		assigned[a.Role.ID] = true
+	}
+
+	for _, name := range spec.Roles {
+		roleID, ok := roles[name]
+		if !ok {
+			msg := fmt.Sprintf("role %q of user %q does not exist", name, spec.Name)
+			return common.NewUserDataError(msg)
+		}
+
+		if assigned[roleID] {
+			continue
+		}
+
+		err = identity.AssignRole(client, projectID, userID, roleID).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to grant role %q to user %q", name, spec.Name)
+			return err
+		}
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"role %q has been granted to user %q", name, spec.Name)
+	}
+
+	return nil
+}
+
+// ReconcileUsers creates or updates the declared keystone users.  The
+// password of a user is only sent to keystone when the user is created or
+// when its secret has changed since it was last sent.
+func (r *PlatformUsersReconciler) ReconcileUsers(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUsers, projects map[string]string) error {
+	domain := instance.KeystoneDomain()
+	status := &instance.Status
+	var roles map[string]string
+
+	for i := range instance.Spec.Users {
+		spec := &instance.Spec.Users[i]
+
+		var projectID *string
+		if spec.Project != nil {
+			if id, ok := projects[*spec.Project]; ok {
+				projectID = &id
+			} else {
+				// The project is not declared by this resource so it must
+				// already exist.
+				project, err := findProject(client, domain, *spec.Project)
+				if err != nil {
+					return err
+				}
+				if project == nil {
+					msg := fmt.Sprintf("project %q of user %q does not exist", *spec.Project, spec.Name)
+					return common.NewUserDataError(msg)
+				}
+				projectID = &project.ID
+			}
+		}
+
+		password, err := r.getUserPassword(instance, spec)
+		if err != nil {
+			return err
+		}
+
+		user, err := findUser(client, domain, spec.Name)
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			opts := identity.UserOpts{
+				Name:             spec.Name,
+				DomainID:         domain,
+				DefaultProjectID: projectID,
+				Email:            spec.Email,
+				Enabled:          spec.Enabled,
+				Password:         &password.password,
+			}
+
+			logPlatformUsers.Info("creating user", "name", spec.Name)
+
+			user, err = identity.CreateUser(client, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to create user %q", spec.Name)
+				return err
+			}
+
+			r.NormalEvent(instance, common.ResourceCreated, "user %q has been created", spec.Name)
+
+		} else if opts, ok := userUpdateOpts(spec, user, projectID, password, status.PasswordVersions[spec.Name]); ok {
+			logPlatformUsers.Info("updating user", "name", spec.Name,
+				"password", opts.Password != nil)
+
+			user, err = identity.UpdateUser(client, user.ID, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to update user %q", spec.Name)
+				return err
+			}
+
+			r.NormalEvent(instance, common.ResourceUpdated, "user %q has been updated", spec.Name)
+		}
+
+		if status.PasswordVersions == nil {
+			status.PasswordVersions = make(map[string]string)
+		}
+		status.PasswordVersions[spec.Name] = password.version
+
+		if len(spec.Roles) == 0 {
+			continue
+		}
+
+		if roles == nil {
+			result, err := identity.ListRoles(client).Extract()
+			if err != nil {
+				err = perrors.Wrap(err, "failed to list roles")
+				return err
+			}
+
+			roles = make(map[string]string)
+			for _, role := range result {
+				roles[role.Name] = role.ID
+			}
+		}
+
+		err = r.ReconcileRoles(client, instance, spec, user.ID, *projectID, roles)
+		if err != nil {
+			return err
+		}
+	}
+
+	declared := declaredUserNames(instance)
+	for name := range status.PasswordVersions {
+		// Forget the password versions of users that are no longer
+		// declared so that they are sent again if re-declared.
+		if !utils.ContainsString(declared, name) {
+			delete(status.PasswordVersions, name)
+		}
+	}
+
+	return nil
+}
+
+// declaredUserNames is a utility function which returns the names of the
+// users declared by the resource.
+func declaredUserNames(instance *starlingxv1.PlatformUsers) []string {
+	result := make([]string, 0, len(instance.Spec.Users))
+	for _, u := range instance.Spec.Users {
+		result = append(result, u.Name)
+	}
+	return result
+}
+
+// statusUpdateRequired is a utility function which determines whether an update
+// is required to the platform users status attribute.  Updating this
+// unnecessarily will result in an infinite reconciliation loop.
+func (r *PlatformUsersReconciler) statusUpdateRequired(instance *starlingxv1.PlatformUsers, original *starlingxv1.PlatformUsersStatus, inSync bool) bool {
+	status := &instance.Status
+
+	status.InSync = inSync
+
+	if status.InSync && !status.Reconciled {
+		// Record the fact that we have reached inSync at least once.
+		status.Reconciled = true
+	}
+
+	status.ObservedGeneration = instance.Generation
+
+	return !status.DeepEqual(original)
+}
+
+// ReconcileKeystone reconciles the projects and users declared by the
+// resource.  The keystone client is only built if there is something to
+// reconcile.
+func (r *PlatformUsersReconciler) ReconcileKeystone(instance *starlingxv1.PlatformUsers) error {
+	if len(instance.Spec.Projects) == 0 && len(instance.Spec.Users) == 0 {
+		return nil
+	}
+
+	keystoneClient, err := r.getKeystoneClient(instance.Namespace)
+	if err != nil {
+		return err
+	}
+
+	projects, err := r.ReconcileProjects(keystoneClient, instance)
+	if err == nil {
+		err = r.ReconcileUsers(keystoneClient, instance, projects)
+	}
+
+	if err != nil {
+		switch perrors.Cause(err).(type) {
+		case gophercloud.ErrDefault401, *url.Error:
+			// Force the keystone client to be rebuilt on the next attempt
+			// since its credentials or endpoint are no longer valid.
+			delete(r.keystoneClients, instance.Namespace)
+		}
+	}
+
+	return err
+}
+
+// ReconcileResource interacts with the system and keystone APIs in order to
+// reconcile the state of the platform users with the state stored in the k8s
+// database.  Nothing is removed from the system when the resource is deleted
+// therefore no finalizer is required.
+func (r *PlatformUsersReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUsers) error {
+	if !instance.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	original := instance.Status.DeepCopy()
+
+	err := validatePlatformUsers(instance)
+	if err == nil {
+		err = r.ReconcileSysadmin(client, instance)
+	}
+	if err == nil {
+		err = r.ReconcileSecurityCompliance(client, instance)
+	}
+	if err == nil {
+		err = r.ReconcileKeystone(instance)
+	}
+
+	inSync := err == nil
+
+	if instance.Status.InSync != inSync {
+		r.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, original, inSync) {
+		logPlatformUsers.Info("updating platform users", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a PlatformUsers object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformusers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=platformusers/status,verbs=get;update;patch
+func (r *PlatformUsersReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logPlatformUsers
+	logPlatformUsers = logPlatformUsers.WithName(request.String())
+	defer func() { logPlatformUsers = savedLog }()
+
+	// Fetch the PlatformUsers instance
+	instance := &starlingxv1.PlatformUsers{}
+	err := r.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logPlatformUsers.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system and keystone API requests without
+		// executing them.  Nothing else is updated while in plan mode so that
+		// neither the system nor the resource is modified.
+		if !utils.IsReconcilerEnabled(utils.PlatformUsers) {
+			return reconcile.Result{}, nil
+		}
+
+		platformClient := r.GetPlatformClient(request.Namespace)
+		if platformClient == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		err = r.ReconcilePlan(platformClient, instance)
+		return reconcile.Result{}, err
+	}
+
+	if !utils.IsReconcilerEnabled(utils.PlatformUsers) {
+		return reconcile.Result{}, nil
+	}
+
+	platformClient := r.GetPlatformClient(request.Namespace)
+	if platformClient == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !r.GetSystemReady(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
+	err = r.ReconcileResource(platformClient, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ReconcilePlan runs the platform users reconciliation against plan mode
+// clients and publishes the system and keystone API requests that it would
+// have issued in the resource status.
+func (r *PlatformUsersReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.PlatformUsers) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logPlatformUsers)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	// The keystone requests are recorded in the same plan as the system API
+	// requests.
+	planner.keystoneClients = make(map[string]*gophercloud.ServiceClient)
+	if len(instance.Spec.Projects) > 0 || len(instance.Spec.Users) > 0 {
+		keystoneClient, err := r.getKeystoneClient(instance.Namespace)
+		if err != nil {
+			return err
+		}
+		planner.keystoneClients[instance.Namespace] = common.NewPlanModeServiceClient(keystoneClient, p.Recorder)
+	}
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy())
+
+	return p.Publish(r.Client, instance, result)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PlatformUsersReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logPlatformUsers}
+	r.ReconcilerEventLogger = &common.EventLogger{
+		EventRecorder: mgr.GetEventRecorderFor(PlatformUsersControllerName),
+		Logger:        logPlatformUsers}
+
+	err := mgr.GetFieldIndexer().IndexField(context.Background(),
+		&starlingxv1.PlatformUsers{}, platformUsersSecretIndex, indexPlatformUsersSecrets)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.PlatformUsers{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.PlatformUsersList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
+		Watches(&v1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.platformUsersForSecret)).
+		Complete(r)
+}
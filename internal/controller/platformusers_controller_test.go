@@ -0,0 +1,218 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+)
+
+// keystoneFixture records the requests received by a fake keystone API
+// server and defines the projects and users that it reports.
+type keystoneFixture struct {
+	requests []string
+	bodies   map[string]map[string]interface{}
+	projects string
+	users    string
+}
+
+func newKeystoneFixtureServer(fixture *keystoneFixture) (*httptest.Server, *gophercloud.ServiceClient) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+		fixture.requests = append(fixture.requests, request)
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			body := make(map[string]interface{})
+			_ = json.Unmarshal(data, &body)
+			fixture.bodies[request] = body
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch request {
+		case "GET /projects":
+			_, _ = fmt.Fprintf(w, `{"projects": [%s]}`, fixture.projects)
+		case "POST /projects":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"project": {"id": "p1", "name": "operations", "enabled": true}}`)
+		case "GET /users":
+			_, _ = fmt.Fprintf(w, `{"users": [%s]}`, fixture.users)
+		case "POST /users":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"user": {"id": "u1", "name": "operator", "enabled": true}}`)
+		case "PATCH /users/u1":
+			_, _ = fmt.Fprint(w, `{"user": {"id": "u1", "name": "operator", "enabled": true}}`)
+		case "GET /roles":
+			_, _ = fmt.Fprint(w, `{"roles": [{"id": "r1", "name": "member"}]}`)
+		case "GET /role_assignments":
+			_, _ = fmt.Fprint(w, `{"role_assignments": []}`)
+		case "PUT /projects/p1/users/u1/roles/r1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	sc := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{TokenID: "test-token"},
+		Endpoint:       server.URL + "/",
+	}
+	return server, sc
+}
+
+func newPlatformUsersReconciler(dm *cloudManager.Dummymanager) *PlatformUsersReconciler {
+	logger := log.Log.WithName("test")
+	return &PlatformUsersReconciler{
+		Client:       k8sClient,
+		CloudManager: dm,
+		ReconcilerErrorHandler: &common.ErrorHandler{
+			CloudManager: dm,
+			Logger:       logger,
+		},
+		ReconcilerEventLogger: &common.EventLogger{
+			EventRecorder: record.NewFakeRecorder(100),
+			Logger:        logger,
+		},
+	}
+}
+
+var _ = Describe("PlatformUsers controller", func() {
+	var (
+		server     *httptest.Server
+		ksClient   *gophercloud.ServiceClient
+		fixture    *keystoneFixture
+		reconciler *PlatformUsersReconciler
+		instance   *starlingxv1.PlatformUsers
+		secret     *v1.Secret
+	)
+
+	BeforeEach(func() {
+		fixture = &keystoneFixture{bodies: make(map[string]map[string]interface{})}
+		server, ksClient = newKeystoneFixtureServer(fixture)
+		reconciler = newPlatformUsersReconciler(&cloudManager.Dummymanager{})
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator-password", Namespace: "default"},
+			Data: map[string][]byte{
+				starlingxv1.PlatformUserPasswordKey: []byte("St8rlingX*"),
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+		instance = &starlingxv1.PlatformUsers{
+			ObjectMeta: metav1.ObjectMeta{Name: "users", Namespace: "default", Generation: 1},
+			Spec: starlingxv1.PlatformUsersSpec{
+				Projects: []starlingxv1.KeystoneProjectInfo{{Name: "operations"}},
+				Users: []starlingxv1.KeystoneUserInfo{{
+					Name:    "operator",
+					Project: ptr.To("operations"),
+					Roles:   []string{"member"},
+					Secret:  secret.Name,
+				}},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(k8sClient.Delete(context.Background(), secret)).To(Succeed())
+	})
+
+	Describe("validatePlatformUsers", func() {
+		It("should require a project to grant roles", func() {
+			instance.Spec.Users[0].Project = nil
+			Expect(validatePlatformUsers(instance)).To(HaveOccurred())
+		})
+
+		It("should reject duplicate users", func() {
+			instance.Spec.Users = append(instance.Spec.Users, instance.Spec.Users[0])
+			Expect(validatePlatformUsers(instance)).To(HaveOccurred())
+		})
+	})
+
+	Describe("indexPlatformUsersSecrets", func() {
+		It("should index each password secret once", func() {
+			instance.Spec.Users = append(instance.Spec.Users, starlingxv1.KeystoneUserInfo{
+				Name:   "auditor",
+				Secret: secret.Name,
+			})
+			Expect(indexPlatformUsersSecrets(instance)).To(Equal([]string{secret.Name}))
+		})
+
+		It("should ignore other kinds of resources", func() {
+			Expect(indexPlatformUsersSecrets(secret)).To(BeNil())
+		})
+	})
+
+	Describe("ReconcileProjects", func() {
+		It("should create missing projects", func() {
+			projects, err := reconciler.ReconcileProjects(ksClient, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("GET /projects", "POST /projects"))
+			Expect(projects).To(HaveKeyWithValue("operations", "p1"))
+		})
+
+		It("should leave matching projects untouched", func() {
+			fixture.projects = `{"id": "p1", "name": "operations", "enabled": true}`
+			_, err := reconciler.ReconcileProjects(ksClient, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("GET /projects"))
+		})
+	})
+
+	Describe("ReconcileUsers", func() {
+		projects := map[string]string{"operations": "p1"}
+
+		It("should create the user and grant its roles", func() {
+			err := reconciler.ReconcileUsers(ksClient, instance, projects)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("GET /users", "POST /users", "GET /roles",
+				"GET /role_assignments", "PUT /projects/p1/users/u1/roles/r1"))
+			user := fixture.bodies["POST /users"]["user"].(map[string]interface{})
+			Expect(user["password"]).To(Equal("St8rlingX*"))
+			Expect(user["default_project_id"]).To(Equal("p1"))
+			Expect(instance.Status.PasswordVersions).To(HaveKeyWithValue("operator", secret.ResourceVersion))
+		})
+
+		It("should only send the password when its secret changes", func() {
+			instance.Spec.Users[0].Roles = nil
+			fixture.users = `{"id": "u1", "name": "operator", "default_project_id": "p1", "enabled": true}`
+			instance.Status.PasswordVersions = map[string]string{"operator": secret.ResourceVersion}
+			err := reconciler.ReconcileUsers(ksClient, instance, projects)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("GET /users"))
+
+			fixture.requests = nil
+			secret.Data[starlingxv1.PlatformUserPasswordKey] = []byte("N3wP4ssw0rd*")
+			Expect(k8sClient.Update(context.Background(), secret)).To(Succeed())
+			err = reconciler.ReconcileUsers(ksClient, instance, projects)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("GET /users", "PATCH /users/u1"))
+			user := fixture.bodies["PATCH /users/u1"]["user"].(map[string]interface{})
+			Expect(user).To(Equal(map[string]interface{}{"password": "N3wP4ssw0rd*"}))
+			Expect(instance.Status.PasswordVersions).To(HaveKeyWithValue("operator", secret.ResourceVersion))
+		})
+
+		It("should wait for a missing password secret", func() {
+			instance.Spec.Users[0].Secret = "missing"
+			err := reconciler.ReconcileUsers(ksClient, instance, projects)
+			Expect(err).To(HaveOccurred())
+			Expect(fixture.requests).To(BeEmpty())
+		})
+	})
+})
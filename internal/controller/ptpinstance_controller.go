@@ -16,11 +16,13 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -153,6 +155,13 @@ func (r *PtpInstanceReconciler) ReconcileParamRemoved(client *gophercloud.Servic
 // ReconcileNew is a method which handles reconciling a new data resource and
 // creates the corresponding system resource thru the system API.
 func (r *PtpInstanceReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.PtpInstance) (*ptpinstances.PTPInstance, error) {
+	if starlingxv1.IsAdoptionPending(instance) {
+		// Only existing resources can be adopted.
+		msg := common.AdoptionNoSystemResource
+		r.NormalEvent(instance, common.ResourceDependency, msg)
+		return nil, common.NewAdoptionPending(msg)
+	}
+
 	if instance.Status.Reconciled && r.StopAfterInSync() {
 		// Do not process any further changes once we have reached a
 		// synchronized state unless there is an annotation on the resource.
@@ -307,10 +316,28 @@ func (r *PtpInstanceReconciler) FindExistingPTPInstance(client *gophercloud.Serv
 	return found, err
 }
 
+// reconcileAdoption binds the resource to an existing ptp instance without
+// modifying it.  Any parameter differences are reported in the delta so that
+// they can be reviewed before the adoption is confirmed.
+func (r *PtpInstanceReconciler) reconcileAdoption(instance *starlingxv1.PtpInstance, existing *ptpinstances.PTPInstance) error {
+	_, _, paramsRequired := instanceParameterUpdateRequired(instance, existing, r)
+	if instanceUpdateRequired(instance, existing) || paramsRequired {
+		msg := common.AdoptionChangesHeld
+		r.NormalEvent(instance, common.ResourceDependency, msg)
+		return common.NewAdoptionPending(msg)
+	}
+
+	return nil
+}
+
 // ReconcileUpdated is a method which handles reconciling an existing data
 // resource and updates the corresponding system resource thru the system API to
 // match the desired state of the resource.
 func (r *PtpInstanceReconciler) ReconcileUpdated(client *gophercloud.ServiceClient, instance *starlingxv1.PtpInstance, existing *ptpinstances.PTPInstance) error {
+	if starlingxv1.IsAdoptionPending(instance) {
+		return r.reconcileAdoption(instance, existing)
+	}
+
 	if ok := instanceUpdateRequired(instance, existing); ok {
 		if instance.Status.Reconciled && r.StopAfterInSync() {
 			// Do not process any further changes once we have reached a
@@ -592,6 +619,35 @@ func (r *PtpInstanceReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return reconcile.Result{}, err
 	}
 
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system nor the resource is modified.
+		if !utils.IsReconcilerEnabled(utils.PTPInstance) {
+			return reconcile.Result{}, nil
+		}
+
+		if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+			// Nothing is removed from the system when a resource is orphaned.
+			return reconcile.Result{}, nil
+		}
+
+		platformClient := r.GetPlatformClient(request.Namespace)
+		if platformClient == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		err = r.ReconcilePlan(platformClient, instance)
+		return reconcile.Result{}, err
+	}
+
 	if err, _ := r.UpdateDeploymentScope(instance); err != nil {
 		return r.HandleReconcilerError(request, err)
 	}
@@ -631,6 +687,17 @@ func (r *PtpInstanceReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		}
 	}
 
+	if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+		// The resource is being released without removing it from the
+		// system so the only thing left to do is to drop the finalizer.
+		if utils.ContainsString(instance.Finalizers, PtpInstanceFinalizerName) {
+			r.NormalEvent(instance, common.ResourceDeleted,
+				"PTP instance orphaned; system resource left in place")
+			r.removePtpInstanceFinalizer(instance)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if !utils.IsReconcilerEnabled(utils.PTPInstance) {
 		return reconcile.Result{}, nil
 	}
@@ -644,20 +711,63 @@ func (r *PtpInstanceReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return common.RetryMissingClient, nil
 	}
 
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if !r.GetSystemReady(request.Namespace) {
 		r.WarningEvent(instance, common.ResourceDependency,
 			"waiting for system reconciliation")
 		return common.RetrySystemNotReady, nil
 	}
 
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
 	err = r.ReconcileResource(platformClient, instance)
 	if err != nil {
 		return r.HandleReconcilerError(request, err)
 	}
 
+	if !instance.DeletionTimestamp.IsZero() {
+		r.CancelMonitor(instance)
+		deletePtpMetrics(instance.Namespace, instance.Name)
+		if err := r.ReconcileHostPtpStatus(instance, nil); err != nil {
+			// The finalizer is already removed so this cannot be retried;
+			// the stale entries are left in the host status.
+			logPtpInstance.Error(err, "failed to remove the PTP instance from the host status")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	err = r.ReconcileOperationalStatus(platformClient, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// ReconcilePlan runs the PTP instance reconciliation against plan mode
+// clients and publishes the system API requests that it would have issued
+// in the resource status.
+func (r *PtpInstanceReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.PtpInstance) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logPtpInstance)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy())
+
+	return p.Publish(r.Client, instance, result)
+}
+
 // UpdateDeploymentScope function is used to update the deployment scope for PtpInstance.
 func (r *PtpInstanceReconciler) UpdateDeploymentScope(instance *starlingxv1.PtpInstance) (error, bool) {
 	updated, err := common.UpdateDeploymentScope(r.Client, instance)
@@ -682,5 +792,7 @@ func (r *PtpInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Logger:        logPtpInstance}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.PtpInstance{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.PtpInstanceList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
 		Complete(r)
 }
@@ -0,0 +1,102 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/ptpnotifications"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	ptpMetricsNamespace = "deployment_manager"
+	ptpMetricsSubsystem = "ptp"
+)
+
+// ptpMetricsLabels are the labels which identify the ptp instance and host
+// of every PTP operational metric.
+var ptpMetricsLabels = []string{"namespace", "instance", "host"}
+
+var (
+	ptpLockedGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ptpMetricsNamespace,
+		Subsystem: ptpMetricsSubsystem,
+		Name:      "locked",
+		Help:      "Whether the clock driven by a PTP instance on a host is locked to its time source.",
+	}, ptpMetricsLabels)
+
+	ptpClockClassGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ptpMetricsNamespace,
+		Subsystem: ptpMetricsSubsystem,
+		Name:      "clock_class",
+		Help:      "The clock class advertised by a PTP instance on a host.",
+	}, ptpMetricsLabels)
+
+	ptpOffsetGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ptpMetricsNamespace,
+		Subsystem: ptpMetricsSubsystem,
+		Name:      "offset_nanoseconds",
+		Help:      "The offset from its time source of the clock driven by a PTP instance on a host.",
+	}, ptpMetricsLabels)
+
+	ptpGNSSSynchronizedGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ptpMetricsNamespace,
+		Subsystem: ptpMetricsSubsystem,
+		Name:      "gnss_synchronized",
+		Help:      "Whether the GNSS receiver used by a PTP instance on a host is synchronized.",
+	}, ptpMetricsLabels)
+)
+
+func init() {
+	metrics.Registry.MustRegister(ptpLockedGauge, ptpClockClassGauge,
+		ptpOffsetGauge, ptpGNSSSynchronizedGauge)
+}
+
+// boolToGauge converts a boolean to a gauge value.
+func boolToGauge(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// updatePtpMetrics replaces the PTP operational metrics of an instance with
+// the state and the offset observed on each of its hosts.  Metrics of hosts
+// to which the instance is no longer assigned are dropped.
+func updatePtpMetrics(namespace string, name string, hosts []starlingxv1.PtpHostStatus, offsets map[string]string) {
+	deletePtpMetrics(namespace, name)
+
+	for i := range hosts {
+		h := &hosts[i]
+		labels := prometheus.Labels{"namespace": namespace, "instance": name, "host": h.Host}
+
+		ptpLockedGauge.With(labels).Set(boolToGauge(ptpHostLocked(h)))
+
+		if h.ClockClass != nil {
+			ptpClockClassGauge.With(labels).Set(float64(*h.ClockClass))
+		}
+
+		if value, ok := offsets[h.Host]; ok {
+			if offset, err := strconv.ParseFloat(value, 64); err == nil {
+				ptpOffsetGauge.With(labels).Set(offset)
+			}
+		}
+
+		if h.GNSSStatus != "" {
+			ptpGNSSSynchronizedGauge.With(labels).Set(boolToGauge(h.GNSSStatus == ptpnotifications.GNSSStateSynchronized))
+		}
+	}
+}
+
+// deletePtpMetrics drops every PTP operational metric of an instance.
+func deletePtpMetrics(namespace string, name string) {
+	labels := prometheus.Labels{"namespace": namespace, "instance": name}
+	ptpLockedGauge.DeletePartialMatch(labels)
+	ptpClockClassGauge.DeletePartialMatch(labels)
+	ptpOffsetGauge.DeletePartialMatch(labels)
+	ptpGNSSSynchronizedGauge.DeletePartialMatch(labels)
+}
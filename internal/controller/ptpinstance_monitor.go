@@ -0,0 +1,92 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+)
+
+// DefaultPtpOperationalMonitorInterval represents the default interval
+// between polling attempts to check the operational state of a ptp instance.
+// Each poll also refreshes the exported metrics, including the clock offset.
+const DefaultPtpOperationalMonitorInterval = 30 * time.Second
+
+// ptpOperationalMonitor waits for the operational state of a ptp instance to
+// change on any of its hosts.  Whenever a host gains or loses lock, changes
+// clock class, or the set of assigned hosts changes, a reconcilable event is
+// generated to kick the reconciler so that the change is reflected in the
+// resource status.
+type ptpOperationalMonitor struct {
+	manager.CommonMonitorBody
+	manager   manager.CloudManager
+	namespace string
+	name      string
+	service   string
+	hosts     []starlingxv1.PtpHostStatus
+	ptpClient *gophercloud.ServiceClient
+}
+
+// NewPtpOperationalMonitor defines a convenience function to instantiate a
+// new ptp operational monitor with all required attributes.  The monitor
+// stops as soon as the operational state differs from the one that was last
+// reported.
+func NewPtpOperationalMonitor(instance *starlingxv1.PtpInstance, hosts []starlingxv1.PtpHostStatus) *manager.Monitor {
+	logger := logPtpInstance.WithName("ptp-operational-monitor")
+	return &manager.Monitor{
+		MonitorBody: &ptpOperationalMonitor{
+			namespace: instance.Namespace,
+			name:      instance.Name,
+			service:   instance.Spec.Service,
+			hosts:     hosts,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultPtpOperationalMonitorInterval,
+	}
+}
+
+// SetManager implements the MonitorManager interface so that the monitor can
+// build its own PTP notification client.
+func (m *ptpOperationalMonitor) SetManager(manager manager.CloudManager) {
+	m.manager = manager
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.  The supplied client is a system API client which is used
+// to find the hosts to which the instance is assigned.
+func (m *ptpOperationalMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	if m.ptpClient == nil {
+		m.ptpClient, err = m.manager.BuildPTPNotificationClient(m.namespace)
+		if err != nil {
+			m.SetState("failed to build PTP notification client: %s", err.Error())
+			return false, err
+		} else if m.ptpClient == nil {
+			m.SetState("PTP notification service is no longer configured")
+			return true, nil
+		}
+	}
+
+	hosts, offsets, err := collectPtpOperationalStatus(client, m.ptpClient, m.name, m.service)
+	if err != nil {
+		m.ptpClient = nil
+		m.SetState("failed to get operational state of PTP instance %s: %s", m.name, err.Error())
+		return false, err
+	}
+
+	updatePtpMetrics(m.namespace, m.name, hosts, offsets)
+
+	if !ptpOperationalStateChanged(m.hosts, hosts) {
+		m.SetState("waiting for PTP instance %s operational state to change", m.name)
+		return false, nil
+	}
+
+	m.SetState("PTP instance %s operational state has changed", m.name)
+
+	return true, nil
+}
@@ -0,0 +1,367 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ptpinstances"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/ptpnotifications"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ptpOperationalResources returns the synchronization resources reported by
+// the PTP notification service for the clock driven by a ptp instance
+// service.  Services which do not drive a clock have no operational state.
+func ptpOperationalResources(service string) []string {
+	switch service {
+	case "ptp4l":
+		return []string{ptpnotifications.ResourceLockState, ptpnotifications.ResourceClockClass}
+	case "phc2sys":
+		return []string{ptpnotifications.ResourceOSClockSyncState}
+	case "ts2phc", "gnss-monitor":
+		return []string{ptpnotifications.ResourceGNSSSyncStatus}
+	}
+	return nil
+}
+
+// ptpHostLocked returns whether the clock or GNSS receiver driven by an
+// instance on a host is locked to its time source.  A host for which no
+// state was reported is not considered locked.
+func ptpHostLocked(status *starlingxv1.PtpHostStatus) bool {
+	if status.LockState == "" && status.GNSSStatus == "" {
+		return false
+	}
+	if status.LockState != "" && status.LockState != ptpnotifications.LockStateLocked {
+		return false
+	}
+	if status.GNSSStatus != "" && status.GNSSStatus != ptpnotifications.GNSSStateSynchronized {
+		return false
+	}
+	return true
+}
+
+// ptpOperationalStateChanged returns whether the operational state of an
+// instance differs between two observations.
+func ptpOperationalStateChanged(previous []starlingxv1.PtpHostStatus, current []starlingxv1.PtpHostStatus) bool {
+	if len(previous) != len(current) {
+		return true
+	}
+
+	for i := range current {
+		if !previous[i].DeepEqual(&current[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ptpLockedCondition builds the condition which reports whether every host to
+// which an instance is assigned is locked to its time source.
+func ptpLockedCondition(instance *starlingxv1.PtpInstance, statuses []starlingxv1.PtpHostStatus) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               starlingxv1.PtpConditionLocked,
+		ObservedGeneration: instance.Generation,
+	}
+
+	if len(statuses) == 0 {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = starlingxv1.PtpReasonNoHosts
+		condition.Message = "the instance is not assigned to any host"
+		return condition
+	}
+
+	unlocked := make([]string, 0)
+	for i := range statuses {
+		if !ptpHostLocked(&statuses[i]) {
+			unlocked = append(unlocked, statuses[i].Host)
+		}
+	}
+
+	if len(unlocked) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = starlingxv1.PtpReasonLockLost
+		condition.Message = fmt.Sprintf("hosts not locked to their time source: %s",
+			strings.Join(unlocked, ", "))
+		return condition
+	}
+
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = starlingxv1.PtpReasonLocked
+	condition.Message = "all hosts are locked to their time source"
+
+	return condition
+}
+
+// ptpAssignedHosts returns the names of the hosts to which a ptp instance is
+// assigned on the system, sorted by name.
+func ptpAssignedHosts(platformClient *gophercloud.ServiceClient, name string) ([]string, error) {
+	objects, err := hosts.ListHosts(platformClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for _, h := range objects {
+		if h.Hostname == "" {
+			continue
+		}
+
+		assigned, err := ptpinstances.ListHostPTPInstances(platformClient, h.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list PTP instances of host: %s", h.Hostname)
+			return nil, err
+		}
+
+		for _, i := range assigned {
+			if i.Name == name {
+				result = append(result, h.Hostname)
+				break
+			}
+		}
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// collectPtpHostStatus queries the PTP notification service for the
+// operational state of an instance on a host along with the offset from its
+// time source.  The offset is returned separately since it changes
+// continuously; it is only published as a metric so that the status is not
+// rewritten on every observation.  Resources which are not tracked on the
+// host are skipped.
+func collectPtpHostStatus(ptpClient *gophercloud.ServiceClient, host string, name string, service string) (status starlingxv1.PtpHostStatus, offset *string, err error) {
+	status = starlingxv1.PtpHostStatus{Host: host}
+
+	for _, resource := range ptpOperationalResources(service) {
+		state, err := ptpnotifications.GetCurrentState(ptpClient, host, resource).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			err = perrors.Wrapf(err, "failed to get PTP state %s of host: %s", resource, host)
+			return status, nil, err
+		}
+
+		notifications := state.Values(host, name, ptpnotifications.DataTypeNotification)
+		metrics := state.Values(host, name, ptpnotifications.DataTypeMetric)
+
+		switch resource {
+		case ptpnotifications.ResourceClockClass:
+			values := append(notifications, metrics...)
+			if len(values) > 0 {
+				if class, err := strconv.Atoi(values[0].String()); err == nil {
+					status.ClockClass = &class
+				}
+			}
+			continue
+
+		case ptpnotifications.ResourceGNSSSyncStatus:
+			if len(notifications) > 0 {
+				status.GNSSStatus = notifications[0].String()
+			}
+
+		default:
+			if len(notifications) > 0 {
+				status.LockState = notifications[0].String()
+			}
+		}
+
+		if len(metrics) > 0 && offset == nil {
+			value := metrics[0].String()
+			offset = &value
+		}
+	}
+
+	return status, offset, nil
+}
+
+// collectPtpOperationalStatus returns the operational state of a ptp instance
+// on each host to which it is assigned, and the offset from the time source
+// of each host which reports one.
+func collectPtpOperationalStatus(platformClient *gophercloud.ServiceClient, ptpClient *gophercloud.ServiceClient, name string, service string) ([]starlingxv1.PtpHostStatus, map[string]string, error) {
+	assigned, err := ptpAssignedHosts(platformClient, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]starlingxv1.PtpHostStatus, 0, len(assigned))
+	offsets := make(map[string]string)
+	for _, host := range assigned {
+		status, offset, err := collectPtpHostStatus(ptpClient, host, name, service)
+		if err != nil {
+			return nil, nil, err
+		}
+		result = append(result, status)
+		if offset != nil {
+			offsets[host] = *offset
+		}
+	}
+
+	return result, offsets, nil
+}
+
+// updateHostPtpStatus records the operational state of a ptp instance in the
+// status of a host, or removes the instance from it if status is nil.  The
+// entries are kept sorted by instance name.  It returns whether the host
+// status was changed.
+func updateHostPtpStatus(host *starlingxv1.Host, name string, status *starlingxv1.PtpHostStatus) bool {
+	result := make([]starlingxv1.HostPtpStatus, 0, len(host.Status.Ptp)+1)
+	for _, s := range host.Status.Ptp {
+		if s.Instance != name {
+			result = append(result, s)
+		}
+	}
+
+	if status != nil {
+		result = append(result, starlingxv1.HostPtpStatus{
+			Instance:   name,
+			Locked:     ptpHostLocked(status),
+			LockState:  status.LockState,
+			GNSSStatus: status.GNSSStatus,
+		})
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].Instance < result[j].Instance
+		})
+	}
+
+	if len(result) == 0 {
+		result = nil
+	}
+
+	original := host.Status.DeepCopy()
+	host.Status.Ptp = result
+
+	return !host.Status.DeepEqual(original)
+}
+
+// ReconcileHostPtpStatus publishes the operational state of a ptp instance in
+// the status of the host resources of its namespace so that it can also be
+// inspected from the host side.  The instance is removed from the hosts to
+// which it is no longer assigned.
+func (r *PtpInstanceReconciler) ReconcileHostPtpStatus(instance *starlingxv1.PtpInstance, statuses []starlingxv1.PtpHostStatus) error {
+	hostList := &starlingxv1.HostList{}
+	err := r.Client.List(context.TODO(), hostList, client.InNamespace(instance.Namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return err
+	}
+
+	assigned := make(map[string]*starlingxv1.PtpHostStatus, len(statuses))
+	for i := range statuses {
+		assigned[statuses[i].Host] = &statuses[i]
+	}
+
+	for i := range hostList.Items {
+		host := &hostList.Items[i]
+		if !updateHostPtpStatus(host, instance.Name, assigned[host.Name]) {
+			continue
+		}
+
+		logPtpInstance.V(2).Info("updating host PTP operational status", "host", host.Name)
+
+		err = r.Client.Status().Update(context.TODO(), host)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update host status: %s", host.Name)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReconcileOperationalStatus publishes the operational state of a ptp
+// instance on each host to which it is assigned, both in its own status and in
+// the status of those hosts, raises an event whenever a host loses or regains
+// lock, and starts a monitor which keeps the metrics up to date and triggers a
+// new reconciliation when the state changes.  Nothing is done unless the PTP
+// notification service of the system is configured.
+func (r *PtpInstanceReconciler) ReconcileOperationalStatus(platformClient *gophercloud.ServiceClient, instance *starlingxv1.PtpInstance) error {
+	if !utils.IsReconcilerEnabled(utils.PTPOperationalStatus) {
+		return nil
+	}
+
+	if ptpOperationalResources(instance.Spec.Service) == nil {
+		return nil
+	}
+
+	ptpClient, err := r.BuildPTPNotificationClient(instance.Namespace)
+	if err != nil {
+		return err
+	} else if ptpClient == nil {
+		return nil
+	}
+
+	statuses, offsets, err := collectPtpOperationalStatus(platformClient, ptpClient, instance.Name, instance.Spec.Service)
+	if err != nil {
+		return err
+	}
+
+	previous := make(map[string]bool)
+	for i := range instance.Status.Hosts {
+		previous[instance.Status.Hosts[i].Host] = ptpHostLocked(&instance.Status.Hosts[i])
+	}
+
+	for i := range statuses {
+		status := &statuses[i]
+		locked := ptpHostLocked(status)
+		if wasLocked, ok := previous[status.Host]; ok && wasLocked && !locked {
+			r.WarningEvent(instance, common.ResourceUpdated,
+				"host %s lost lock: state %q, GNSS %q", status.Host, status.LockState, status.GNSSStatus)
+		} else if (!ok || !wasLocked) && locked {
+			r.NormalEvent(instance, common.ResourceUpdated,
+				"host %s is locked to its time source", status.Host)
+		}
+	}
+
+	updatePtpMetrics(instance.Namespace, instance.Name, statuses, offsets)
+
+	original := instance.Status.DeepCopy()
+
+	if len(statuses) > 0 {
+		instance.Status.Hosts = statuses
+	} else {
+		instance.Status.Hosts = nil
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, ptpLockedCondition(instance, statuses))
+
+	if !instance.Status.DeepEqual(original) {
+		logPtpInstance.V(2).Info("updating PTP instance operational status", "hosts", instance.Status.Hosts)
+
+		err = r.Client.Status().Update(context.TODO(), instance)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update status: %s",
+				instance.Name)
+			return err
+		}
+	}
+
+	err = r.ReconcileHostPtpStatus(instance, statuses)
+	if err != nil {
+		return err
+	}
+
+	// Replace any monitor started by a previous reconciliation since it was
+	// watching for a change from an older state.
+	r.CancelMonitor(instance)
+
+	return r.StartMonitor(NewPtpOperationalMonitor(instance, statuses),
+		"waiting for PTP operational state change")
+}
@@ -0,0 +1,105 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/ptpnotifications"
+)
+
+var _ = Describe("PtpInstance operational status", func() {
+	intPtr := func(i int) *int { return &i }
+
+	locked := starlingxv1.PtpHostStatus{Host: "controller-0", LockState: ptpnotifications.LockStateLocked, ClockClass: intPtr(6)}
+	holdover := starlingxv1.PtpHostStatus{Host: "controller-1", LockState: ptpnotifications.LockStateHoldover, ClockClass: intPtr(7)}
+	synchronized := starlingxv1.PtpHostStatus{Host: "controller-0", GNSSStatus: ptpnotifications.GNSSStateSynchronized}
+
+	Describe("ptpOperationalResources", func() {
+		It("should only report resources for services which drive a clock", func() {
+			Expect(ptpOperationalResources("ptp4l")).To(ConsistOf(
+				ptpnotifications.ResourceLockState, ptpnotifications.ResourceClockClass))
+			Expect(ptpOperationalResources("ts2phc")).To(ConsistOf(ptpnotifications.ResourceGNSSSyncStatus))
+			Expect(ptpOperationalResources("clock")).To(BeNil())
+		})
+	})
+
+	Describe("ptpHostLocked", func() {
+		It("should require every reported state to be locked", func() {
+			Expect(ptpHostLocked(&locked)).To(BeTrue())
+			Expect(ptpHostLocked(&holdover)).To(BeFalse())
+			Expect(ptpHostLocked(&synchronized)).To(BeTrue())
+			Expect(ptpHostLocked(&starlingxv1.PtpHostStatus{Host: "controller-0"})).To(BeFalse())
+			Expect(ptpHostLocked(&starlingxv1.PtpHostStatus{Host: "controller-0",
+				LockState: ptpnotifications.LockStateLocked, GNSSStatus: "FREERUN"})).To(BeFalse())
+		})
+	})
+
+	Describe("ptpLockedCondition", func() {
+		instance := &starlingxv1.PtpInstance{ObjectMeta: metav1.ObjectMeta{Name: "ptp1", Generation: 3}}
+
+		It("should report whether every host is locked", func() {
+			condition := ptpLockedCondition(instance, []starlingxv1.PtpHostStatus{locked})
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(starlingxv1.PtpReasonLocked))
+			Expect(condition.ObservedGeneration).To(Equal(int64(3)))
+
+			condition = ptpLockedCondition(instance, []starlingxv1.PtpHostStatus{locked, holdover})
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(starlingxv1.PtpReasonLockLost))
+			Expect(condition.Message).To(ContainSubstring("controller-1"))
+		})
+
+		It("should report an unknown state without any host", func() {
+			condition := ptpLockedCondition(instance, nil)
+			Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(condition.Reason).To(Equal(starlingxv1.PtpReasonNoHosts))
+		})
+	})
+
+	Describe("ptpOperationalStateChanged", func() {
+		It("should not report an unchanged state", func() {
+			Expect(ptpOperationalStateChanged([]starlingxv1.PtpHostStatus{locked, holdover},
+				[]starlingxv1.PtpHostStatus{locked, holdover})).To(BeFalse())
+		})
+
+		It("should detect a change of lock state or hosts", func() {
+			current := locked
+			current.LockState = ptpnotifications.LockStateFreerun
+			Expect(ptpOperationalStateChanged([]starlingxv1.PtpHostStatus{locked},
+				[]starlingxv1.PtpHostStatus{current})).To(BeTrue())
+			Expect(ptpOperationalStateChanged([]starlingxv1.PtpHostStatus{locked},
+				[]starlingxv1.PtpHostStatus{locked, holdover})).To(BeTrue())
+		})
+	})
+
+	Describe("updateHostPtpStatus", func() {
+		It("should record the state of each instance sorted by name", func() {
+			host := &starlingxv1.Host{ObjectMeta: metav1.ObjectMeta{Name: "controller-0"}}
+
+			Expect(updateHostPtpStatus(host, "ptp2", &locked)).To(BeTrue())
+			Expect(updateHostPtpStatus(host, "ptp1", &synchronized)).To(BeTrue())
+			Expect(host.Status.Ptp).To(Equal([]starlingxv1.HostPtpStatus{
+				{Instance: "ptp1", Locked: true, GNSSStatus: ptpnotifications.GNSSStateSynchronized},
+				{Instance: "ptp2", Locked: true, LockState: ptpnotifications.LockStateLocked},
+			}))
+
+			Expect(updateHostPtpStatus(host, "ptp2", &locked)).To(BeFalse())
+		})
+
+		It("should remove an instance which is no longer assigned", func() {
+			host := &starlingxv1.Host{ObjectMeta: metav1.ObjectMeta{Name: "controller-1"}}
+
+			Expect(updateHostPtpStatus(host, "ptp1", &holdover)).To(BeTrue())
+			Expect(host.Status.Ptp).To(HaveLen(1))
+			Expect(host.Status.Ptp[0].Locked).To(BeFalse())
+
+			Expect(updateHostPtpStatus(host, "ptp1", nil)).To(BeTrue())
+			Expect(host.Status.Ptp).To(BeNil())
+			Expect(updateHostPtpStatus(host, "ptp1", nil)).To(BeFalse())
+		})
+	})
+})
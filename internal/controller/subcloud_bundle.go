@@ -0,0 +1,256 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// parseBundle is a utility function which decodes a multi-document YAML
+// deployment bundle into the list of resources that it contains.  The
+// namespace document produced by deployctl is dropped since the bundle is
+// always propagated into the namespace chosen by the subcloud resource.
+func parseBundle(data string) ([]*unstructured.Unstructured, error) {
+	result := make([]*unstructured.Unstructured, 0)
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(data), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		err := decoder.Decode(&obj.Object)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			err = perrors.Wrap(err, "failed to decode deployment bundle")
+			return nil, err
+		}
+
+		if len(obj.Object) == 0 {
+			// Empty documents are produced by leading or trailing
+			// separators.
+			continue
+		}
+
+		if obj.GetKind() == "" || obj.GetName() == "" {
+			msg := "deployment bundle resources must have a kind and a name"
+			return nil, common.NewUserDataError(msg)
+		}
+
+		if obj.GetAPIVersion() == "v1" && obj.GetKind() == "Namespace" {
+			continue
+		}
+
+		result = append(result, obj)
+	}
+
+	return result, nil
+}
+
+// getBundle is a utility to retrieve the deployment bundle from the secret
+// referenced by the resource.
+func (r *SubcloudReconciler) getBundle(instance *starlingxv1.Subcloud) (string, error) {
+	secret := &v1.Secret{}
+	secretName := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.Bundle.Secret}
+
+	err := r.Get(context.TODO(), secretName, secret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			msg := fmt.Sprintf("deployment bundle secret %q not found", secretName.Name)
+			return "", common.NewMissingKubernetesResource(msg)
+		}
+		err = perrors.Wrap(err, "failed to get deployment bundle secret")
+		return "", err
+	}
+
+	data, ok := secret.Data[instance.BundleKey()]
+	if !ok {
+		msg := fmt.Sprintf("missing %q key within deployment bundle secret", instance.BundleKey())
+		return "", common.NewUserDataError(msg)
+	}
+
+	return string(data), nil
+}
+
+// buildSubcloudEndpointSecret is a utility which derives the system endpoint
+// secret of a subcloud from the one used to reach the system controller.
+// Subclouds are reached thru the system controller keystone so only the
+// region needs to be changed.
+func (r *SubcloudReconciler) buildSubcloudEndpointSecret(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) (*unstructured.Unstructured, error) {
+	central := &v1.Secret{}
+	secretName := types.NamespacedName{Namespace: instance.Namespace, Name: cloudManager.SystemEndpointSecretName}
+
+	err := r.Get(context.TODO(), secretName, central)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to get system endpoint secret")
+		return nil, err
+	}
+
+	secret := &v1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cloudManager.SystemEndpointSecretName,
+			Namespace: instance.BundleNamespace(),
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: make(map[string][]byte),
+	}
+
+	for key, value := range central.Data {
+		secret.Data[key] = value
+	}
+	secret.Data[cloudManager.RegionNameKey] = []byte(subcloud.RegionName)
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(secret)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to convert system endpoint secret")
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+// ensureNamespace is a utility which creates the namespace into which the
+// deployment bundle is propagated if it does not already exist.
+func (r *SubcloudReconciler) ensureNamespace(name string) error {
+	namespace := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+
+	err := r.Create(context.TODO(), namespace)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		err = perrors.Wrapf(err, "failed to create namespace: %s", name)
+		return err
+	}
+
+	return nil
+}
+
+// applyBundleObject is a utility which creates a deployment bundle resource
+// or replaces the existing one.  Finalizers already set by other reconcilers
+// are preserved so that the replaced resource can still be cleaned up.
+func (r *SubcloudReconciler) applyBundleObject(obj *unstructured.Unstructured) error {
+	err := r.Create(context.TODO(), obj)
+	if err == nil {
+		return nil
+	} else if !errors.IsAlreadyExists(err) {
+		err = perrors.Wrapf(err, "failed to create %s: %s", obj.GetKind(), obj.GetName())
+		return err
+	}
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.GroupVersionKind())
+
+	err = r.Get(context.TODO(), client.ObjectKeyFromObject(obj), current)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get %s: %s", obj.GetKind(), obj.GetName())
+		return err
+	}
+
+	obj.SetResourceVersion(current.GetResourceVersion())
+	obj.SetFinalizers(current.GetFinalizers())
+
+	err = r.Update(context.TODO(), obj)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to update %s: %s", obj.GetKind(), obj.GetName())
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileBundle is a method which propagates the deployment bundle of a
+// subcloud once it is online, managed, and deployed.  The bundle resources
+// are created in a dedicated namespace so that they are reconciled against
+// the subcloud like any other system.  The bundle is only propagated again
+// if its content or target namespace has changed.
+func (r *SubcloudReconciler) ReconcileBundle(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) error {
+	status := &instance.Status
+
+	if instance.Spec.Bundle == nil {
+		return nil
+	}
+
+	if !subcloudOnlineAndManaged(subcloud) || subcloud.DeployStatus != subclouds.DeployStatusComplete {
+		if instance.DesiredManagement() != starlingxv1.SubcloudManaged {
+			msg := "subcloud must be managed to propagate its deployment bundle"
+			return common.NewValidationError(msg)
+		}
+		return r.waitForSubcloud(instance, subcloud, "to be online and managed before propagating its bundle")
+	}
+
+	data, err := r.getBundle(instance)
+	if err != nil {
+		return err
+	}
+
+	namespace := instance.BundleNamespace()
+	hash := hashString(namespace + "\n" + data)
+	if status.BundleHash != nil && *status.BundleHash == hash {
+		return nil
+	}
+
+	objects, err := parseBundle(data)
+	if err != nil {
+		return err
+	}
+
+	err = r.ensureNamespace(namespace)
+	if err != nil {
+		return err
+	}
+
+	endpoint := false
+	for _, obj := range objects {
+		obj.SetNamespace(namespace)
+		if obj.GetKind() == "Secret" && obj.GetName() == cloudManager.SystemEndpointSecretName {
+			endpoint = true
+		}
+	}
+
+	if !endpoint {
+		// The bundle does not specify how to reach the subcloud so derive
+		// the endpoint from the system controller endpoint.
+		secret, err := r.buildSubcloudEndpointSecret(instance, subcloud)
+		if err != nil {
+			return err
+		}
+		objects = append([]*unstructured.Unstructured{secret}, objects...)
+	}
+
+	logSubcloud.Info("propagating deployment bundle", "namespace", namespace,
+		"resources", len(objects))
+
+	for _, obj := range objects {
+		err = r.applyBundleObject(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	status.BundleHash = &hash
+
+	r.NormalEvent(instance, common.ResourceUpdated,
+		"deployment bundle has been propagated to namespace %q", namespace)
+
+	return nil
+}
@@ -0,0 +1,734 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var logSubcloud = log.Log.WithName("controller").WithName("subcloud")
+
+const SubcloudControllerName = "subcloud-controller"
+
+const SubcloudFinalizerName = "subcloud.finalizers.windriver.com"
+
+var _ reconcile.Reconciler = &SubcloudReconciler{}
+
+// SubcloudReconciler reconciles a Subcloud object
+type SubcloudReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	cloudManager.CloudManager
+	common.ReconcilerErrorHandler
+	common.ReconcilerEventLogger
+	dcClients map[string]*gophercloud.ServiceClient
+}
+
+// subcloudCredentials defines the passwords required to deploy and back up a
+// subcloud.
+type subcloudCredentials struct {
+	sysadminPassword string
+	bmcPassword      string
+}
+
+// hashString is a utility function which returns a digest of a string so that
+// large documents can be compared against the values last sent to the system
+// without having to store them in the resource status.
+func hashString(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// setSubcloudStatus is a utility function which records the state reported by
+// the system controller in the resource status.
+func setSubcloudStatus(status *starlingxv1.SubcloudStatus, subcloud *subclouds.Subcloud) {
+	id := subcloud.ID
+	deployStatus := subcloud.DeployStatus
+	availability := subcloud.AvailabilityStatus
+	management := subcloud.ManagementState
+	version := subcloud.SoftwareVersion
+
+	status.ID = &id
+	status.DeployStatus = &deployStatus
+	status.AvailabilityStatus = &availability
+	status.ManagementState = &management
+	status.SoftwareVersion = &version
+
+	if subcloud.BackupStatus != "" {
+		backupStatus := subcloud.BackupStatus
+		status.BackupStatus = &backupStatus
+	}
+}
+
+// subcloudOnlineAndManaged determines whether a subcloud is able to accept
+// operations that must be executed on the subcloud itself.
+func subcloudOnlineAndManaged(subcloud *subclouds.Subcloud) bool {
+	return subcloud.AvailabilityStatus == subclouds.AvailabilityOnline &&
+		subcloud.ManagementState == subclouds.ManagementManaged
+}
+
+// getDCManagerClient returns the dcmanager client of a namespace and builds it
+// if it does not exist yet.
+func (r *SubcloudReconciler) getDCManagerClient(namespace string) (*gophercloud.ServiceClient, error) {
+	if c, ok := r.dcClients[namespace]; ok {
+		return c, nil
+	}
+
+	c, err := r.BuildPlatformClient(namespace, cloudManager.DCManagerEndpointName, cloudManager.DCManagerEndpointType)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.dcClients == nil {
+		r.dcClients = make(map[string]*gophercloud.ServiceClient)
+	}
+	r.dcClients[namespace] = c
+
+	return c, nil
+}
+
+// getSubcloudCredentials is a utility to retrieve the subcloud credentials
+// from the secret referenced by the resource.
+func (r *SubcloudReconciler) getSubcloudCredentials(instance *starlingxv1.Subcloud) (*subcloudCredentials, error) {
+	secret := &v1.Secret{}
+	secretName := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.Credentials.Secret}
+
+	err := r.Get(context.TODO(), secretName, secret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			msg := fmt.Sprintf("subcloud credentials secret %q not found", secretName.Name)
+			return nil, common.NewMissingKubernetesResource(msg)
+		}
+		err = perrors.Wrap(err, "failed to get subcloud credentials secret")
+		return nil, err
+	}
+
+	password, ok := secret.Data[starlingxv1.SubcloudSysadminPasswordKey]
+	if !ok {
+		msg := fmt.Sprintf("missing %q key within subcloud credentials secret",
+			starlingxv1.SubcloudSysadminPasswordKey)
+		return nil, common.NewUserDataError(msg)
+	}
+
+	result := &subcloudCredentials{sysadminPassword: string(password)}
+
+	if password, ok = secret.Data[starlingxv1.SubcloudBMCPasswordKey]; ok {
+		result.bmcPassword = string(password)
+	} else if instance.Spec.InstallValues != nil {
+		msg := fmt.Sprintf("missing %q key within subcloud credentials secret",
+			starlingxv1.SubcloudBMCPasswordKey)
+		return nil, common.NewUserDataError(msg)
+	}
+
+	return result, nil
+}
+
+// findSubcloud is a utility function which retrieves the subcloud matching
+// the resource name.  A nil subcloud is returned if it does not exist.
+func findSubcloud(client *gophercloud.ServiceClient, name string) (*subclouds.Subcloud, error) {
+	subcloud, err := subclouds.Get(client, name).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+		err = perrors.Wrapf(err, "failed to get subcloud: %s", name)
+		return nil, err
+	}
+
+	return subcloud, nil
+}
+
+// subcloudAddOpts is a utility function which builds the request to add a
+// new subcloud from the attributes of the resource.
+func subcloudAddOpts(instance *starlingxv1.Subcloud, credentials *subcloudCredentials) subclouds.AddOpts {
+	spec := instance.Spec
+
+	opts := subclouds.AddOpts{
+		Name:             instance.Name,
+		BootstrapAddress: spec.BootstrapAddress,
+		BootstrapValues:  spec.BootstrapValues,
+		SysadminPassword: credentials.sysadminPassword,
+		BMCPassword:      credentials.bmcPassword,
+	}
+
+	if spec.InstallValues != nil {
+		opts.InstallValues = *spec.InstallValues
+	}
+	if spec.DeployConfig != nil {
+		opts.DeployConfig = *spec.DeployConfig
+	}
+	if spec.Group != nil {
+		opts.Group = *spec.Group
+	}
+	if spec.Release != nil {
+		opts.Release = *spec.Release
+	}
+	if spec.Description != nil {
+		opts.Description = *spec.Description
+	}
+	if spec.Location != nil {
+		opts.Location = *spec.Location
+	}
+
+	return opts
+}
+
+// subcloudUpdateOpts is a utility function which determines whether the
+// descriptive attributes of a subcloud need to be updated.
+func subcloudUpdateOpts(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) (subclouds.UpdateOpts, bool) {
+	var opts subclouds.UpdateOpts
+	result := false
+
+	if instance.Spec.Description != nil && *instance.Spec.Description != subcloud.Description {
+		opts.Description = instance.Spec.Description
+		result = true
+	}
+
+	if instance.Spec.Location != nil && *instance.Spec.Location != subcloud.Location {
+		opts.Location = instance.Spec.Location
+		result = true
+	}
+
+	return opts, result
+}
+
+// waitForSubcloud launches a monitor which triggers a new reconciliation
+// once the state of the subcloud has changed.
+func (r *SubcloudReconciler) waitForSubcloud(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud, reason string) error {
+	msg := fmt.Sprintf("waiting for subcloud %s", reason)
+	return r.StartMonitor(NewSubcloudMonitor(instance, subcloud), msg)
+}
+
+// ReconcileNew is a method which handles adding a new subcloud to the system
+// controller.  The subcloud is installed, bootstrapped, and deployed
+// asynchronously therefore it is monitored until the deployment settles.
+func (r *SubcloudReconciler) ReconcileNew(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud) error {
+	credentials, err := r.getSubcloudCredentials(instance)
+	if err != nil {
+		return err
+	}
+
+	opts := subcloudAddOpts(instance, credentials)
+
+	logSubcloud.Info("adding subcloud", "name", instance.Name,
+		"address", opts.BootstrapAddress)
+
+	subcloud, err := subclouds.Add(client, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to add subcloud: %s", instance.Name)
+		return err
+	}
+
+	setSubcloudStatus(&instance.Status, subcloud)
+	if instance.Spec.DeployConfig != nil {
+		hash := hashString(*instance.Spec.DeployConfig)
+		instance.Status.DeployConfigHash = &hash
+	}
+
+	r.NormalEvent(instance, common.ResourceCreated,
+		"subcloud %q has been added", instance.Name)
+
+	return r.waitForSubcloud(instance, subcloud, "deployment to complete")
+}
+
+// ReconcileDeployConfig is a method which re-runs the deploy configure phase
+// of a subcloud whenever its deploy config has changed.
+func (r *SubcloudReconciler) ReconcileDeployConfig(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) (bool, error) {
+	status := &instance.Status
+
+	if instance.Spec.DeployConfig == nil {
+		return false, nil
+	}
+
+	hash := hashString(*instance.Spec.DeployConfig)
+	if status.DeployConfigHash == nil && !subcloud.DeployFailed() {
+		// The subcloud was added outside of this resource (or the status
+		// was lost) so assume that its deploy config is current.
+		status.DeployConfigHash = &hash
+		return false, nil
+	} else if status.DeployConfigHash != nil && *status.DeployConfigHash == hash {
+		return false, nil
+	}
+
+	credentials, err := r.getSubcloudCredentials(instance)
+	if err != nil {
+		return false, err
+	}
+
+	opts := subclouds.ConfigureOpts{
+		DeployConfig:     *instance.Spec.DeployConfig,
+		SysadminPassword: credentials.sysadminPassword,
+	}
+
+	logSubcloud.Info("configuring subcloud", "name", instance.Name)
+
+	result, err := subclouds.Configure(client, instance.Name, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to configure subcloud: %s", instance.Name)
+		return false, err
+	}
+
+	*subcloud = *result
+	setSubcloudStatus(status, subcloud)
+	status.DeployConfigHash = &hash
+
+	r.NormalEvent(instance, common.ResourceUpdated,
+		"subcloud deploy config has been applied")
+
+	return true, nil
+}
+
+// ReconcileAttributes is a method which handles reconciling the descriptive
+// attributes and the management state of a subcloud.  A subcloud can only
+// be managed once it is online.
+func (r *SubcloudReconciler) ReconcileAttributes(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) error {
+	if opts, ok := subcloudUpdateOpts(instance, subcloud); ok {
+		logSubcloud.Info("updating subcloud", "name", instance.Name, "opts", opts)
+
+		result, err := subclouds.Update(client, instance.Name, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update subcloud: %s", instance.Name)
+			return err
+		}
+
+		*subcloud = *result
+
+		r.NormalEvent(instance, common.ResourceUpdated,
+			"subcloud attributes have been updated")
+	}
+
+	desired := instance.DesiredManagement()
+	if subcloud.ManagementState == desired {
+		return nil
+	}
+
+	if desired == starlingxv1.SubcloudManaged && subcloud.AvailabilityStatus != subclouds.AvailabilityOnline {
+		return r.waitForSubcloud(instance, subcloud, "to be online before managing it")
+	}
+
+	logSubcloud.Info("changing subcloud management state", "name", instance.Name, "state", desired)
+
+	opts := subclouds.UpdateOpts{ManagementState: &desired}
+	result, err := subclouds.Update(client, instance.Name, opts).Extract()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to change subcloud management state to %s", desired)
+		return err
+	}
+
+	*subcloud = *result
+
+	r.NormalEvent(instance, common.ResourceUpdated,
+		"subcloud is now %s", desired)
+
+	return nil
+}
+
+// ReconcileBackup is a method which requests a new subcloud backup whenever
+// the backup request value of the resource has changed.
+func (r *SubcloudReconciler) ReconcileBackup(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) error {
+	backup := instance.Spec.Backup
+	status := &instance.Status
+
+	if subcloud.BackupInProgress() {
+		return r.waitForSubcloud(instance, subcloud, "backup to complete")
+	}
+
+	if backup == nil || backup.Request == nil {
+		return nil
+	} else if status.BackupRequest != nil && *status.BackupRequest == *backup.Request {
+		return nil
+	}
+
+	if !subcloudOnlineAndManaged(subcloud) {
+		if instance.DesiredManagement() != starlingxv1.SubcloudManaged {
+			msg := "subcloud must be managed to be backed up"
+			return common.NewValidationError(msg)
+		}
+		return r.waitForSubcloud(instance, subcloud, "to be online and managed before backing it up")
+	}
+
+	credentials, err := r.getSubcloudCredentials(instance)
+	if err != nil {
+		return err
+	}
+
+	opts := subclouds.BackupOpts{
+		Subcloud:         instance.Name,
+		SysadminPassword: credentials.sysadminPassword,
+	}
+
+	if backup.LocalOnly != nil {
+		opts.LocalOnly = *backup.LocalOnly
+	}
+	if backup.RegistryImages != nil {
+		opts.RegistryImages = *backup.RegistryImages
+	}
+	if backup.Values != nil {
+		opts.BackupValues = *backup.Values
+	}
+
+	logSubcloud.Info("backing up subcloud", "name", instance.Name, "request", *backup.Request)
+
+	err = subclouds.CreateBackup(client, opts).ExtractErr()
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to back up subcloud: %s", instance.Name)
+		return err
+	}
+
+	request := *backup.Request
+	status.BackupRequest = &request
+
+	r.NormalEvent(instance, common.ResourceUpdated,
+		"subcloud backup %q has been requested", request)
+
+	// The backup status is not returned by the backup request so refresh the
+	// subcloud before monitoring it.
+	result, err := findSubcloud(client, instance.Name)
+	if err == nil && result != nil {
+		*subcloud = *result
+		setSubcloudStatus(status, subcloud)
+	}
+
+	return r.waitForSubcloud(instance, subcloud, "backup to complete")
+}
+
+// ReconcileExisting is a method which handles reconciling an existing
+// subcloud.  The deploy config, attributes, management state, backups, and
+// deployment bundle are reconciled in that order since each step depends on
+// the state reached by the previous one.
+func (r *SubcloudReconciler) ReconcileExisting(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) error {
+	status := &instance.Status
+
+	setSubcloudStatus(status, subcloud)
+
+	if subcloud.DeployInProgress() {
+		return r.waitForSubcloud(instance, subcloud, "deployment to complete")
+	}
+
+	configured, err := r.ReconcileDeployConfig(client, instance, subcloud)
+	if err != nil {
+		return err
+	} else if configured {
+		return r.waitForSubcloud(instance, subcloud, "deployment to complete")
+	}
+
+	if subcloud.DeployFailed() {
+		msg := fmt.Sprintf("subcloud deployment failed: %s", subcloud.DeployStatus)
+		return common.NewUserDataError(msg)
+	}
+
+	err = r.ReconcileAttributes(client, instance, subcloud)
+	setSubcloudStatus(status, subcloud)
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcileBackup(client, instance, subcloud)
+	setSubcloudStatus(status, subcloud)
+	if err != nil {
+		return err
+	}
+
+	return r.ReconcileBundle(instance, subcloud)
+}
+
+// Removes the subcloud finalizer
+func (r *SubcloudReconciler) removeSubcloudFinalizer(instance *starlingxv1.Subcloud) {
+	// Remove the finalizer so the kubernetes delete operation can continue.
+	instance.Finalizers = utils.RemoveString(instance.Finalizers, SubcloudFinalizerName)
+	if err := r.Update(context.Background(), instance); err != nil {
+		logSubcloud.Error(err, "failed to remove the finalizer in the subcloud because of the error:%v")
+	}
+}
+
+// ReconciledDeleted is a method which handles reconciling a deleted subcloud.
+// The subcloud must be unmanaged before it can be removed from the system
+// controller and it cannot be removed while it is being deployed.
+func (r *SubcloudReconciler) ReconciledDeleted(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) error {
+	if !utils.ContainsString(instance.Finalizers, SubcloudFinalizerName) {
+		return nil
+	}
+
+	if subcloud != nil {
+		if subcloud.DeployInProgress() {
+			return r.waitForSubcloud(instance, subcloud, "deployment to complete before deleting it")
+		}
+
+		if subcloud.ManagementState == subclouds.ManagementManaged {
+			state := starlingxv1.SubcloudUnmanaged
+			opts := subclouds.UpdateOpts{ManagementState: &state}
+			_, err := subclouds.Update(client, instance.Name, opts).Extract()
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to unmanage subcloud: %s", instance.Name)
+				return err
+			}
+		}
+
+		err := subclouds.Delete(client, instance.Name).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete subcloud: %s", instance.Name)
+			return err
+		}
+
+		r.NormalEvent(instance, common.ResourceDeleted, "subcloud has been deleted")
+	}
+
+	r.removeSubcloudFinalizer(instance)
+
+	return nil
+}
+
+// statusUpdateRequired is a utility function which determines whether an update
+// is required to the subcloud status attribute.  Updating this unnecessarily
+// will result in an infinite reconciliation loop.
+func (r *SubcloudReconciler) statusUpdateRequired(instance *starlingxv1.Subcloud, original *starlingxv1.SubcloudStatus, inSync bool) bool {
+	status := &instance.Status
+
+	status.InSync = inSync
+
+	if status.InSync && !status.Reconciled {
+		// Record the fact that we have reached inSync at least once.
+		status.Reconciled = true
+	}
+
+	status.ObservedGeneration = instance.Generation
+
+	return !status.DeepEqual(original)
+}
+
+// ReconcileResource interacts with the dcmanager API in order to reconcile
+// the state of a subcloud with the state stored in the k8s database.
+func (r *SubcloudReconciler) ReconcileResource(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud) error {
+	subcloud, err := findSubcloud(client, instance.Name)
+	if err != nil {
+		return err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.ReconciledDeleted(client, instance, subcloud)
+	}
+
+	original := instance.Status.DeepCopy()
+	status := &instance.Status
+
+	if subcloud == nil {
+		err = r.ReconcileNew(client, instance)
+	} else {
+		err = r.ReconcileExisting(client, instance, subcloud)
+	}
+
+	inSync := err == nil &&
+		status.DeployStatus != nil && *status.DeployStatus == subclouds.DeployStatusComplete &&
+		status.ManagementState != nil && *status.ManagementState == instance.DesiredManagement()
+
+	if instance.Status.InSync != inSync {
+		r.NormalEvent(instance, common.ResourceUpdated, "synchronization has changed to: %t", inSync)
+	}
+
+	if r.statusUpdateRequired(instance, original, inSync) {
+		logSubcloud.Info("updating subcloud", "status", instance.Status)
+
+		err2 := r.Client.Status().Update(context.TODO(), instance)
+		if err2 != nil {
+			err2 = perrors.Wrapf(err2, "failed to update status: %s",
+				instance.Name)
+			return err2
+		}
+	}
+
+	return err
+}
+
+// Reconcile reads that state of the cluster for a Subcloud object and makes changes based on the state read
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=subclouds,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=subclouds/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=starlingx.windriver.com,resources=subclouds/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create
+func (r *SubcloudReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	savedLog := logSubcloud
+	logSubcloud = logSubcloud.WithName(request.String())
+	defer func() { logSubcloud = savedLog }()
+
+	// Fetch the Subcloud instance
+	instance := &starlingxv1.Subcloud{}
+	err := r.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically
+			// garbage collected. For additional cleanup logic use finalizers.
+			return reconcile.Result{}, nil
+		}
+
+		logSubcloud.Error(err, "unable to read object: %v", request)
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of dcmanager API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system controller nor the resource is modified.
+		if !utils.IsReconcilerEnabled(utils.Subcloud) {
+			return reconcile.Result{}, nil
+		}
+
+		if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+			return reconcile.Result{}, nil
+		}
+
+		if r.GetPlatformClient(request.Namespace) == nil {
+			r.WarningEvent(instance, common.ResourceDependency,
+				"waiting for platform client creation")
+			return common.RetryMissingClient, nil
+		}
+
+		dcClient, err := r.getDCManagerClient(request.Namespace)
+		if err != nil {
+			return r.HandleReconcilerError(request, err)
+		}
+
+		err = r.ReconcilePlan(dcClient, instance)
+		return reconcile.Result{}, err
+	}
+
+	if instance.DeletionTimestamp.IsZero() {
+		// Ensure that the object has a finalizer setup as a pre-delete hook so
+		// that we can delete any subcloud that we previously added.
+		if !utils.ContainsString(instance.Finalizers, SubcloudFinalizerName) {
+			instance.Finalizers = append(instance.Finalizers, SubcloudFinalizerName)
+			if err := r.Update(context.Background(), instance); err != nil {
+				return reconcile.Result{}, err
+			}
+
+			// Might as well return immediately as the update is going to cause
+			// another reconcile event for this resource and we don't want to
+			// access the system API more than necessary.
+			return reconcile.Result{}, nil
+		}
+	}
+
+	if !instance.DeletionTimestamp.IsZero() && starlingxv1.IsOrphanDeletion(instance) {
+		// The resource is being released without removing it from the
+		// system controller so the only thing left to do is to drop the
+		// finalizer.
+		if utils.ContainsString(instance.Finalizers, SubcloudFinalizerName) {
+			r.NormalEvent(instance, common.ResourceDeleted,
+				"subcloud orphaned; system resource left in place")
+			r.removeSubcloudFinalizer(instance)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if !utils.IsReconcilerEnabled(utils.Subcloud) {
+		return reconcile.Result{}, nil
+	}
+
+	if r.GetPlatformClient(request.Namespace) == nil {
+		// The client has not been authenticated by the system controller so
+		// wait.
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform client creation")
+		return common.RetryMissingClient, nil
+	}
+
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !r.GetSystemReady(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for system reconciliation")
+		return common.RetrySystemNotReady, nil
+	}
+
+	if r.GetUpgradeInProgress(request.Namespace) {
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
+	dcClient, err := r.getDCManagerClient(request.Namespace)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	err = r.ReconcileResource(dcClient, instance)
+	if err != nil {
+		switch perrors.Cause(err).(type) {
+		case gophercloud.ErrDefault401, *url.Error:
+			// Force the dcmanager client to be rebuilt on the next attempt
+			// since its credentials or endpoint are no longer valid.
+			delete(r.dcClients, request.Namespace)
+		}
+		return r.HandleReconcilerError(request, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ReconcilePlan runs the subcloud reconciliation against plan mode clients
+// and publishes the dcmanager API requests that it would have issued in the
+// resource status.
+func (r *SubcloudReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.Subcloud) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logSubcloud)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy())
+
+	return p.Publish(r.Client, instance, result)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SubcloudReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	tMgr := cloudManager.GetInstance(mgr)
+	r.Client = mgr.GetClient()
+	r.Scheme = mgr.GetScheme()
+	r.CloudManager = tMgr
+	r.ReconcilerErrorHandler = &common.ErrorHandler{
+		CloudManager: tMgr,
+		Logger:       logSubcloud}
+	r.ReconcilerEventLogger = &common.EventLogger{
+		EventRecorder: mgr.GetEventRecorderFor(SubcloudControllerName),
+		Logger:        logSubcloud}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&starlingxv1.Subcloud{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.SubcloudList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
+		Complete(r)
+}
@@ -0,0 +1,214 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+)
+
+// subcloudFixture records the requests received by a fake dcmanager API
+// server and defines the subcloud that it reports.
+type subcloudFixture struct {
+	requests []string
+	subcloud string
+}
+
+func newSubcloudFixtureServer(fixture *subcloudFixture) (*httptest.Server, *gophercloud.ServiceClient) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fixture.requests = append(fixture.requests, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/subcloud-backup":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet && fixture.subcloud == "":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_, _ = fmt.Fprint(w, fixture.subcloud)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	sc := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{TokenID: "test-token"},
+		Endpoint:       server.URL + "/",
+	}
+	return server, sc
+}
+
+func newSubcloudReconciler(dm *cloudManager.Dummymanager) *SubcloudReconciler {
+	logger := log.Log.WithName("test")
+	return &SubcloudReconciler{
+		Client:       k8sClient,
+		CloudManager: dm,
+		ReconcilerErrorHandler: &common.ErrorHandler{
+			CloudManager: dm,
+			Logger:       logger,
+		},
+		ReconcilerEventLogger: &common.EventLogger{
+			EventRecorder: record.NewFakeRecorder(100),
+			Logger:        logger,
+		},
+	}
+}
+
+var _ = Describe("Subcloud controller", func() {
+	var (
+		server     *httptest.Server
+		gcClient   *gophercloud.ServiceClient
+		fixture    *subcloudFixture
+		dm         *cloudManager.Dummymanager
+		reconciler *SubcloudReconciler
+		instance   *starlingxv1.Subcloud
+		secret     *v1.Secret
+	)
+
+	BeforeEach(func() {
+		fixture = &subcloudFixture{}
+		server, gcClient = newSubcloudFixtureServer(fixture)
+		dm = &cloudManager.Dummymanager{}
+		reconciler = newSubcloudReconciler(dm)
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "subcloud1-credentials", Namespace: "default"},
+			Data: map[string][]byte{
+				starlingxv1.SubcloudSysadminPasswordKey: []byte("St8rlingX*"),
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+		instance = &starlingxv1.Subcloud{
+			ObjectMeta: metav1.ObjectMeta{Name: "subcloud1", Namespace: "default", Generation: 1},
+			Spec: starlingxv1.SubcloudSpec{
+				BootstrapAddress: "10.10.10.2",
+				BootstrapValues:  "name: subcloud1\n",
+				Credentials:      starlingxv1.SubcloudCredentialsInfo{Secret: secret.Name},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(k8sClient.Delete(context.Background(), secret)).To(Succeed())
+	})
+
+	Describe("parseBundle", func() {
+		It("should drop the namespace and empty documents", func() {
+			bundle := `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: deployment
+---
+apiVersion: starlingx.windriver.com/v1
+kind: System
+metadata:
+  name: subcloud1
+  namespace: deployment
+---
+`
+			objects, err := parseBundle(bundle)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objects).To(HaveLen(1))
+			Expect(objects[0].GetKind()).To(Equal("System"))
+		})
+
+		It("should reject resources without a name", func() {
+			_, err := parseBundle("apiVersion: v1\nkind: Secret\n")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("getSubcloudCredentials", func() {
+		It("should require the BMC password for remote installs", func() {
+			instance.Spec.InstallValues = ptr.To("bmc_address: 10.10.10.3\n")
+			_, err := reconciler.getSubcloudCredentials(instance)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ReconcileNew", func() {
+		It("should add the subcloud and monitor its deployment", func() {
+			fixture.subcloud = `{"id": 3, "name": "subcloud1", "deploy-status": "pre-install"}`
+			instance.Spec.DeployConfig = ptr.To("hosts: []\n")
+			err := reconciler.ReconcileNew(gcClient, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("POST /subclouds"))
+			Expect(*instance.Status.ID).To(Equal(3))
+			Expect(*instance.Status.DeployStatus).To(Equal("pre-install"))
+			Expect(*instance.Status.DeployConfigHash).To(Equal(hashString("hosts: []\n")))
+			Expect(dm.MonitorStarted).To(BeTrue())
+		})
+	})
+
+	Describe("ReconcileExisting", func() {
+		It("should manage an online subcloud", func() {
+			fixture.subcloud = `{"id": 3, "name": "subcloud1", "deploy-status": "complete",
+				"availability-status": "online", "management-state": "managed"}`
+			subcloud := &subclouds.Subcloud{ID: 3, Name: "subcloud1",
+				DeployStatus:       subclouds.DeployStatusComplete,
+				AvailabilityStatus: subclouds.AvailabilityOnline,
+				ManagementState:    subclouds.ManagementUnmanaged}
+			err := reconciler.ReconcileExisting(gcClient, instance, subcloud)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("PATCH /subclouds/subcloud1"))
+			Expect(*instance.Status.ManagementState).To(Equal(subclouds.ManagementManaged))
+		})
+
+		It("should wait for an offline subcloud before managing it", func() {
+			subcloud := &subclouds.Subcloud{ID: 3, Name: "subcloud1",
+				DeployStatus:       subclouds.DeployStatusComplete,
+				AvailabilityStatus: subclouds.AvailabilityOffline,
+				ManagementState:    subclouds.ManagementUnmanaged}
+			err := reconciler.ReconcileExisting(gcClient, instance, subcloud)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(BeEmpty())
+			Expect(dm.MonitorStarted).To(BeTrue())
+		})
+
+		It("should report a failed deployment", func() {
+			subcloud := &subclouds.Subcloud{ID: 3, Name: "subcloud1",
+				DeployStatus: "bootstrap-failed"}
+			err := reconciler.ReconcileExisting(gcClient, instance, subcloud)
+			Expect(err).To(HaveOccurred())
+			Expect(fixture.requests).To(BeEmpty())
+		})
+
+		It("should request a backup once per request value", func() {
+			fixture.subcloud = `{"id": 3, "name": "subcloud1", "deploy-status": "complete",
+				"availability-status": "online", "management-state": "managed",
+				"backup-status": "backing-up"}`
+			instance.Spec.Backup = &starlingxv1.SubcloudBackupInfo{Request: ptr.To("2026-01-01")}
+			subcloud := &subclouds.Subcloud{ID: 3, Name: "subcloud1",
+				DeployStatus:       subclouds.DeployStatusComplete,
+				AvailabilityStatus: subclouds.AvailabilityOnline,
+				ManagementState:    subclouds.ManagementManaged}
+			err := reconciler.ReconcileExisting(gcClient, instance, subcloud)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(ConsistOf("POST /subcloud-backup", "GET /subclouds/subcloud1"))
+			Expect(*instance.Status.BackupRequest).To(Equal("2026-01-01"))
+			Expect(*instance.Status.BackupStatus).To(Equal(subclouds.BackupStatusBackingUp))
+
+			fixture.requests = nil
+			subcloud.BackupStatus = "complete-central"
+			err = reconciler.ReconcileExisting(gcClient, instance, subcloud)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fixture.requests).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,102 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/subclouds"
+)
+
+// DefaultSubcloudMonitorInterval represents the default interval between
+// polling attempts to check the progress of a subcloud.  Installing and
+// deploying a subcloud takes a long time therefore there is no need to poll
+// this frequently.
+const DefaultSubcloudMonitorInterval = 30 * time.Second
+
+// subcloudMonitor waits for a subcloud to change state.  Whenever its deploy
+// status, availability, management state, or backup status changes a
+// reconcilable event is generated to kick the reconciler so that the change
+// is reflected in the resource status.
+type subcloudMonitor struct {
+	manager.CommonMonitorBody
+	manager      manager.CloudManager
+	namespace    string
+	name         string
+	deployStatus string
+	availability string
+	management   string
+	backupStatus string
+	dcClient     *gophercloud.ServiceClient
+}
+
+// NewSubcloudMonitor defines a convenience function to instantiate a new
+// subcloud monitor with all required attributes.  The monitor stops as soon
+// as the subcloud differs from the one that was last reported.
+func NewSubcloudMonitor(instance *starlingxv1.Subcloud, subcloud *subclouds.Subcloud) *manager.Monitor {
+	logger := logSubcloud.WithName("subcloud-monitor")
+	return &manager.Monitor{
+		MonitorBody: &subcloudMonitor{
+			namespace:    instance.Namespace,
+			name:         instance.Name,
+			deployStatus: subcloud.DeployStatus,
+			availability: subcloud.AvailabilityStatus,
+			management:   subcloud.ManagementState,
+			backupStatus: subcloud.BackupStatus,
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultSubcloudMonitorInterval,
+	}
+}
+
+// SetManager implements the MonitorManager interface so that the monitor can
+// build its own dcmanager client.
+func (m *subcloudMonitor) SetManager(manager manager.CloudManager) {
+	m.manager = manager
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.  The supplied client is a system API client therefore a
+// dcmanager client is built on first use.
+func (m *subcloudMonitor) Run(_ *gophercloud.ServiceClient) (stop bool, err error) {
+	if m.dcClient == nil {
+		m.dcClient, err = m.manager.BuildPlatformClient(m.namespace,
+			manager.DCManagerEndpointName, manager.DCManagerEndpointType)
+		if err != nil {
+			m.SetState("failed to build dcmanager client: %s", err.Error())
+			return false, err
+		}
+	}
+
+	subcloud, err := findSubcloud(m.dcClient, m.name)
+	if err != nil {
+		m.dcClient = nil
+		m.SetState("failed to get subcloud %s: %s", m.name, err.Error())
+		return false, err
+	}
+
+	if subcloud == nil {
+		m.SetState("subcloud %s no longer exists", m.name)
+		return true, nil
+	}
+
+	if subcloud.DeployStatus == m.deployStatus &&
+		subcloud.AvailabilityStatus == m.availability &&
+		subcloud.ManagementState == m.management &&
+		subcloud.BackupStatus == m.backupStatus {
+		m.SetState("waiting for subcloud %s to leave the %s/%s state",
+			m.name, subcloud.DeployStatus, subcloud.AvailabilityStatus)
+		return false, nil
+	}
+
+	m.SetState("subcloud %s has reached the %s/%s state",
+		m.name, subcloud.DeployStatus, subcloud.AvailabilityStatus)
+
+	return true, nil
+}
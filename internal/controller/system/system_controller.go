@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ptp"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/serviceparameters"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagebackends"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagetiers"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/system"
 	"github.com/imdario/mergo"
 	perrors "github.com/pkg/errors"
@@ -37,12 +39,16 @@ import (
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/lvgs"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/remotelogging"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/tiers"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -270,6 +276,92 @@ func (r *SystemReconciler) ReconcileStorageBackends(client *gophercloud.ServiceC
 	return nil
 }
 
+// ReconcileStorageTiers configures the Ceph storage tiers to align with the
+// desired list of tiers.  Tiers are created on existing clusters only; the
+// clusters themselves are created by the system when the storage backends
+// are configured.  The default tier of each cluster is never deleted.
+func (r *SystemReconciler) ReconcileStorageTiers(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
+	if !utils.IsReconcilerEnabled(utils.StorageTiers) {
+		return nil
+	}
+
+	if spec.Storage == nil || spec.Storage.Tiers == nil {
+		return nil
+	}
+
+	updated := false
+	present := make(map[string]bool)
+	for _, tierInfo := range spec.Storage.Tiers {
+		clusterName := tierInfo.GetClusterName()
+		cluster := info.FindClusterByName(clusterName)
+		if cluster == nil {
+			msg := fmt.Sprintf("waiting for the %q cluster to be created before adding the %q storage tier",
+				clusterName, tierInfo.Name)
+			return common.NewResourceStatusDependency(msg)
+		}
+
+		if tier := info.FindStorageTier(cluster.ID, tierInfo.Name); tier != nil {
+			present[tier.ID] = true
+			continue
+		}
+
+		opts := tiers.StorageTierOpts{
+			Name:      tierInfo.Name,
+			ClusterID: cluster.ID,
+		}
+
+		logSystem.Info("creating storage tier", "opts", opts)
+
+		result, err := tiers.Create(client, opts).Extract()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to create storage tier: %s",
+				common.FormatStruct(opts))
+			return err
+		}
+
+		present[result.ID] = true
+		updated = true
+		r.NormalEvent(instance, common.ResourceCreated,
+			"storage tier %q has been created in cluster %q", result.Name, clusterName)
+	}
+
+	for _, tier := range info.StorageTiers {
+		if present[tier.ID] || tier.Name == storagetiers.StorageTierName {
+			continue
+		}
+
+		if tiers.InUse(&tier) {
+			// OSDs must first be removed from the tier by the host
+			// reconciler.  Deleting the tier is retried on a later pass.
+			r.WarningEvent(instance, common.ResourceDependency,
+				"storage tier %q cannot be deleted while OSDs are bound to it", tier.Name)
+			continue
+		}
+
+		logSystem.Info("deleting storage tier", "uuid", tier.ID, "name", tier.Name)
+
+		err := tiers.Delete(client, tier.ID).ExtractErr()
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to delete storage tier: %s", tier.ID)
+			return err
+		}
+
+		updated = true
+		r.NormalEvent(instance, common.ResourceDeleted,
+			"storage tier %q has been deleted", tier.Name)
+	}
+
+	if updated {
+		err := info.PopulateStorageTiers(client)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to refresh storage tiers")
+			return err
+		}
+	}
+
+	return nil
+}
+
 // dnsUpdateRequired determines whether an update is required to the DNS
 // system attributes and returns the attributes to be changed if an update
 // is necessary.
@@ -400,6 +492,68 @@ func (r *SystemReconciler) ReconcilePTP(client *gophercloud.ServiceClient, insta
 	return nil
 }
 
+// remoteLoggingUpdateRequired determines whether an update is required to the
+// remote logging system attributes and returns the attributes to be changed if
+// an update is necessary.
+func remoteLoggingUpdateRequired(spec *starlingxv1.RemoteLoggingInfo, r *remotelogging.RemoteLogging) (opts remotelogging.RemoteLoggingOpts, result bool) {
+	if spec != nil {
+		if spec.Server != nil && *spec.Server != r.IPAddress {
+			opts.IPAddress = spec.Server
+			result = true
+		}
+
+		if spec.Transport != nil && *spec.Transport != r.Transport {
+			opts.Transport = spec.Transport
+			result = true
+		}
+
+		if spec.Port != nil && *spec.Port != r.Port {
+			opts.Port = spec.Port
+			result = true
+		}
+
+		if spec.Enabled != nil && *spec.Enabled != r.Enabled {
+			opts.Enabled = spec.Enabled
+			result = true
+		}
+	}
+
+	return opts, result
+}
+
+// ReconcileRemoteLogging configures the system resources to align with the
+// desired remote logging state.  The CA certificate required by the tls
+// transport is installed beforehand as part of the system certificates.
+func (r *SystemReconciler) ReconcileRemoteLogging(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
+	if !utils.IsReconcilerEnabled(utils.RemoteLogging) {
+		return nil
+	}
+
+	if spec.RemoteLogging == nil {
+		return nil
+	}
+
+	if info.RemoteLogging == nil {
+		msg := "waiting for the system remote logging configuration to be available"
+		return common.NewSystemDependency(msg)
+	}
+
+	if opts, ok := remoteLoggingUpdateRequired(spec.RemoteLogging, info.RemoteLogging); ok {
+		logSystem.Info("updating remote logging config", "opts", opts)
+
+		result, err := remotelogging.Update(client, info.RemoteLogging.ID, opts).Extract()
+		if err != nil {
+			return err
+		}
+
+		info.RemoteLogging = result
+
+		r.NormalEvent(instance, common.ResourceUpdated, "remote logging has been updated")
+	}
+
+	return nil
+}
+
 // serviceparametersUpdateRequired determines whether an update is required to the ServiceParameter
 // and returns the field to be changed if an update is necessary.
 // Only the value for a serviceparameter can be changed at this time.
@@ -434,18 +588,95 @@ func (r *SystemReconciler) RefreshServiceParameterList(client *gophercloud.Servi
 		err = perrors.Wrap(err, "failed to refresh service parameters list")
 		return err
 	}
+
+	owned, err := r.platformUsersServiceParameters(instance.Namespace)
+	if err != nil {
+		return err
+	}
+
 	r.NormalEvent(instance, common.ResourceUpdated, "ServiceParameter list info has been updated")
-	info.ServiceParameters = result
+	info.ServiceParameters = excludeServiceParameters(result, owned)
 	return nil
 }
 
+// platformUsersServiceParameters returns the service parameters declared by
+// the PlatformUsers resources of a namespace.  These are owned by the platform
+// users reconciler and are therefore neither compared nor modified by the
+// system reconciler.
+func (r *SystemReconciler) platformUsersServiceParameters(namespace string) (map[utils.ServiceParam]bool, error) {
+	list := &starlingxv1.PlatformUsersList{}
+	err := r.List(context.TODO(), list, client.InNamespace(namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list platform users")
+		return nil, err
+	}
+
+	result := make(map[utils.ServiceParam]bool)
+	for i := range list.Items {
+		for name := range list.Items[i].SecurityComplianceParameters() {
+			result[utils.ServiceParam{
+				Service:   utils.ServiceTypeIdentity,
+				Section:   utils.ServiceParamSectionSecurityCompliance,
+				ParamName: name,
+			}] = true
+		}
+	}
+
+	return result, nil
+}
+
+// excludeServiceParameters removes the service parameters owned by another
+// resource from a list of system service parameters.
+func excludeServiceParameters(list []serviceparameters.ServiceParameter, owned map[utils.ServiceParam]bool) []serviceparameters.ServiceParameter {
+	result := make([]serviceparameters.ServiceParameter, 0, len(list))
+	for _, p := range list {
+		if !owned[utils.ServiceParam{Service: p.Service, Section: p.Section, ParamName: p.ParamName}] {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// excludeServiceParameterInfo removes the service parameters owned by another
+// resource from a list of desired service parameters.
+func excludeServiceParameterInfo(list starlingxv1.ServiceParameterList, owned map[utils.ServiceParam]bool) starlingxv1.ServiceParameterList {
+	if list == nil {
+		return nil
+	}
+
+	result := make(starlingxv1.ServiceParameterList, 0, len(list))
+	for _, p := range list {
+		if !owned[utils.ServiceParam{Service: p.Service, Section: p.Section, ParamName: p.ParamName}] {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
 // ReconcileServiceParameters configures the system resources to align with the desired ServiceParameter state.
 func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
 	if !utils.IsReconcilerEnabled(utils.ServiceParameters) {
 		return nil
 	}
 	updated := false
-	kubeApiserverUpdated := false
+	applyServices := make(map[string]bool)
+	lockRequired := false
+
+	// recordImpact tracks what is required for a modified parameter to take
+	// effect according to the service parameter catalog.
+	recordImpact := func(sp *starlingxv1.ServiceParameterInfo) {
+		definition := utils.LookupServiceParameter(sp.Service, sp.Section, sp.ParamName)
+		if definition == nil {
+			return
+		}
+		if definition.RequiresApply() {
+			applyServices[sp.Service] = true
+		}
+		if definition.RequiresLock() {
+			lockRequired = true
+		}
+	}
+
 	for _, spec_sp := range spec.ServiceParameters {
 		found := false
 		for _, info_sp := range info.ServiceParameters {
@@ -461,10 +692,7 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 					}
 					// success
 					updated = true
-					if spec_sp.Service == utils.ServiceTypeKubernetes &&
-						spec_sp.Section == utils.ServiceParamSectionKubernetesApiserver {
-						kubeApiserverUpdated = true
-					}
+					recordImpact(&spec_sp)
 					r.NormalEvent(instance, common.ResourceUpdated, "ServiceParameter %q %q %q has been modified", result.Service, result.Section, result.ParamName)
 				}
 				break
@@ -487,10 +715,7 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 			}
 			// success
 			updated = true
-			if spec_sp.Service == utils.ServiceTypeKubernetes &&
-				spec_sp.Section == utils.ServiceParamSectionKubernetesApiserver {
-				kubeApiserverUpdated = true
-			}
+			recordImpact(&spec_sp)
 			r.NormalEvent(instance, common.ResourceCreated, "ServiceParameter %q %q %q has been created", result.Service, result.Section, result.ParamName)
 		}
 	}
@@ -526,7 +751,8 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 				Section:   info_sp.Section,
 				ParamName: info_sp.ParamName,
 			}
-			if starlingxv1.IsDefaultServiceParameter(&sp) {
+			if starlingxv1.IsDefaultServiceParameter(&sp) ||
+				!utils.IsServiceParameterDeletable(sp.Service, sp.Section, sp.ParamName) {
 				msg := fmt.Sprintf("it is unsafe to delete default service parameters: %q %q %q", info_sp.Service, info_sp.Section, info_sp.ParamName)
 				return common.NewUserDataError(msg)
 			}
@@ -550,18 +776,28 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 	}
 
 	// Apply service parameters for services that require an explicit apply
-	// to take effect. Kubernetes kube_apiserver parameters are not consumed
-	// by puppet classes during unlock; they require service-parameter-apply
-	// to trigger the change_k8s_control_plane_params.py script.
+	// to take effect according to the service parameter catalog.  For
+	// example, kubernetes kube_apiserver parameters are not consumed by
+	// puppet classes during unlock; they require service-parameter-apply to
+	// trigger the change_k8s_control_plane_params.py script.
 	//
 	// The apply is only issued when both conditions are met:
-	// 1. The user's deployment configuration explicitly contains
-	//    kubernetes/kube_apiserver service parameters (not inherited from
-	//    system defaults via the merge).
-	// 2. A kubernetes/kube_apiserver parameter was actually created or
-	//    updated during this reconciliation cycle.
-	if kubeApiserverUpdated && kubernetesServiceParametersInSpec(&instance.Spec) {
-		service := utils.ServiceTypeKubernetes
+	// 1. The user's deployment configuration explicitly contains service
+	//    parameters of that service that require an apply (not inherited
+	//    from system defaults via the merge).
+	// 2. Such a parameter was actually created or updated during this
+	//    reconciliation cycle.
+	services := make([]string, 0, len(applyServices))
+	for service := range applyServices {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	for _, service := range services {
+		if !applyServiceParametersInSpec(&instance.Spec, service) {
+			continue
+		}
+		service := service
 		opts := serviceparameters.ServiceApplyOpts{
 			Service: &service,
 		}
@@ -571,16 +807,35 @@ func (r *SystemReconciler) ReconcileServiceParameters(client *gophercloud.Servic
 		r.NormalEvent(instance, common.ResourceUpdated, "ServiceParameters have been applied for service %q", service)
 	}
 
+	if lockRequired && instance.Status.DeploymentScope == cloudManager.ScopePrincipal &&
+		instance.Status.StrategyRequired != cloudManager.StrategyLockRequired {
+		// The modified parameters only take effect once the hosts are
+		// locked and unlocked so request a strategy to do so.
+		r.SetStrategyExpectedByOtherReconcilers(true)
+		instance.Status.StrategyRequired = cloudManager.StrategyLockRequired
+		r.SetResourceInfo(cloudManager.ResourceSystem, "", instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
+		err := r.Client.Status().Update(context.TODO(), instance)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update status: %s",
+				common.FormatStruct(instance.Status))
+			return err
+		}
+		r.NormalEvent(instance, common.ResourceUpdated, "ServiceParameters require the hosts to be locked and unlocked")
+	}
+
 	return nil
 }
 
-// kubernetesServiceParametersInSpec returns true if the user's deployment
-// configuration explicitly contains kubernetes/kube_apiserver service
-// parameters that require an explicit apply.
-func kubernetesServiceParametersInSpec(spec *starlingxv1.SystemSpec) bool {
+// applyServiceParametersInSpec returns true if the user's deployment
+// configuration explicitly contains service parameters of a service that
+// require an explicit apply.
+func applyServiceParametersInSpec(spec *starlingxv1.SystemSpec, service string) bool {
 	for _, sp := range spec.ServiceParameters {
-		if sp.Service == utils.ServiceTypeKubernetes &&
-			sp.Section == utils.ServiceParamSectionKubernetesApiserver {
+		if sp.Service != service {
+			continue
+		}
+		definition := utils.LookupServiceParameter(sp.Service, sp.Section, sp.ParamName)
+		if definition != nil && definition.RequiresApply() {
 			return true
 		}
 	}
@@ -655,6 +910,93 @@ func (r *SystemReconciler) RefreshFilesystemsList(client *gophercloud.ServiceCli
 	return nil
 }
 
+// controllerVolumeGroupAvailableSize returns the smallest space left in the
+// cgts-vg volume group across all controllers since the controller
+// filesystems are allocated on each of them.
+func controllerVolumeGroupAvailableSize(client *gophercloud.ServiceClient) (int, error) {
+	objects, err := hosts.ListHosts(client)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return 0, err
+	}
+
+	available := -1
+	for _, host := range objects {
+		if host.Personality != hosts.PersonalityController {
+			continue
+		}
+
+		groups, err := lvgs.ListVolumeGroups(client, host.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list volume groups for host %s", host.ID)
+			return 0, err
+		}
+
+		size := 0
+		if vg, ok := lvgs.FindVolumeGroup(groups, common.LVG_CGTS_VG); ok {
+			size = vg.AvailableSize()
+		}
+
+		if available < 0 || size < available {
+			available = size
+		}
+	}
+
+	return max(available, 0), nil
+}
+
+// ResolveFileSystemSizes converts the size policies of the controller
+// filesystems into absolute sizes based on the current filesystem sizes and
+// the space left in the cgts-vg volume group of the controllers.  Filesystems
+// are resolved in order and the growth of each one reduces the space left for
+// the next ones.
+func ResolveFileSystemSizes(client *gophercloud.ServiceClient, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) error {
+	if spec.Storage == nil {
+		return nil
+	}
+
+	required := false
+	for _, fsInfo := range spec.Storage.FileSystems {
+		if fsInfo.SizePolicy != nil {
+			required = true
+			break
+		}
+	}
+
+	if !required {
+		return nil
+	}
+
+	available, err := controllerVolumeGroupAvailableSize(client)
+	if err != nil {
+		return err
+	}
+
+	for i := range spec.Storage.FileSystems {
+		fsInfo := &spec.Storage.FileSystems[i]
+		if fsInfo.SizePolicy == nil {
+			continue
+		}
+
+		current := 0
+		for _, fs := range info.FileSystems {
+			if fs.Name == fsInfo.Name {
+				current = fs.Size
+				break
+			}
+		}
+
+		size := fsInfo.SizePolicy.Resolve(fsInfo.Size, current, available)
+		available = max(available-(size-current), 0)
+
+		logSystem.V(2).Info("resolved filesystem size policy", "name", fsInfo.Name, "size", size)
+		fsInfo.Size = size
+		fsInfo.SizePolicy = nil
+	}
+
+	return nil
+}
+
 // ReconcileFilesystems configures the system resources to align with the
 // desired controller filesystem configuration.
 func (r *SystemReconciler) ReconcileFileSystems(client *gophercloud.ServiceClient, instance *starlingxv1.System, spec *starlingxv1.SystemSpec, info *v1info.SystemInfo) (err error) {
@@ -1092,6 +1434,11 @@ func (r *SystemReconciler) ReconcileSystemInitial(client *gophercloud.ServiceCli
 		return err
 	}
 
+	err = r.ReconcileRemoteLogging(client, instance, spec, info)
+	if err != nil {
+		return err
+	}
+
 	err = r.ReconcileServiceParameters(client, instance, spec, info)
 	if err != nil {
 		return err
@@ -1102,6 +1449,11 @@ func (r *SystemReconciler) ReconcileSystemInitial(client *gophercloud.ServiceCli
 		return err
 	}
 
+	err = r.ReconcileStorageTiers(client, instance, spec, info)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1118,6 +1470,31 @@ func (r *SystemReconciler) ReconcileSystemFinal(client *gophercloud.ServiceClien
 	return nil
 }
 
+// FixRemoteLoggingToManage aligns the remote logging configuration read from
+// the system with the attributes managed by the spec.  Attributes left unset
+// in the spec are not managed, and the certificate reference is not reported
+// by the system API; the certificate itself is compared as part of the system
+// certificate list.
+func FixRemoteLoggingToManage(spec, current *starlingxv1.RemoteLoggingInfo) {
+	if spec.Enabled == nil {
+		current.Enabled = nil
+	}
+
+	if spec.Server == nil {
+		current.Server = nil
+	}
+
+	if spec.Transport == nil {
+		current.Transport = nil
+	}
+
+	if spec.Port == nil {
+		current.Port = nil
+	}
+
+	current.Certificate = spec.Certificate
+}
+
 // Fixes extra certs from the response of the current configuration according to the certs specified in the profile.
 func FixCertsToManage(specCerts, currentCerts []starlingxv1.CertificateInfo) []starlingxv1.CertificateInfo {
 	certificateMap := make(map[string]bool)
@@ -1164,6 +1541,10 @@ func (r *SystemReconciler) ReconcileRequired(instance *starlingxv1.System, spec
 		current.Certificates = res
 	}
 
+	if spec.RemoteLogging != nil && current.RemoteLogging != nil {
+		FixRemoteLoggingToManage(spec.RemoteLogging, current.RemoteLogging)
+	}
+
 	logSystem.Info("spec is:", "values", spec)
 
 	logSystem.Info("current is:", "values", current)
@@ -1233,7 +1614,12 @@ func (r *SystemReconciler) statusUpdateRequired(instance *starlingxv1.System, in
 		// Record the fact that we have reached inSync at least once.
 		status.Reconciled = true
 		status.ConfigurationUpdated = false
-		status.StrategyRequired = cloudManager.StrategyNotRequired
+		if status.StrategyRequired != cloudManager.StrategyLockRequired ||
+			(!r.GetStrategyExpectedByOtherReconcilers() && !r.GetStrategySent()) {
+			// Keep a pending lock request raised by service parameters
+			// that only take effect once the hosts are locked and unlocked.
+			status.StrategyRequired = cloudManager.StrategyNotRequired
+		}
 		if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
 			r.SetResourceInfo(cloudManager.ResourceSystem, "", instance.Name, status.Reconciled, status.StrategyRequired)
 		}
@@ -1476,6 +1862,24 @@ func (r *SystemReconciler) ReconcileResource(client *gophercloud.ServiceClient,
 		return err
 	}
 
+	// Filesystem size policies are relative to the space available on the
+	// controllers so they are converted to absolute sizes before being
+	// compared against the current configuration.
+	err = ResolveFileSystemSizes(client, spec, &systemInfo)
+	if err != nil {
+		return err
+	}
+
+	// Service parameters declared by a PlatformUsers resource are owned by
+	// the platform users reconciler so they are ignored on both sides of the
+	// comparison.
+	owned, err := r.platformUsersServiceParameters(instance.Namespace)
+	if err != nil {
+		return err
+	}
+	spec.ServiceParameters = excludeServiceParameterInfo(spec.ServiceParameters, owned)
+	systemInfo.ServiceParameters = excludeServiceParameters(systemInfo.ServiceParameters, owned)
+
 	ready, err := r.ReconcileSystem(client, instance, spec, &systemInfo)
 	inSync := err == nil
 
@@ -1699,6 +2103,27 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 	r.CancelMonitor(instance)
 
 	platformClient := r.GetPlatformClient(request.Namespace)
+
+	planMode, err := common.IsPlanModeEnabled(r.Client, instance)
+	if err != nil {
+		return r.HandleReconcilerError(request, err)
+	}
+
+	if planMode {
+		// Compute the list of system API requests without executing them.
+		// Nothing else is updated while in plan mode so that neither the
+		// system nor the resource is modified.
+		if platformClient == nil {
+			platformClient, err = r.BuildPlatformClient(request.Namespace, cloudManager.SystemEndpointName, cloudManager.SystemEndpointType)
+			if err != nil {
+				return r.HandleReconcilerError(request, err)
+			}
+		}
+
+		err = r.ReconcilePlan(platformClient, instance, request)
+		return reconcile.Result{}, err
+	}
+
 	if err, _ := r.UpdateDeploymentScope(instance); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -1765,6 +2190,19 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		}
 	}
 
+	err = common.ClearPlan(r.Client, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if r.GetUpgradeInProgress(request.Namespace) {
+		// The system configuration must not be modified while the hosts are
+		// being upgraded.
+		r.WarningEvent(instance, common.ResourceDependency,
+			"waiting for platform upgrade to complete")
+		return common.RetryUpgradeInProgress, nil
+	}
+
 	// If strategy is applied, start strategy monitor
 	if instance.Status.StrategyApplied {
 		logSystem.Info("Strategy applied, start strategy monitor")
@@ -1782,6 +2220,22 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 	return ctrl.Result{}, nil
 }
 
+// ReconcilePlan runs the system reconciliation against plan mode clients and
+// publishes the system API requests that it would have issued in the
+// resource status.
+func (r *SystemReconciler) ReconcilePlan(client *gophercloud.ServiceClient, instance *starlingxv1.System, request ctrl.Request) error {
+	p := common.NewPlanner(r.Client, r.CloudManager, client, logSystem)
+
+	planner := *r
+	planner.Client = p.Client
+	planner.CloudManager = p.CloudManager
+	planner.ReconcilerEventLogger = p.EventLogger
+
+	result := planner.ReconcileResource(p.PlatformClient, instance.DeepCopy(), request)
+
+	return p.Publish(r.Client, instance, result)
+}
+
 // UpdateDeploymentScope function is used to update the deployment scope for System.
 func (r *SystemReconciler) UpdateDeploymentScope(instance *starlingxv1.System) (error, bool) {
 	updated, err := common.UpdateDeploymentScope(r.Client, instance)
@@ -1807,5 +2261,7 @@ func (r *SystemReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Logger:        logSystem}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.System{}).
+		Watches(&v1.Namespace{}, common.EnqueueNamespaceResources(mgr.GetClient(), &starlingxv1.SystemList{}),
+			builder.WithPredicates(common.PlanModeChangedPredicate)).
 		Complete(r)
 }
@@ -17,6 +17,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/remotelogging"
 )
 
 var _ = Describe("System controller", func() {
@@ -449,6 +451,64 @@ var _ = Describe("System controller", func() {
 		})
 	})
 
+	Describe("remoteLoggingUpdateRequired", func() {
+		Context("when spec is nil", func() {
+			It("should return false", func() {
+				_, result := remoteLoggingUpdateRequired(nil, &remotelogging.RemoteLogging{})
+				Expect(result).To(BeFalse())
+			})
+		})
+
+		Context("when server and transport differ", func() {
+			It("should return true with only the changed attributes set", func() {
+				server := "syslog.example.com"
+				transport := "tls"
+				port := 514
+				spec := &starlingxv1.RemoteLoggingInfo{Server: &server, Transport: &transport, Port: &port}
+				current := &remotelogging.RemoteLogging{IPAddress: "10.10.10.1", Transport: "udp", Port: 514}
+				opts, result := remoteLoggingUpdateRequired(spec, current)
+				Expect(result).To(BeTrue())
+				Expect(*opts.IPAddress).To(Equal(server))
+				Expect(*opts.Transport).To(Equal(transport))
+				Expect(opts.Port).To(BeNil())
+				Expect(opts.Enabled).To(BeNil())
+			})
+		})
+
+		Context("when all fields match", func() {
+			It("should return false", func() {
+				enabled := true
+				server := "10.10.10.1"
+				spec := &starlingxv1.RemoteLoggingInfo{Enabled: &enabled, Server: &server}
+				current := &remotelogging.RemoteLogging{IPAddress: "10.10.10.1", Enabled: true}
+				_, result := remoteLoggingUpdateRequired(spec, current)
+				Expect(result).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("FixRemoteLoggingToManage", func() {
+		It("should only compare the attributes managed by the spec", func() {
+			enabled := true
+			server := "10.10.10.1"
+			transport := "tcp"
+			port := 514
+			certificate := "syslog-ca"
+			spec := &starlingxv1.RemoteLoggingInfo{Server: &server, Certificate: &certificate}
+			current := &starlingxv1.RemoteLoggingInfo{Enabled: &enabled, Server: &server, Transport: &transport, Port: &port}
+			Expect(spec.DeepEqual(current)).To(BeFalse())
+			Expect(current.DeepEqual(spec)).To(BeFalse())
+
+			FixRemoteLoggingToManage(spec, current)
+			Expect(spec.DeepEqual(current)).To(BeTrue())
+			Expect(current.DeepEqual(spec)).To(BeTrue())
+
+			other := "10.10.10.2"
+			current.Server = &other
+			Expect(spec.DeepEqual(current)).To(BeFalse())
+		})
+	})
+
 	Describe("drbdUpdateRequired", func() {
 		Context("when storage is nil", func() {
 			It("should return false", func() {
@@ -565,6 +625,33 @@ var _ = Describe("System controller", func() {
 		})
 	})
 
+	Describe("excludeServiceParameters", func() {
+		It("should leave out the parameters owned by platform users", func() {
+			owned := map[utils.ServiceParam]bool{{
+				Service:   utils.ServiceTypeIdentity,
+				Section:   utils.ServiceParamSectionSecurityCompliance,
+				ParamName: utils.ServiceParamNameSecurityComplianceLockoutDuration,
+			}: true}
+
+			current := []serviceparameters.ServiceParameter{
+				{Service: utils.ServiceTypeIdentity, Section: utils.ServiceParamSectionSecurityCompliance,
+					ParamName: utils.ServiceParamNameSecurityComplianceLockoutDuration, ParamValue: "300"},
+				{Service: utils.ServiceTypeIdentity, Section: utils.ServiceParamSectionSecurityCompliance,
+					ParamName: utils.ServiceParamNameSecurityComplianceLockoutFailureAttempts, ParamValue: "3"},
+			}
+			result := excludeServiceParameters(current, owned)
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].ParamName).To(Equal(utils.ServiceParamNameSecurityComplianceLockoutFailureAttempts))
+
+			spec := starlingxv1.ServiceParameterList{
+				{Service: utils.ServiceTypeIdentity, Section: utils.ServiceParamSectionSecurityCompliance,
+					ParamName: utils.ServiceParamNameSecurityComplianceLockoutDuration, ParamValue: "1800"},
+			}
+			Expect(excludeServiceParameterInfo(spec, owned)).To(BeEmpty())
+			Expect(excludeServiceParameterInfo(nil, owned)).To(BeNil())
+		})
+	})
+
 	Describe("ControllerNodesAvailable", func() {
 		Context("when enough controllers are unlocked/enabled/available", func() {
 			It("should return true", func() {
@@ -659,7 +746,7 @@ var _ = Describe("System controller", func() {
 		})
 	})
 
-	Describe("kubernetesServiceParametersInSpec", func() {
+	Describe("applyServiceParametersInSpec", func() {
 		Context("when spec contains kubernetes/kube_apiserver parameters", func() {
 			It("should return true", func() {
 				spec := &starlingxv1.SystemSpec{
@@ -672,7 +759,7 @@ var _ = Describe("System controller", func() {
 						},
 					},
 				}
-				Expect(kubernetesServiceParametersInSpec(spec)).To(BeTrue())
+				Expect(applyServiceParametersInSpec(spec, utils.ServiceTypeKubernetes)).To(BeTrue())
 			})
 		})
 
@@ -694,7 +781,7 @@ var _ = Describe("System controller", func() {
 						},
 					},
 				}
-				Expect(kubernetesServiceParametersInSpec(spec)).To(BeTrue())
+				Expect(applyServiceParametersInSpec(spec, utils.ServiceTypeKubernetes)).To(BeTrue())
 			})
 		})
 
@@ -710,7 +797,7 @@ var _ = Describe("System controller", func() {
 						},
 					},
 				}
-				Expect(kubernetesServiceParametersInSpec(spec)).To(BeFalse())
+				Expect(applyServiceParametersInSpec(spec, utils.ServiceTypeKubernetes)).To(BeFalse())
 			})
 		})
 
@@ -726,14 +813,14 @@ var _ = Describe("System controller", func() {
 						},
 					},
 				}
-				Expect(kubernetesServiceParametersInSpec(spec)).To(BeFalse())
+				Expect(applyServiceParametersInSpec(spec, utils.ServiceTypeKubernetes)).To(BeFalse())
 			})
 		})
 
 		Context("when spec has no service parameters", func() {
 			It("should return false", func() {
 				spec := &starlingxv1.SystemSpec{}
-				Expect(kubernetesServiceParametersInSpec(spec)).To(BeFalse())
+				Expect(applyServiceParametersInSpec(spec, utils.ServiceTypeKubernetes)).To(BeFalse())
 			})
 		})
 	})
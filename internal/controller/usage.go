@@ -0,0 +1,397 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package controller
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresses"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaceDataNetworks"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaces"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// interfaceUsage defines the network resource references of a host interface
+// once the profile chain and the host overrides have been applied.
+type interfaceUsage struct {
+	platformNetworks []string
+	dataNetworks     []string
+	addressPools     []string
+}
+
+// usageChangedPredicate filters the host and host profile events which can
+// change the usage of a network resource.  Those are the changes to their
+// specification, and a host completing or losing its reconciliation since
+// that is when the system reports its interfaces as provisioned.  Host status
+// updates are otherwise frequent and do not affect the usage.
+var usageChangedPredicate = predicate.Or[client.Object](
+	predicate.GenerationChangedPredicate{},
+	predicate.Funcs{UpdateFunc: hostReconciledChanged})
+
+// hostReconciledChanged determines whether a host update changed its
+// reconciled state.
+func hostReconciledChanged(e event.UpdateEvent) bool {
+	before, ok := e.ObjectOld.(*starlingxv1.Host)
+	if !ok {
+		return false
+	}
+
+	after, ok := e.ObjectNew.(*starlingxv1.Host)
+	if !ok {
+		return false
+	}
+
+	return before.Status.Reconciled != after.Status.Reconciled
+}
+
+// hostInterfaceUsage returns the network resource references of each
+// interface of a host once its profile chain and overrides are merged the
+// same way that the host controller merges them.  A host whose profile cannot
+// be merged has no usage since the host controller already reports those
+// errors against the host.
+func hostInterfaceUsage(cl client.Client, host *starlingxv1.Host) (map[string]*interfaceUsage, error) {
+	profile, err := common.MergeHostProfile(cl, host, &starlingxv1.HostProfileSpec{})
+	if err != nil {
+		switch err.(type) {
+		case common.ErrResourceConfigurationDependency, common.ValidationError:
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result := make(map[string]*interfaceUsage)
+	for _, iface := range common.ProfileInterfaces(profile) {
+		result[iface.Name] = &interfaceUsage{
+			platformNetworks: iface.PlatformNetworks,
+			dataNetworks:     iface.DataNetworks,
+			addressPools:     iface.AddressPools}
+	}
+
+	return result, nil
+}
+
+// configuredConsumers returns the host interfaces of a namespace whose
+// configuration satisfies the uses predicate.
+func configuredConsumers(cl client.Client, namespace string, uses func(*interfaceUsage) bool) ([]starlingxv1.ResourceConsumer, error) {
+	list := &starlingxv1.HostList{}
+	err := cl.List(context.TODO(), list, client.InNamespace(namespace))
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return nil, err
+	}
+
+	result := make([]starlingxv1.ResourceConsumer, 0)
+	for i := range list.Items {
+		host := &list.Items[i]
+
+		usage, err := hostInterfaceUsage(cl, host)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, iface := range usage {
+			if uses(iface) {
+				result = append(result, starlingxv1.ResourceConsumer{
+					Host:       host.Name,
+					Interface:  name,
+					Configured: true})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// systemInterface defines the addresses and the data networks which the
+// system reports for a host interface.
+type systemInterface struct {
+	host         string
+	name         string
+	addresses    []string
+	dataNetworks []string
+}
+
+// systemUsageRefreshInterval defines how long a snapshot of the system
+// interfaces is reused before it is collected again.
+const systemUsageRefreshInterval = 30 * time.Second
+
+// systemUsageSnapshot defines the system interfaces of a namespace along with
+// the time at which they were collected.
+type systemUsageSnapshot struct {
+	collected  time.Time
+	interfaces []systemInterface
+}
+
+// systemUsageCache holds a snapshot of the system interfaces of each
+// namespace so that the address pools and data networks which are reconciled
+// for the same event share a single pass over the system API rather than each
+// listing every host.  The snapshot of a namespace is discarded whenever a
+// host or host profile event is mapped to the resources of the namespace.
+type systemUsageCache struct {
+	lock      sync.Mutex
+	snapshots map[string]*systemUsageSnapshot
+}
+
+// systemUsage is the snapshot cache shared by the usage reconcilers.
+var systemUsage = &systemUsageCache{snapshots: make(map[string]*systemUsageSnapshot)}
+
+// Invalidate discards the snapshot of a namespace so that the next reconciler
+// which needs it collects it again.
+func (c *systemUsageCache) Invalidate(namespace string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.snapshots, namespace)
+}
+
+// Interfaces returns the system interfaces of a namespace, collecting them if
+// there is no snapshot or if it is older than the refresh interval.
+func (c *systemUsageCache) Interfaces(platformClient *gophercloud.ServiceClient, namespace string) ([]systemInterface, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	snapshot, ok := c.snapshots[namespace]
+	if ok && time.Since(snapshot.collected) < systemUsageRefreshInterval {
+		return snapshot.interfaces, nil
+	}
+
+	result, err := listSystemInterfaces(platformClient)
+	if err != nil {
+		return nil, err
+	}
+
+	c.snapshots[namespace] = &systemUsageSnapshot{
+		collected:  time.Now(),
+		interfaces: result}
+
+	return result, nil
+}
+
+// listSystemInterfaces returns the addresses and data networks of every host
+// interface of the system.
+func listSystemInterfaces(platformClient *gophercloud.ServiceClient) ([]systemInterface, error) {
+	objects, err := hosts.ListHosts(platformClient)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to list hosts")
+		return nil, err
+	}
+
+	result := make([]systemInterface, 0)
+	for _, h := range objects {
+		if h.Hostname == "" {
+			continue
+		}
+
+		ifaces, err := interfaces.ListInterfaces(platformClient, h.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list interfaces for host %s", h.Hostname)
+			return nil, err
+		}
+
+		results, err := addresses.ListAddresses(platformClient, h.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list addresses for host %s", h.Hostname)
+			return nil, err
+		}
+
+		associations, err := interfaceDataNetworks.ListInterfaceDataNetworks(platformClient, h.ID)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to list interface data networks for host %s", h.Hostname)
+			return nil, err
+		}
+
+		names := make(map[string]string)
+		byName := make(map[string]*systemInterface)
+		lookup := func(ifname string) *systemInterface {
+			if _, ok := byName[ifname]; !ok {
+				byName[ifname] = &systemInterface{host: h.Hostname, name: ifname}
+			}
+			return byName[ifname]
+		}
+
+		for _, iface := range ifaces {
+			names[iface.ID] = iface.Name
+			lookup(iface.Name)
+		}
+
+		for _, addr := range results {
+			iface := lookup(addr.InterfaceName)
+			iface.addresses = append(iface.addresses, addr.Address)
+		}
+
+		for _, association := range associations {
+			if ifname, ok := names[association.InterfaceUUID]; ok {
+				iface := lookup(ifname)
+				iface.dataNetworks = append(iface.dataNetworks, association.DataNetworkName)
+			}
+		}
+
+		for _, iface := range byName {
+			result = append(result, *iface)
+		}
+	}
+
+	return result, nil
+}
+
+// dataNetworkSystemConsumers returns the host interfaces which the system
+// reports as assigned to a data network.
+func dataNetworkSystemConsumers(ifaces []systemInterface, name string) []starlingxv1.ResourceConsumer {
+	result := make([]starlingxv1.ResourceConsumer, 0)
+	for _, iface := range ifaces {
+		if utils.ContainsString(iface.dataNetworks, name) {
+			result = append(result, starlingxv1.ResourceConsumer{
+				Host:        iface.host,
+				Interface:   iface.name,
+				Provisioned: true})
+		}
+	}
+
+	return result
+}
+
+// addressPoolSystemConsumers returns the host interfaces which the system
+// reports as having an address within a subnet along with those addresses.
+func addressPoolSystemConsumers(ifaces []systemInterface, subnet *net.IPNet) ([]starlingxv1.ResourceConsumer, []string) {
+	consumers := make([]starlingxv1.ResourceConsumer, 0)
+	inUse := make([]string, 0)
+	for _, iface := range ifaces {
+		for _, address := range iface.addresses {
+			ip := net.ParseIP(address)
+			if ip == nil || !subnet.Contains(ip) {
+				continue
+			}
+
+			inUse = append(inUse, address)
+			consumers = append(consumers, starlingxv1.ResourceConsumer{
+				Host:        iface.host,
+				Interface:   iface.name,
+				Provisioned: true})
+		}
+	}
+
+	return consumers, inUse
+}
+
+// mergeConsumers combines consumer lists into a single list with one entry
+// per host interface, sorted by host and interface name.
+func mergeConsumers(lists ...[]starlingxv1.ResourceConsumer) starlingxv1.ResourceConsumerList {
+	merged := make(map[[2]string]*starlingxv1.ResourceConsumer)
+	for _, list := range lists {
+		for _, c := range list {
+			key := [2]string{c.Host, c.Interface}
+			if existing, ok := merged[key]; ok {
+				existing.Configured = existing.Configured || c.Configured
+				existing.Provisioned = existing.Provisioned || c.Provisioned
+				continue
+			}
+			consumer := c
+			merged[key] = &consumer
+		}
+	}
+
+	if len(merged) == 0 {
+		return nil
+	}
+
+	result := make(starlingxv1.ResourceConsumerList, 0, len(merged))
+	for _, c := range merged {
+		result = append(result, *c)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Host != result[j].Host {
+			return result[i].Host < result[j].Host
+		}
+		return result[i].Interface < result[j].Interface
+	})
+
+	return result
+}
+
+// saturatedInt64 converts a count to an int64, saturating at the largest
+// int64 value.
+func saturatedInt64(value *big.Int) int64 {
+	if !value.IsInt64() {
+		return math.MaxInt64
+	}
+	return value.Int64()
+}
+
+// addressPoolCounts returns the number of addresses of the allocation ranges
+// of a pool which are in use and the number which are still available.  The
+// controller, floating and gateway addresses as well as the addresses
+// allocated to host interfaces are always considered in use, in addition to
+// the addresses supplied by the caller.
+func addressPoolCounts(pool *starlingxv1.AddressPool, inUse []string) (allocated int64, free int64, err error) {
+	addressRanges, err := common.PoolAddressRanges(pool)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ranges := make([][2]*big.Int, 0, len(addressRanges))
+	for _, r := range addressRanges {
+		ranges = append(ranges, [2]*big.Int{
+			new(big.Int).SetBytes(r[0]),
+			new(big.Int).SetBytes(r[1])})
+	}
+
+	candidates := append([]string{}, inUse...)
+	for _, address := range []*string{pool.Spec.Controller0Address, pool.Spec.Controller1Address,
+		pool.Spec.FloatingAddress, pool.Spec.Gateway} {
+		if address != nil {
+			candidates = append(candidates, *address)
+		}
+	}
+	for _, alloc := range pool.Status.Allocations {
+		candidates = append(candidates, alloc.Address)
+	}
+
+	used := make(map[string]bool)
+	for _, address := range candidates {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			continue
+		}
+
+		value := new(big.Int).SetBytes(ip.To16())
+		for _, r := range ranges {
+			if value.Cmp(r[0]) >= 0 && value.Cmp(r[1]) <= 0 {
+				used[ip.String()] = true
+				break
+			}
+		}
+	}
+
+	capacity := new(big.Int)
+	for _, r := range ranges {
+		if r[1].Cmp(r[0]) < 0 {
+			continue
+		}
+		size := new(big.Int).Sub(r[1], r[0])
+		capacity.Add(capacity, size.Add(size, big.NewInt(1)))
+	}
+
+	count := big.NewInt(int64(len(used)))
+	remaining := new(big.Int).Sub(capacity, count)
+	if remaining.Sign() < 0 {
+		remaining.SetInt64(0)
+	}
+
+	return count.Int64(), saturatedInt64(remaining), nil
+}
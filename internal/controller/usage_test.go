@@ -0,0 +1,233 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package controller
+
+import (
+	"context"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+var _ = Describe("Resource usage", func() {
+	strPtr := func(s string) *string { return &s }
+
+	Describe("mergeConsumers", func() {
+		It("should combine the flags of the same interface and sort", func() {
+			configured := []starlingxv1.ResourceConsumer{
+				{Host: "worker-1", Interface: "data0", Configured: true},
+				{Host: "worker-0", Interface: "data1", Configured: true},
+			}
+			provisioned := []starlingxv1.ResourceConsumer{
+				{Host: "worker-0", Interface: "data1", Provisioned: true},
+				{Host: "worker-0", Interface: "data0", Provisioned: true},
+			}
+
+			Expect(mergeConsumers(configured, provisioned)).To(Equal(starlingxv1.ResourceConsumerList{
+				{Host: "worker-0", Interface: "data0", Provisioned: true},
+				{Host: "worker-0", Interface: "data1", Configured: true, Provisioned: true},
+				{Host: "worker-1", Interface: "data0", Configured: true},
+			}))
+		})
+
+		It("should return nil when there are no consumers", func() {
+			Expect(mergeConsumers(nil, []starlingxv1.ResourceConsumer{})).To(BeNil())
+		})
+	})
+
+	Describe("addressPoolCounts", func() {
+		It("should count the reserved and in use addresses of the subnet", func() {
+			pool := &starlingxv1.AddressPool{
+				Spec: starlingxv1.AddressPoolSpec{
+					Subnet:             "192.168.100.0",
+					Prefix:             24,
+					Gateway:            strPtr("192.168.100.1"),
+					FloatingAddress:    strPtr("192.168.100.2"),
+					Controller0Address: strPtr("192.168.100.3"),
+					Controller1Address: strPtr("192.168.100.4"),
+				},
+				Status: starlingxv1.AddressPoolStatus{
+					Allocations: []starlingxv1.AddressAllocation{
+						{Host: "worker-0", Interface: "data0", Address: "192.168.100.5"},
+					},
+				},
+			}
+
+			allocated, free, err := addressPoolCounts(pool, []string{"192.168.100.3", "192.168.100.6", "10.0.0.1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allocated).To(Equal(int64(6)))
+			Expect(free).To(Equal(int64(248)))
+		})
+
+		It("should only count the addresses of the allocation ranges", func() {
+			pool := &starlingxv1.AddressPool{
+				Spec: starlingxv1.AddressPoolSpec{
+					Subnet:  "fd00:10::",
+					Prefix:  64,
+					Gateway: strPtr("fd00:10::1"),
+					Allocation: starlingxv1.AllocationInfo{
+						Ranges: []starlingxv1.AllocationRange{
+							{Start: "fd00:10::100", End: "fd00:10::1ff"},
+						},
+					},
+				},
+			}
+
+			allocated, free, err := addressPoolCounts(pool, []string{"fd00:10:0:0::100"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allocated).To(Equal(int64(1)))
+			Expect(free).To(Equal(int64(255)))
+		})
+
+		It("should saturate the free count of large subnets", func() {
+			pool := &starlingxv1.AddressPool{
+				Spec: starlingxv1.AddressPoolSpec{
+					Subnet: "fd00:20::",
+					Prefix: 48,
+				},
+			}
+
+			allocated, free, err := addressPoolCounts(pool, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allocated).To(BeZero())
+			Expect(free).To(Equal(int64(math.MaxInt64)))
+		})
+	})
+
+	Describe("configuredConsumers", func() {
+		ctx := context.Background()
+
+		AfterEach(func() {
+			cleanupResources(ctx)
+		})
+
+		It("should apply the profile chain and the host overrides", func() {
+			base := &starlingxv1.HostProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "usage-base", Namespace: TestNamespace},
+				Spec: starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name: "data0", Class: "data", DataNetworks: []string{"physnet0"}},
+								Port: starlingxv1.EthernetPortInfo{Name: "enp24s0f0"},
+							},
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+									Name: "data1", Class: "data", DataNetworks: []string{"physnet0"}},
+								Port: starlingxv1.EthernetPortInfo{Name: "enp24s0f1"},
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, base)).To(Succeed())
+
+			createProfile(ctx, "usage-worker", strPtr("usage-base"))
+
+			mac := "01:02:03:04:05:06"
+			host := &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: TestNamespace},
+				Spec: starlingxv1.HostSpec{
+					Profile: "usage-worker",
+					Match:   &starlingxv1.MatchInfo{BootMAC: &mac},
+					Overrides: &starlingxv1.HostProfileSpec{
+						Interfaces: &starlingxv1.InterfaceInfo{
+							Ethernet: starlingxv1.EthernetList{
+								{
+									CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+										Name: "data1", Class: "data", DataNetworks: []string{"physnet1"}},
+									Port: starlingxv1.EthernetPortInfo{Name: "enp24s0f1"},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, host)).To(Succeed())
+
+			consumers, err := configuredConsumers(k8sClient, TestNamespace, func(iface *interfaceUsage) bool {
+				return len(iface.dataNetworks) > 0 && iface.dataNetworks[0] == "physnet0"
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumers).To(ConsistOf(starlingxv1.ResourceConsumer{
+				Host: "worker-0", Interface: "data0", Configured: true}))
+		})
+
+		It("should skip hosts whose profile chain loops", func() {
+			createProfile(ctx, "usage-loop-a", strPtr("usage-loop-b"))
+			createProfile(ctx, "usage-loop-b", strPtr("usage-loop-a"))
+
+			mac := "01:02:03:04:05:07"
+			host := &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker-1", Namespace: TestNamespace},
+				Spec: starlingxv1.HostSpec{
+					Profile: "usage-loop-a",
+					Match:   &starlingxv1.MatchInfo{BootMAC: &mac},
+				},
+			}
+			Expect(k8sClient.Create(ctx, host)).To(Succeed())
+
+			consumers, err := configuredConsumers(k8sClient, TestNamespace, func(iface *interfaceUsage) bool {
+				return true
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumers).To(BeEmpty())
+		})
+	})
+
+	Describe("system consumers", func() {
+		ifaces := []systemInterface{
+			{host: "worker-0", name: "data0", dataNetworks: []string{"physnet0"}},
+			{host: "worker-0", name: "mgmt0", addresses: []string{"192.168.204.10", "fd00::10"}},
+			{host: "worker-1", name: "data0", dataNetworks: []string{"physnet0", "physnet1"},
+				addresses: []string{"192.168.100.20"}},
+		}
+
+		It("should return the interfaces assigned to a data network", func() {
+			Expect(dataNetworkSystemConsumers(ifaces, "physnet1")).To(ConsistOf(
+				starlingxv1.ResourceConsumer{Host: "worker-1", Interface: "data0", Provisioned: true}))
+			Expect(dataNetworkSystemConsumers(ifaces, "physnet0")).To(HaveLen(2))
+		})
+
+		It("should return the interfaces with an address in the subnet", func() {
+			pool := &starlingxv1.AddressPool{
+				Spec: starlingxv1.AddressPoolSpec{Subnet: "192.168.204.0", Prefix: 24},
+			}
+			subnet, err := common.PoolSubnet(pool)
+			Expect(err).ToNot(HaveOccurred())
+
+			consumers, inUse := addressPoolSystemConsumers(ifaces, subnet)
+			Expect(consumers).To(ConsistOf(
+				starlingxv1.ResourceConsumer{Host: "worker-0", Interface: "mgmt0", Provisioned: true}))
+			Expect(inUse).To(ConsistOf("192.168.204.10"))
+		})
+	})
+
+	Describe("usageChangedPredicate", func() {
+		It("should only pass host status updates which change the reconciled state", func() {
+			before := &starlingxv1.Host{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+			after := before.DeepCopy()
+			after.Status.InSync = true
+
+			Expect(usageChangedPredicate.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after})).To(BeFalse())
+
+			after.Status.Reconciled = true
+			Expect(usageChangedPredicate.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after})).To(BeTrue())
+		})
+
+		It("should pass specification changes", func() {
+			before := &starlingxv1.HostProfile{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+			after := before.DeepCopy()
+			after.Generation = 2
+
+			Expect(usageChangedPredicate.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after})).To(BeTrue())
+		})
+	})
+})
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2024-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2024-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -7,9 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
-	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -55,16 +56,16 @@ func (d *AddressPoolCustomDefaulter) Default(ctx context.Context, obj runtime.Ob
 
 // Determines if a string is a valid IP address
 func IsIPAddress(value string) bool {
-	return common.IsIPv4(value) || common.IsIPv6(value)
+	return utils.IsIPv4(value) || utils.IsIPv6(value)
 }
 
 // Determines if the a prefix length agrees with the address family of the specified address
 func IsValidPrefix(address string, prefix int) bool {
-	if common.IsIPv4(address) {
+	if utils.IsIPv4(address) {
 		if prefix <= MaxIPv4PrefixLength {
 			return true
 		}
-	} else if common.IsIPv6(address) {
+	} else if utils.IsIPv6(address) {
 		if prefix <= MaxIPv6PrefixLength {
 			return true
 		}
@@ -78,6 +79,10 @@ func IsValidPrefix(address string, prefix int) bool {
 // to the system API and any errors generated by that API will be reported in the resource status and events.
 // Validates that all address specifications within the network are of the same address family.
 func validateAddressPool(r *starlingxv1.AddressPool) error {
+	if err := starlingxv1.ValidateDeploymentScopeAnnotation(r); err != nil {
+		return err
+	}
+
 	if !IsIPAddress(r.Spec.Subnet) {
 		return errors.New("expecting a valid IPv4 or IPv6 address in subnet")
 	}
@@ -86,7 +91,7 @@ func validateAddressPool(r *starlingxv1.AddressPool) error {
 		if !IsIPAddress(*r.Spec.FloatingAddress) {
 			return errors.New("expecting a valid IPv4 or IPv6 floatingAddress")
 		}
-		if common.IsIPv4(*r.Spec.FloatingAddress) != common.IsIPv4(r.Spec.Subnet) {
+		if utils.IsIPv4(*r.Spec.FloatingAddress) != utils.IsIPv4(r.Spec.Subnet) {
 			return errors.New("floatingAddress must be of the same family as the network subnet")
 		}
 	}
@@ -95,7 +100,7 @@ func validateAddressPool(r *starlingxv1.AddressPool) error {
 		if !IsIPAddress(*r.Spec.Controller0Address) {
 			return errors.New("expecting a valid IPv4 or IPv6 controller0Address")
 		}
-		if common.IsIPv4(*r.Spec.Controller0Address) != common.IsIPv4(r.Spec.Subnet) {
+		if utils.IsIPv4(*r.Spec.Controller0Address) != utils.IsIPv4(r.Spec.Subnet) {
 			return errors.New("controller0Address must be of the same family as the network subnet")
 		}
 	}
@@ -104,7 +109,7 @@ func validateAddressPool(r *starlingxv1.AddressPool) error {
 		if !IsIPAddress(*r.Spec.Controller1Address) {
 			return errors.New("expecting a valid IPv4 or IPv6 controller1Address")
 		}
-		if common.IsIPv4(*r.Spec.Controller1Address) != common.IsIPv4(r.Spec.Subnet) {
+		if utils.IsIPv4(*r.Spec.Controller1Address) != utils.IsIPv4(r.Spec.Subnet) {
 			return errors.New("controller1Address must be of the same family as the network subnet")
 		}
 	}
@@ -113,7 +118,7 @@ func validateAddressPool(r *starlingxv1.AddressPool) error {
 		if !IsIPAddress(*r.Spec.Gateway) {
 			return errors.New("expecting a valid IPv4 or IPv6 gateway")
 		}
-		if common.IsIPv4(*r.Spec.Gateway) != common.IsIPv4(r.Spec.Subnet) {
+		if utils.IsIPv4(*r.Spec.Gateway) != utils.IsIPv4(r.Spec.Subnet) {
 			return errors.New("controller1Address must be of the same family as the network subnet")
 		}
 	}
@@ -127,11 +132,11 @@ func validateAddressPool(r *starlingxv1.AddressPool) error {
 			return errors.New("start and end addresses must be valid IP addresses")
 		}
 
-		if common.IsIPv4(ra.Start) != common.IsIPv4(ra.End) {
+		if utils.IsIPv4(ra.Start) != utils.IsIPv4(ra.End) {
 			return errors.New("start and end addresses must be of the same address family")
 		}
 
-		if common.IsIPv4(ra.Start) != common.IsIPv4(r.Spec.Subnet) {
+		if utils.IsIPv4(ra.Start) != utils.IsIPv4(r.Spec.Subnet) {
 			return errors.New("allocation range address must be of the same family as the network subnet")
 		}
 	}
@@ -140,6 +145,46 @@ func validateAddressPool(r *starlingxv1.AddressPool) error {
 	return nil
 }
 
+// validateAddressPoolAllocations verifies that an update does not move the
+// controller, floating or gateway address of a pool onto an address that is
+// already allocated to a host interface.
+func validateAddressPoolAllocations(old, r *starlingxv1.AddressPool) error {
+	allocated := make(map[string]starlingxv1.AddressAllocation, len(old.Status.Allocations))
+	for _, alloc := range old.Status.Allocations {
+		if ip := net.ParseIP(alloc.Address); ip != nil {
+			allocated[ip.String()] = alloc
+		}
+	}
+
+	addresses := []struct {
+		attr    string
+		address *string
+	}{
+		{"controller0Address", r.Spec.Controller0Address},
+		{"controller1Address", r.Spec.Controller1Address},
+		{"floatingAddress", r.Spec.FloatingAddress},
+		{"gateway", r.Spec.Gateway},
+	}
+
+	for _, a := range addresses {
+		if a.address == nil {
+			continue
+		}
+
+		ip := net.ParseIP(*a.address)
+		if ip == nil {
+			continue
+		}
+
+		if alloc, ok := allocated[ip.String()]; ok {
+			return fmt.Errorf("%s %s is already allocated to interface %s of host %s",
+				a.attr, *a.address, alloc.Interface, alloc.Host)
+		}
+	}
+
+	return nil
+}
+
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 // +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-addresspool,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=addresspools,versions=v1,name=vaddresspool.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
 
@@ -154,7 +199,10 @@ func (v *AddressPoolCustomValidator) ValidateCreate(ctx context.Context, obj run
 		return nil, fmt.Errorf("expected a AddressPool object but got %T", obj)
 	}
 	systemlog.Info("validate create", "name", addrPool.Name)
-	return nil, validateAddressPool(addrPool)
+	if err := validateAddressPool(addrPool); err != nil {
+		return nil, err
+	}
+	return nil, validateAddressPoolAgainstCluster(ctx, addrPool)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -164,7 +212,23 @@ func (v *AddressPoolCustomValidator) ValidateUpdate(ctx context.Context, oldObj,
 		return nil, fmt.Errorf("expected a AddressPool object but got %T", newObj)
 	}
 	addresspoollog.Info("validate update", "name", addrPool.Name)
-	return nil, validateAddressPool(addrPool)
+	if err := validateAddressPool(addrPool); err != nil {
+		return nil, err
+	}
+	oldPool, ok := oldObj.(*starlingxv1.AddressPool)
+	if !ok {
+		return nil, fmt.Errorf("expected a AddressPool object but got %T", oldObj)
+	}
+	if err := validateAddressPoolAllocations(oldPool, addrPool); err != nil {
+		return nil, err
+	}
+	if oldPool.Spec.Subnet == addrPool.Spec.Subnet && oldPool.Spec.Prefix == addrPool.Spec.Prefix {
+		// Only a change of subnet can introduce an overlap; metadata updates
+		// such as finalizer changes must be accepted even if existing pools
+		// already overlap.
+		return nil, nil
+	}
+	return nil, validateAddressPoolAgainstCluster(ctx, addrPool)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
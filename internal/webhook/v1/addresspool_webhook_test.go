@@ -6,6 +6,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func GetAddrPool(ip_family string) *starlingxv1.AddressPool {
@@ -62,6 +64,15 @@ var _ = Describe("AddressPoolWebhook", func() {
 			})
 		})
 
+		Context("when the deployment scope annotation is not supported", func() {
+			It("should fail validation with error", func() {
+				r := GetAddrPool("ipv4")
+				r.Annotations = map[string]string{starlingxv1.DeploymentScopeAnnotation: "everything"}
+				err := validateAddressPool(r)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
 		Context("when subnet is not valid IPv4 or IPv6", func() {
 			It("should fail validation with error", func() {
 				r := GetAddrPool("ipv4")
@@ -202,6 +213,34 @@ var _ = Describe("AddressPoolWebhook", func() {
 			})
 		})
 	})
+
+	Describe("ValidateAddressPoolAllocations", func() {
+		Context("when a controller address is moved onto an allocated address", func() {
+			It("should fail validation with error", func() {
+				old := GetAddrPool("ipv4")
+				old.Status.Allocations = []starlingxv1.AddressAllocation{
+					{Host: "worker-0", Interface: "data0", Address: "192.168.204.10"},
+				}
+				r := GetAddrPool("ipv4")
+				address := "192.168.204.10"
+				r.Spec.Controller1Address = &address
+				err := validateAddressPoolAllocations(old, r)
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("worker-0"))
+			})
+		})
+
+		Context("when no allocated address is reused", func() {
+			It("should validate successfully without error", func() {
+				old := GetAddrPool("ipv6")
+				old.Status.Allocations = []starlingxv1.AddressAllocation{
+					{Host: "worker-0", Interface: "data0", Address: "fcff:1:2:3::10"},
+				}
+				err := validateAddressPoolAllocations(old, GetAddrPool("ipv6"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
 })
 
 var _ = Describe("AddressPoolWebhook wrappers", func() {
@@ -216,6 +255,32 @@ var _ = Describe("AddressPoolWebhook wrappers", func() {
 			_, err := v.ValidateUpdate(ctx, GetAddrPool("ipv4"), GetAddrPool("ipv4"))
 			Expect(err).ToNot(HaveOccurred())
 		})
+		It("should accept a finalizer update of an overlapping pool", func() {
+			saved := cl
+			defer func() { cl = saved }()
+
+			other := GetAddrPool("ipv4")
+			other.Name = "other"
+			other.Namespace = "deployment"
+			scheme := runtime.NewScheme()
+			Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+			cl = fake.NewClientBuilder().WithScheme(scheme).WithObjects(other).Build()
+
+			old := GetAddrPool("ipv4")
+			old.Name = "mgmt"
+			old.Namespace = "deployment"
+			r := old.DeepCopy()
+			r.Finalizers = []string{"addresspool.finalizers.windriver.com"}
+
+			v := &AddressPoolCustomValidator{}
+			_, err := v.ValidateUpdate(ctx, old, r)
+			Expect(err).ToNot(HaveOccurred())
+
+			r.Spec.Prefix = 23
+			_, err = v.ValidateUpdate(ctx, old, r)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`"other"`))
+		})
 		It("should accept ValidateDelete", func() {
 			v := &AddressPoolCustomValidator{}
 			_, err := v.ValidateDelete(ctx, GetAddrPool("ipv4"))
@@ -0,0 +1,254 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// The checks in this file validate a resource against the other resources
+// stored in the cluster.  Missing references are reported as warnings since
+// resources are commonly applied in an arbitrary order and the referenced
+// resource may simply not have been created yet.  Inconsistencies that cannot
+// be resolved by creating more resources are reported as errors.
+
+// validateProfileChain walks the chain of base profiles of a host profile.
+// A missing base profile is reported as a warning while a cycle is reported as
+// an error.
+func validateProfileChain(ctx context.Context, c client.Client, profile *starlingxv1.HostProfile) (admission.Warnings, error) {
+	visited := map[string]bool{profile.Name: true}
+	chain := []string{profile.Name}
+
+	base := profile.Spec.Base
+	for base != nil && *base != "" {
+		name := *base
+		chain = append(chain, name)
+
+		if visited[name] {
+			return nil, fmt.Errorf("host profile base chain contains a cycle: %v", chain)
+		}
+		visited[name] = true
+
+		current := &starlingxv1.HostProfile{}
+		err := c.Get(ctx, types.NamespacedName{Namespace: profile.Namespace, Name: name}, current)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return admission.Warnings{fmt.Sprintf("base host profile %q does not exist", name)}, nil
+			}
+			return admission.Warnings{fmt.Sprintf("unable to verify base host profile %q: %s", name, err.Error())}, nil
+		}
+
+		base = current.Spec.Base
+	}
+
+	return nil, nil
+}
+
+// validateHostProfileReference verifies that the profile referenced by a host
+// exists.
+func validateHostProfileReference(ctx context.Context, c client.Client, host *starlingxv1.Host) admission.Warnings {
+	if host.Spec.Profile == "" {
+		return nil
+	}
+
+	profile := &starlingxv1.HostProfile{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: host.Namespace, Name: host.Spec.Profile}, profile)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return admission.Warnings{fmt.Sprintf("host profile %q does not exist", host.Spec.Profile)}
+		}
+		return admission.Warnings{fmt.Sprintf("unable to verify host profile %q: %s", host.Spec.Profile, err.Error())}
+	}
+
+	return nil
+}
+
+// listNames returns the set of names of all resources of a list type within a
+// namespace.
+func listNames(ctx context.Context, c client.Client, namespace string, list client.ObjectList) (map[string]bool, error) {
+	if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]bool)
+	switch items := list.(type) {
+	case *starlingxv1.PlatformNetworkList:
+		for _, item := range items.Items {
+			result[item.Name] = true
+		}
+	case *starlingxv1.DataNetworkList:
+		for _, item := range items.Items {
+			result[item.Name] = true
+		}
+	case *starlingxv1.PtpInterfaceList:
+		for _, item := range items.Items {
+			result[item.Name] = true
+		}
+	case *starlingxv1.AddressPoolList:
+		for _, item := range items.Items {
+			result[item.Name] = true
+		}
+	}
+
+	return result, nil
+}
+
+// validateInterfaceReferences verifies that every platform network, data
+// network, PTP interface and address pool referenced by the interfaces of a
+// profile spec exists within the namespace.
+func validateInterfaceReferences(ctx context.Context, c client.Client, namespace string, spec *starlingxv1.HostProfileSpec) admission.Warnings {
+	interfaces := commonInterfaces(spec)
+	if len(interfaces) == 0 {
+		return nil
+	}
+
+	warnings := admission.Warnings{}
+
+	platformNetworks, err := listNames(ctx, c, namespace, &starlingxv1.PlatformNetworkList{})
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("unable to verify interface references: %s", err.Error())}
+	}
+
+	dataNetworks, err := listNames(ctx, c, namespace, &starlingxv1.DataNetworkList{})
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("unable to verify interface references: %s", err.Error())}
+	}
+
+	ptpInterfaces, err := listNames(ctx, c, namespace, &starlingxv1.PtpInterfaceList{})
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("unable to verify interface references: %s", err.Error())}
+	}
+
+	addressPools, err := listNames(ctx, c, namespace, &starlingxv1.AddressPoolList{})
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("unable to verify interface references: %s", err.Error())}
+	}
+
+	for _, iface := range interfaces {
+		for _, name := range iface.PlatformNetworks {
+			if !platformNetworks[name] {
+				warnings = append(warnings, fmt.Sprintf("interface %q references platform network %q which does not exist", iface.Name, name))
+			}
+		}
+
+		for _, name := range iface.DataNetworks {
+			if !dataNetworks[name] {
+				warnings = append(warnings, fmt.Sprintf("interface %q references data network %q which does not exist", iface.Name, name))
+			}
+		}
+
+		for _, name := range iface.PtpInterfaces {
+			if !ptpInterfaces[name] {
+				warnings = append(warnings, fmt.Sprintf("interface %q references PTP interface %q which does not exist", iface.Name, name))
+			}
+		}
+
+		for _, name := range iface.AddressPools {
+			if !addressPools[name] {
+				warnings = append(warnings, fmt.Sprintf("interface %q references address pool %q which does not exist", iface.Name, name))
+			}
+		}
+	}
+
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	return warnings
+}
+
+// addressPoolNetwork returns the network described by the subnet and prefix
+// of an address pool.
+func addressPoolNetwork(pool *starlingxv1.AddressPool) (*net.IPNet, error) {
+	_, network, err := net.ParseCIDR(fmt.Sprintf("%s/%d", pool.Spec.Subnet, pool.Spec.Prefix))
+	return network, err
+}
+
+// networksOverlap determines whether two networks share any addresses.
+func networksOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// validateAddressPoolOverlap verifies that the subnet of an address pool does
+// not overlap with the subnet of any other address pool in the namespace.
+func validateAddressPoolOverlap(ctx context.Context, c client.Client, pool *starlingxv1.AddressPool) error {
+	network, err := addressPoolNetwork(pool)
+	if err != nil {
+		// The subnet itself is validated elsewhere.
+		return nil
+	}
+
+	pools := &starlingxv1.AddressPoolList{}
+	if err := c.List(ctx, pools, client.InNamespace(pool.Namespace)); err != nil {
+		addresspoollog.Info("unable to list address pools; skipping overlap check", "error", err.Error())
+		return nil
+	}
+
+	for i := range pools.Items {
+		other := &pools.Items[i]
+		if other.Name == pool.Name {
+			continue
+		}
+
+		otherNetwork, err := addressPoolNetwork(other)
+		if err != nil {
+			continue
+		}
+
+		if networksOverlap(network, otherNetwork) {
+			return fmt.Errorf("subnet %s overlaps with subnet %s of address pool %q",
+				network.String(), otherNetwork.String(), other.Name)
+		}
+	}
+
+	return nil
+}
+
+// validateHostProfileAgainstCluster runs the cluster state checks that apply
+// to a host profile.
+func validateHostProfileAgainstCluster(ctx context.Context, profile *starlingxv1.HostProfile) (admission.Warnings, error) {
+	if cl == nil {
+		return nil, nil
+	}
+
+	warnings, err := validateProfileChain(ctx, cl, profile)
+	if err != nil {
+		return warnings, err
+	}
+
+	return append(warnings, validateInterfaceReferences(ctx, cl, profile.Namespace, &profile.Spec)...), nil
+}
+
+// validateHostAgainstCluster runs the cluster state checks that apply to a
+// host.
+func validateHostAgainstCluster(ctx context.Context, host *starlingxv1.Host) admission.Warnings {
+	if cl == nil {
+		return nil
+	}
+
+	warnings := validateHostProfileReference(ctx, cl, host)
+	if host.Spec.Overrides != nil {
+		warnings = append(warnings, validateInterfaceReferences(ctx, cl, host.Namespace, host.Spec.Overrides)...)
+	}
+
+	return warnings
+}
+
+// validateAddressPoolAgainstCluster runs the cluster state checks that apply
+// to an address pool.
+func validateAddressPoolAgainstCluster(ctx context.Context, pool *starlingxv1.AddressPool) error {
+	if cl == nil {
+		return nil
+	}
+
+	return validateAddressPoolOverlap(ctx, cl, pool)
+}
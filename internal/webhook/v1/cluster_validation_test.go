@@ -0,0 +1,133 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package v1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Cluster state validation", func() {
+	var scheme *runtime.Scheme
+
+	strPtr := func(s string) *string { return &s }
+
+	newProfile := func(name string, base *string) *starlingxv1.HostProfile {
+		return &starlingxv1.HostProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "deployment"},
+			Spec:       starlingxv1.HostProfileSpec{Base: base},
+		}
+	}
+
+	newPool := func(name, subnet string, prefix int) *starlingxv1.AddressPool {
+		return &starlingxv1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "deployment"},
+			Spec:       starlingxv1.AddressPoolSpec{Subnet: subnet, Prefix: prefix},
+		}
+	}
+
+	build := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	}
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	Describe("validateProfileChain", func() {
+		It("should accept a complete chain", func() {
+			c := build(newProfile("common", nil), newProfile("controller", strPtr("common")))
+			warnings, err := validateProfileChain(ctx, c, newProfile("controller-0", strPtr("controller")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should warn about a missing base profile", func() {
+			c := build(newProfile("controller", strPtr("common")))
+			warnings, err := validateProfileChain(ctx, c, newProfile("controller-0", strPtr("controller")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring(`"common"`)))
+		})
+
+		It("should reject a cycle", func() {
+			c := build(newProfile("common", strPtr("controller")), newProfile("controller", strPtr("common")))
+			_, err := validateProfileChain(ctx, c, newProfile("common", strPtr("controller")))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cycle"))
+		})
+	})
+
+	Describe("validateHostProfileReference", func() {
+		It("should warn about a missing profile", func() {
+			host := &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "deployment"},
+				Spec:       starlingxv1.HostSpec{Profile: "controller"},
+			}
+			Expect(validateHostProfileReference(ctx, build(), host)).To(HaveLen(1))
+			Expect(validateHostProfileReference(ctx, build(newProfile("controller", nil)), host)).To(BeEmpty())
+		})
+	})
+
+	Describe("validateInterfaceReferences", func() {
+		It("should warn about every missing reference", func() {
+			spec := &starlingxv1.HostProfileSpec{
+				Interfaces: &starlingxv1.InterfaceInfo{
+					Ethernet: starlingxv1.EthernetList{
+						{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+							Name:             "enp0s3",
+							PlatformNetworks: starlingxv1.PlatformNetworkItemList{"mgmt", "oam"},
+							DataNetworks:     starlingxv1.DataNetworkItemList{"group0-data0"},
+							PtpInterfaces:    starlingxv1.PtpInterfaceItemList{"ptpint1"},
+						}},
+					},
+				},
+			}
+			mgmt := &starlingxv1.PlatformNetwork{ObjectMeta: metav1.ObjectMeta{Name: "mgmt", Namespace: "deployment"}}
+			data0 := &starlingxv1.DataNetwork{ObjectMeta: metav1.ObjectMeta{Name: "group0-data0", Namespace: "deployment"}}
+
+			warnings := validateInterfaceReferences(ctx, build(mgmt, data0), "deployment", spec)
+			Expect(warnings).To(ConsistOf(
+				ContainSubstring(`platform network "oam"`),
+				ContainSubstring(`PTP interface "ptpint1"`)))
+		})
+	})
+
+	Describe("validateInterfaceReferences for address pools", func() {
+		It("should warn about a missing address pool", func() {
+			spec := &starlingxv1.HostProfileSpec{
+				Interfaces: &starlingxv1.InterfaceInfo{
+					Ethernet: starlingxv1.EthernetList{
+						{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+							Name:         "data0",
+							AddressPools: starlingxv1.AddressPoolItemList{"data-v4", "data-v6"},
+						}},
+					},
+				},
+			}
+
+			warnings := validateInterfaceReferences(ctx, build(newPool("data-v4", "10.10.10.0", 24)), "deployment", spec)
+			Expect(warnings).To(ConsistOf(ContainSubstring(`address pool "data-v6"`)))
+		})
+	})
+
+	Describe("validateAddressPoolOverlap", func() {
+		It("should reject overlapping subnets", func() {
+			c := build(newPool("mgmt", "192.168.204.0", 24))
+			err := validateAddressPoolOverlap(ctx, c, newPool("oam", "192.168.0.0", 16))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`"mgmt"`))
+		})
+
+		It("should accept disjoint subnets and the pool itself", func() {
+			c := build(newPool("mgmt", "192.168.204.0", 24), newPool("mgmt-ipv6", "fd01::", 64))
+			Expect(validateAddressPoolOverlap(ctx, c, newPool("oam", "10.10.10.0", 24))).To(Succeed())
+			Expect(validateAddressPoolOverlap(ctx, c, newPool("mgmt", "192.168.204.0", 23))).To(Succeed())
+		})
+	})
+})
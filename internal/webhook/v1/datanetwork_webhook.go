@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022,2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022,2025-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -12,6 +12,7 @@ import (
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -48,6 +49,10 @@ func (d *DataNetworkCustomDefaulter) Default(ctx context.Context, obj runtime.Ob
 }
 
 func validateDataNetwork(r *starlingxv1.DataNetwork) error {
+	if err := starlingxv1.ValidateDeploymentScopeAnnotation(r); err != nil {
+		return err
+	}
+
 	if r.Spec.Type != datanetworks.TypeVxLAN {
 		if r.Spec.VxLAN != nil {
 			return errors.New("VxLAN attributes are only allowed for VxLAN type data networks")
@@ -57,8 +62,7 @@ func validateDataNetwork(r *starlingxv1.DataNetwork) error {
 	return nil
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-datanetwork,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=datanetworks,versions=v1,name=vdatanetwork.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-starlingx-windriver-com-v1-datanetwork,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=datanetworks,versions=v1,name=vdatanetwork.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
 
 type DataNetworkCustomValidator struct{}
 
@@ -87,10 +91,14 @@ func (v *DataNetworkCustomValidator) ValidateUpdate(ctx context.Context, oldObj,
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (v *DataNetworkCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
-	dataNetwork, ok := obj.(*starlingxv1.AddressPool)
+	dataNetwork, ok := obj.(*starlingxv1.DataNetwork)
 	if !ok {
-		return nil, fmt.Errorf("expected a AddressPool object but got %T", obj)
+		return nil, fmt.Errorf("expected a DataNetwork object but got %T", obj)
 	}
-	addresspoollog.Info("validate delete", "name", dataNetwork.Name)
-	return nil, nil
+	datanetworklog.Info("validate delete", "name", dataNetwork.Name)
+
+	return nil, validateDeletion(ctx, dataNetwork, "DataNetwork",
+		func(ctx context.Context, c client.Client) ([]string, error) {
+			return findDataNetworkConsumers(ctx, c, dataNetwork)
+		})
 }
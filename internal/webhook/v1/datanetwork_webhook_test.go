@@ -60,10 +60,14 @@ var _ = Describe("DataNetworkWebhook wrappers", func() {
 		})
 		It("should accept ValidateDelete", func() {
 			v := &DataNetworkCustomValidator{}
-			// NOTE: ValidateDelete has a copy-paste bug — it casts to *AddressPool instead of *DataNetwork.
-			// Passing a DataNetwork triggers the type assertion error.
 			obj := &starlingxv1.DataNetwork{}
 			_, err := v.ValidateDelete(ctx, obj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should reject ValidateDelete for other types", func() {
+			v := &DataNetworkCustomValidator{}
+			obj := &starlingxv1.AddressPool{}
+			_, err := v.ValidateDelete(ctx, obj)
 			Expect(err).To(HaveOccurred())
 		})
 	})
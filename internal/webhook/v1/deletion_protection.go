@@ -0,0 +1,186 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// isDeletionUnprotected determines whether a resource can be deleted without
+// checking for consumers.  This is the case when the operator has explicitly
+// allowed the deletion or when the system resource is to be left in place.
+func isDeletionUnprotected(obj client.Object) bool {
+	return starlingxv1.IsDeletionAllowed(obj) || starlingxv1.IsOrphanDeletion(obj)
+}
+
+// newDeletionDeniedError builds the error returned when a resource cannot be
+// deleted because other resources still reference it.
+func newDeletionDeniedError(kind, name string, consumers []string) error {
+	sort.Strings(consumers)
+	return fmt.Errorf("%s %q is still referenced by %s; remove the references "+
+		"or set the %q annotation to %q (or %q to %q) to delete it anyway",
+		kind, name, strings.Join(consumers, ", "),
+		starlingxv1.AllowDeleteAnnotation, "true", starlingxv1.DeletionPolicyAnnotation, starlingxv1.DeletionPolicyOrphan)
+}
+
+// profileSpecs returns the list of host profile specs, from both HostProfile
+// resources and Host overrides, defined within a namespace.  Each spec is
+// keyed by a description of the resource that owns it.
+func profileSpecs(ctx context.Context, c client.Client, namespace string) (map[string]*starlingxv1.HostProfileSpec, error) {
+	result := make(map[string]*starlingxv1.HostProfileSpec)
+
+	profiles := &starlingxv1.HostProfileList{}
+	if err := c.List(ctx, profiles, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for i := range profiles.Items {
+		profile := &profiles.Items[i]
+		result[fmt.Sprintf("HostProfile/%s", profile.Name)] = &profile.Spec
+	}
+
+	hostList := &starlingxv1.HostList{}
+	if err := c.List(ctx, hostList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for i := range hostList.Items {
+		host := &hostList.Items[i]
+		if host.Spec.Overrides != nil {
+			result[fmt.Sprintf("Host/%s", host.Name)] = host.Spec.Overrides
+		}
+	}
+
+	return result, nil
+}
+
+// commonInterfaces returns the attributes common to all interfaces defined
+// within a profile spec.
+func commonInterfaces(spec *starlingxv1.HostProfileSpec) []starlingxv1.CommonInterfaceInfo {
+	result := make([]starlingxv1.CommonInterfaceInfo, 0)
+	if spec.Interfaces == nil {
+		return result
+	}
+
+	for _, e := range spec.Interfaces.Ethernet {
+		result = append(result, e.CommonInterfaceInfo)
+	}
+	for _, v := range spec.Interfaces.VLAN {
+		result = append(result, v.CommonInterfaceInfo)
+	}
+	for _, b := range spec.Interfaces.Bond {
+		result = append(result, b.CommonInterfaceInfo)
+	}
+	for _, v := range spec.Interfaces.VF {
+		result = append(result, v.CommonInterfaceInfo)
+	}
+
+	return result
+}
+
+// containsName determines whether a list of resource names contains a given
+// name.
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// findDataNetworkConsumers returns the list of resources that reference a
+// data network from their interface configuration.
+func findDataNetworkConsumers(ctx context.Context, c client.Client, dataNetwork *starlingxv1.DataNetwork) ([]string, error) {
+	specs, err := profileSpecs(ctx, c, dataNetwork.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for owner, spec := range specs {
+		for _, iface := range commonInterfaces(spec) {
+			if containsName(iface.DataNetworks, dataNetwork.Name) {
+				result = append(result, fmt.Sprintf("%s (interface %s)", owner, iface.Name))
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// findPtpInterfaceConsumers returns the list of resources that reference a
+// PTP interface from their interface configuration.
+func findPtpInterfaceConsumers(ctx context.Context, c client.Client, ptpInterface *starlingxv1.PtpInterface) ([]string, error) {
+	specs, err := profileSpecs(ctx, c, ptpInterface.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for owner, spec := range specs {
+		for _, iface := range commonInterfaces(spec) {
+			if containsName(iface.PtpInterfaces, ptpInterface.Name) {
+				result = append(result, fmt.Sprintf("%s (interface %s)", owner, iface.Name))
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// findPtpInstanceConsumers returns the list of resources that reference a
+// PTP instance either from a host profile or from a PTP interface.
+func findPtpInstanceConsumers(ctx context.Context, c client.Client, ptpInstance *starlingxv1.PtpInstance) ([]string, error) {
+	specs, err := profileSpecs(ctx, c, ptpInstance.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for owner, spec := range specs {
+		if containsName(spec.PtpInstances, ptpInstance.Name) {
+			result = append(result, owner)
+		}
+	}
+
+	ptpInterfaces := &starlingxv1.PtpInterfaceList{}
+	if err := c.List(ctx, ptpInterfaces, client.InNamespace(ptpInstance.Namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, ptpInterface := range ptpInterfaces.Items {
+		if ptpInterface.Spec.PtpInstance == ptpInstance.Name {
+			result = append(result, fmt.Sprintf("PtpInterface/%s", ptpInterface.Name))
+		}
+	}
+
+	return result, nil
+}
+
+// validateDeletion runs a consumer lookup for a resource being deleted and
+// denies the request if any consumers are found.  Deletion is always allowed
+// if the webhook has no client to inspect the namespace with.
+func validateDeletion(ctx context.Context, obj client.Object, kind string, finder func(ctx context.Context, c client.Client) ([]string, error)) error {
+	if cl == nil || isDeletionUnprotected(obj) {
+		return nil
+	}
+
+	consumers, err := finder(ctx, cl)
+	if err != nil {
+		return fmt.Errorf("unable to determine whether %s %q is in use: %w", kind, obj.GetName(), err)
+	}
+
+	if len(consumers) > 0 {
+		return newDeletionDeniedError(kind, obj.GetName(), consumers)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package v1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Deletion protection", func() {
+	var c client.Client
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+
+		profile := &starlingxv1.HostProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-profile", Namespace: "deployment"},
+			Spec: starlingxv1.HostProfileSpec{
+				PtpInstances: starlingxv1.PtpInstanceItemList{"ptp1"},
+				Interfaces: &starlingxv1.InterfaceInfo{
+					Ethernet: starlingxv1.EthernetList{
+						{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+							Name:          "data0",
+							DataNetworks:  starlingxv1.DataNetworkItemList{"group0-data0"},
+							PtpInterfaces: starlingxv1.PtpInterfaceItemList{"ptpint1"},
+						}},
+					},
+				},
+			},
+		}
+		host := &starlingxv1.Host{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: "deployment"},
+			Spec: starlingxv1.HostSpec{
+				Overrides: &starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						VLAN: starlingxv1.VLANList{
+							{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+								Name:         "data1",
+								DataNetworks: starlingxv1.DataNetworkItemList{"group0-data0"},
+							}},
+						},
+					},
+				},
+			},
+		}
+		ptpInterface := &starlingxv1.PtpInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "ptpint2", Namespace: "deployment"},
+			Spec:       starlingxv1.PtpInterfaceSpec{PtpInstance: "ptp2"},
+		}
+
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(profile, host, ptpInterface).Build()
+	})
+
+	It("should find data network consumers in profiles and overrides", func() {
+		dataNetwork := &starlingxv1.DataNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "group0-data0", Namespace: "deployment"},
+		}
+		consumers, err := findDataNetworkConsumers(ctx, c, dataNetwork)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumers).To(ConsistOf(
+			"HostProfile/worker-profile (interface data0)",
+			"Host/worker-0 (interface data1)"))
+
+		dataNetwork.Namespace = "other"
+		consumers, err = findDataNetworkConsumers(ctx, c, dataNetwork)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumers).To(BeEmpty())
+	})
+
+	It("should find PTP instance consumers in profiles and PTP interfaces", func() {
+		ptpInstance := &starlingxv1.PtpInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "ptp1", Namespace: "deployment"},
+		}
+		consumers, err := findPtpInstanceConsumers(ctx, c, ptpInstance)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumers).To(ConsistOf("HostProfile/worker-profile"))
+
+		ptpInstance.Name = "ptp2"
+		consumers, err = findPtpInstanceConsumers(ctx, c, ptpInstance)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumers).To(ConsistOf("PtpInterface/ptpint2"))
+	})
+
+	It("should find PTP interface consumers in profiles", func() {
+		ptpInterface := &starlingxv1.PtpInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "ptpint1", Namespace: "deployment"},
+		}
+		consumers, err := findPtpInterfaceConsumers(ctx, c, ptpInterface)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumers).To(ConsistOf("HostProfile/worker-profile (interface data0)"))
+	})
+
+	It("should honour the deletion annotations", func() {
+		dataNetwork := &starlingxv1.DataNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "group0-data0", Namespace: "deployment"},
+		}
+		Expect(isDeletionUnprotected(dataNetwork)).To(BeFalse())
+
+		dataNetwork.Annotations = map[string]string{starlingxv1.AllowDeleteAnnotation: "true"}
+		Expect(isDeletionUnprotected(dataNetwork)).To(BeTrue())
+
+		dataNetwork.Annotations = map[string]string{starlingxv1.DeletionPolicyAnnotation: starlingxv1.DeletionPolicyOrphan}
+		Expect(isDeletionUnprotected(dataNetwork)).To(BeTrue())
+	})
+
+	It("should describe the consumers when denying deletion", func() {
+		err := newDeletionDeniedError("DataNetwork", "group0-data0", []string{"Host/b", "Host/a"})
+		Expect(err.Error()).To(ContainSubstring("Host/a, Host/b"))
+		Expect(err.Error()).To(ContainSubstring(starlingxv1.AllowDeleteAnnotation))
+	})
+})
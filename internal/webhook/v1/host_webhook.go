@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022, 2024-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022, 2024-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -7,8 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -22,7 +26,17 @@ const HostAllowedReason string = "allowed to be admitted"
 // log is for logging in this package.
 var hostlog = logf.Log.WithName("host-resource")
 
+// hostCloudManager is used to reach the system API when validating requests
+// that depend on the current state of a host.
+var hostCloudManager cloudManager.CloudManager
+
+// activeControllerQueryTimeout bounds the time spent querying the system API
+// while admitting a host deletion so that an unreachable system never causes
+// the admission request itself to time out.
+const activeControllerQueryTimeout = 5 * time.Second
+
 func SetupHostWebhookWithManager(mgr ctrl.Manager) error {
+	hostCloudManager = cloudManager.GetInstance(mgr)
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&starlingxv1.Host{}).
 		WithDefaulter(&HostCustomDefaulter{}).
@@ -87,6 +101,10 @@ func validateMatchInfo(r *starlingxv1.Host) error {
 }
 
 func validateHost(r *starlingxv1.Host) error {
+	if err := starlingxv1.ValidateDeploymentScopeAnnotation(r); err != nil {
+		return err
+	}
+
 	if r.Spec.Match != nil {
 		err := validateMatchInfo(r)
 		if err != nil {
@@ -97,8 +115,7 @@ func validateHost(r *starlingxv1.Host) error {
 	return nil
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-host,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=hosts,versions=v1,name=vhost.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-starlingx-windriver-com-v1-host,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=hosts,versions=v1,name=vhost.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
 
 type HostCustomValidator struct{}
 
@@ -111,7 +128,10 @@ func (v *HostCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Ob
 		return nil, fmt.Errorf("expected a Host object but got %T", obj)
 	}
 	hostlog.Info("validate create", "name", host.Name)
-	return nil, validateHost(host)
+	if err := validateHost(host); err != nil {
+		return nil, err
+	}
+	return validateHostAgainstCluster(ctx, host), nil
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -121,7 +141,10 @@ func (v *HostCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj
 		return nil, fmt.Errorf("expected a Host object but got %T", newObj)
 	}
 	hostlog.Info("validate update", "name", host.Name)
-	return nil, validateHost(host)
+	if err := validateHost(host); err != nil {
+		return nil, err
+	}
+	return validateHostAgainstCluster(ctx, host), nil
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -132,5 +155,69 @@ func (v *HostCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Ob
 	}
 
 	hostlog.Info("validate delete", "name", host.Name)
+
+	// The allow-delete annotation does not apply to the active controller
+	// since its finalizer would still remove it from the system; only
+	// releasing it with the orphan deletion policy is accepted.
+	if !starlingxv1.IsOrphanDeletion(host) && isActiveController(ctx, host) {
+		return nil, fmt.Errorf("host %q is the active controller and cannot be deleted; "+
+			"set the %q annotation to %q to release it without removing it from the system",
+			host.Name, starlingxv1.DeletionPolicyAnnotation, starlingxv1.DeletionPolicyOrphan)
+	}
+
 	return nil, nil
 }
+
+// isActiveController determines whether a host resource is currently mapped
+// to the active controller of the system.  The role of a controller changes
+// on every swact and is not recorded in the resource so the system API is the
+// only source for it.  Admission must not depend on the system being
+// reachable therefore any failure or timeout is treated as not being the
+// active controller; the reconciler refuses to delete the active controller
+// regardless.
+func isActiveController(ctx context.Context, host *starlingxv1.Host) bool {
+	if hostCloudManager == nil || host.Status.ID == nil {
+		return false
+	}
+
+	platformClient := hostCloudManager.GetPlatformClient(host.Namespace)
+	if platformClient == nil {
+		return false
+	}
+
+	type queryResult struct {
+		host *hosts.Host
+		err  error
+	}
+
+	// The request is issued asynchronously since the platform client does
+	// not accept a context.  The channel is buffered so that a late reply
+	// does not block the query once the caller has given up on it.
+	replies := make(chan queryResult, 1)
+	go func() {
+		result, err := hosts.Get(platformClient, *host.Status.ID).Extract()
+		replies <- queryResult{host: result, err: err}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, activeControllerQueryTimeout)
+	defer cancel()
+
+	var reply queryResult
+	select {
+	case reply = <-replies:
+	case <-ctx.Done():
+		hostlog.Info("timed out querying host; allowing delete", "name", host.Name)
+		return false
+	}
+
+	if reply.err != nil {
+		hostlog.Info("unable to query host; allowing delete", "name", host.Name, "error", reply.err.Error())
+		return false
+	}
+
+	if reply.host.Capabilities.Personality == nil {
+		return false
+	}
+
+	return strings.EqualFold(*reply.host.Capabilities.Personality, hosts.ActiveController)
+}
@@ -4,12 +4,29 @@ package v1
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 
+	"github.com/gophercloud/gophercloud"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// activeControllerManager is a cloud manager whose platform client reports
+// the host being queried as the active controller.
+type activeControllerManager struct {
+	*cloudManager.Dummymanager
+	client *gophercloud.ServiceClient
+}
+
+func (m *activeControllerManager) GetPlatformClient(namespace string) *gophercloud.ServiceClient {
+	return m.client
+}
+
 var _ = Describe("HostWebhook", func() {
 
 	Describe("ValidateMatchBMInfo", func() {
@@ -229,5 +246,44 @@ var _ = Describe("HostWebhook wrappers", func() {
 			_, err := v.ValidateDelete(ctx, obj)
 			Expect(err).ToNot(HaveOccurred())
 		})
+		It("should protect the active controller even if its deletion is allowed", func() {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/ihosts/", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"uuid":"host-uuid","hostname":"controller-0","capabilities":{"Personality":"%s"}}`,
+					cloudManager.ActiveController)
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			saved := hostCloudManager
+			defer func() { hostCloudManager = saved }()
+			hostCloudManager = &activeControllerManager{
+				Dummymanager: &cloudManager.Dummymanager{},
+				client: &gophercloud.ServiceClient{
+					ProviderClient: &gophercloud.ProviderClient{TokenID: "test-token"},
+					Endpoint:       server.URL + "/",
+				},
+			}
+
+			id := "host-uuid"
+			obj := &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "controller-0",
+					Namespace:   "deployment",
+					Annotations: map[string]string{starlingxv1.AllowDeleteAnnotation: "true"},
+				},
+				Status: starlingxv1.HostStatus{ID: &id},
+			}
+
+			v := &HostCustomValidator{}
+			_, err := v.ValidateDelete(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("active controller"))
+
+			obj.Annotations = map[string]string{starlingxv1.DeletionPolicyAnnotation: starlingxv1.DeletionPolicyOrphan}
+			_, err = v.ValidateDelete(ctx, obj)
+			Expect(err).ToNot(HaveOccurred())
+		})
 	})
 })
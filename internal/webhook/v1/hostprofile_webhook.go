@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022, 2024-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022, 2024-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -7,7 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/cpus"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/interfaces"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/memory"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/physicalvolumes"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
@@ -58,6 +61,57 @@ func validateMemoryFunction(node starlingxv1.MemoryNodeInfo, function starlingxv
 		}
 	}
 
+	if policy := function.Policy; policy != nil {
+		if function.Function != memory.MemoryFunctionVM {
+			return errors.New("memory allocation policies are only supported for vm memory")
+		}
+
+		set := 0
+		if policy.Percent != nil {
+			set++
+		}
+		if policy.Remaining != nil && *policy.Remaining {
+			set++
+		}
+		if set != 1 {
+			msg := fmt.Sprintf("memory allocation policy for node %d pagesize %s must include exactly one attribute",
+				node.Node, function.PageSize)
+			return errors.New(msg)
+		}
+	}
+
+	return nil
+}
+
+// validateProcessorPolicy validates that a processor allocation policy
+// specifies exactly one attribute and is not applied to the application
+// function, which is implicitly given all unassigned cores.
+func validateProcessorPolicy(node starlingxv1.ProcessorInfo, function starlingxv1.ProcessorFunctionInfo) error {
+	policy := function.Policy
+	if policy == nil {
+		return nil
+	}
+
+	if function.Function == cpus.CPUFunctionApplication {
+		return errors.New("processor allocation policies are not supported for the application function")
+	}
+
+	set := 0
+	if policy.Percent != nil {
+		set++
+	}
+	if policy.Remaining != nil && *policy.Remaining {
+		set++
+	}
+	if policy.PerSocketLessPlatform != nil {
+		set++
+	}
+	if set != 1 {
+		msg := fmt.Sprintf("processor allocation policy for node %d function %s must include exactly one attribute",
+			node.Node, function.Function)
+		return errors.New(msg)
+	}
+
 	return nil
 }
 
@@ -87,6 +141,7 @@ func validateMemoryInfo(obj *starlingxv1.HostProfile) error {
 func validateProcessorInfo(obj *starlingxv1.HostProfile) error {
 	for _, n := range obj.Spec.Processors {
 		present := make(map[string]bool)
+		remaining := false
 		for _, f := range n.Functions {
 			key := f.Function
 			if _, ok := present[key]; ok {
@@ -95,12 +150,61 @@ func validateProcessorInfo(obj *starlingxv1.HostProfile) error {
 				return errors.New(msg)
 			}
 			present[key] = true
+
+			err := validateProcessorPolicy(n, f)
+			if err != nil {
+				return err
+			}
+
+			if f.Policy != nil && f.Policy.Remaining != nil && *f.Policy.Remaining {
+				if remaining {
+					msg := fmt.Sprintf("only one function may be allocated the remaining cores of node %d", n.Node)
+					return errors.New(msg)
+				}
+				remaining = true
+			}
 		}
 	}
 
 	return nil
 }
 
+// validateDiskReference validates that a disk is referenced either by its
+// device path or by a disk selector, but not by both.
+func validateDiskReference(kind string, path string, selector *starlingxv1.DiskSelector) error {
+	if path != "" && selector != nil {
+		msg := fmt.Sprintf("%s specifications must not include both a 'path' and a 'selector' attribute", kind)
+		return errors.New(msg)
+	}
+
+	if path == "" && selector == nil {
+		msg := fmt.Sprintf("%s specifications must include a 'path' or a 'selector' attribute", kind)
+		return errors.New(msg)
+	}
+
+	return validateDiskSelector(kind, selector)
+}
+
+// validateDiskSelector validates that a disk selector specifies at least one
+// attribute and that its size range is consistent.
+func validateDiskSelector(kind string, selector *starlingxv1.DiskSelector) error {
+	if selector == nil {
+		return nil
+	}
+
+	if selector.IsEmpty() {
+		msg := fmt.Sprintf("%s disk selector must include at least one attribute", kind)
+		return errors.New(msg)
+	}
+
+	if selector.MinSize != nil && selector.MaxSize != nil && *selector.MinSize > *selector.MaxSize {
+		msg := fmt.Sprintf("%s disk selector 'minSize' must not exceed 'maxSize'", kind)
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
 func validatePhysicalVolumeInfo(obj *starlingxv1.PhysicalVolumeInfo) error {
 	if obj.Type == physicalvolumes.PVTypePartition {
 		if obj.Size == nil {
@@ -109,7 +213,7 @@ func validatePhysicalVolumeInfo(obj *starlingxv1.PhysicalVolumeInfo) error {
 		}
 	}
 
-	return nil
+	return validateDiskReference("physical volume", obj.Path, obj.Selector)
 }
 
 func validateVolumeGroupInfo(obj *starlingxv1.VolumeGroupInfo) error {
@@ -123,6 +227,31 @@ func validateVolumeGroupInfo(obj *starlingxv1.VolumeGroupInfo) error {
 	return nil
 }
 
+// validateFileSystemSize validates that a filesystem is given either an
+// absolute size or a size policy and that the policy attributes are
+// consistent with each other.
+func validateFileSystemSize(name string, size int, policy *starlingxv1.FileSystemSizePolicy) error {
+	if policy == nil {
+		if size == 0 {
+			msg := fmt.Sprintf("filesystem %q must include a 'size' or a 'sizePolicy' attribute", name)
+			return errors.New(msg)
+		}
+		return nil
+	}
+
+	if policy.Percent != nil && policy.MaxAvailable != nil && *policy.MaxAvailable {
+		msg := fmt.Sprintf("filesystem %q size policy must not include both 'percent' and 'maxAvailable'", name)
+		return errors.New(msg)
+	}
+
+	if policy.Min != nil && policy.Max != nil && *policy.Min > *policy.Max {
+		msg := fmt.Sprintf("filesystem %q size policy 'min' must not exceed 'max'", name)
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
 func validateStorageInfo(obj *starlingxv1.HostProfile) error {
 	for _, vg := range obj.Spec.Storage.VolumeGroups {
 		err := validateVolumeGroupInfo(&vg)
@@ -131,6 +260,117 @@ func validateStorageInfo(obj *starlingxv1.HostProfile) error {
 		}
 	}
 
+	for _, fs := range obj.Spec.Storage.FileSystems {
+		err := validateFileSystemSize(fs.Name, fs.Size, fs.SizePolicy)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, osd := range obj.Spec.Storage.OSDs {
+		err := validateDiskReference("OSD", osd.Path, osd.Selector)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateInstallDeviceInfo validates that the boot and root devices are not
+// given both as device paths and as disk selectors.
+func validateInstallDeviceInfo(obj *starlingxv1.HostProfile) error {
+	if obj.Spec.BootDevice != nil && obj.Spec.BootDeviceSelector != nil {
+		return errors.New("'bootDevice' and 'bootDeviceSelector' are mutually exclusive")
+	}
+
+	if obj.Spec.RootDevice != nil && obj.Spec.RootDeviceSelector != nil {
+		return errors.New("'rootDevice' and 'rootDeviceSelector' are mutually exclusive")
+	}
+
+	err := validateDiskSelector("boot device", obj.Spec.BootDeviceSelector)
+	if err != nil {
+		return err
+	}
+
+	return validateDiskSelector("root device", obj.Spec.RootDeviceSelector)
+}
+
+// validateDeviceInfo validates that each PCI device entry is selected by a PCI
+// address or a PCI device ID and that no device is listed more than once.
+func validateDeviceInfo(obj *starlingxv1.HostProfile) error {
+	for i, d := range obj.Spec.Devices {
+		if d.Address == nil && d.DeviceID == nil {
+			return errors.New("PCI device specifications must include an 'address' or 'deviceID' attribute")
+		}
+
+		for _, other := range obj.Spec.Devices[:i] {
+			if d.IsKeyEqual(other) {
+				return errors.New("duplicate PCI device entries are not allowed")
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatePortInfo validates that each Ethernet interface refers to its port
+// by either a name or a port selector, and that each port selector specifies
+// at least one attribute and a valid MAC expression.
+func validatePortInfo(obj *starlingxv1.HostProfile) error {
+	for _, eth := range obj.Spec.Interfaces.Ethernet {
+		port := eth.Port
+		if port.Name != "" && port.Selector != nil {
+			msg := fmt.Sprintf("ethernet interface %q must not include both a port 'name' and a 'selector' attribute", eth.Name)
+			return errors.New(msg)
+		}
+
+		if port.Name == "" && port.Selector == nil {
+			msg := fmt.Sprintf("ethernet interface %q must include a port 'name' or a 'selector' attribute", eth.Name)
+			return errors.New(msg)
+		}
+
+		if port.Selector == nil {
+			continue
+		}
+
+		if port.Selector.IsEmpty() {
+			msg := fmt.Sprintf("ethernet interface %q port selector must include at least one attribute", eth.Name)
+			return errors.New(msg)
+		}
+
+		if port.Selector.MAC != nil {
+			if _, err := regexp.Compile("(?i)" + *port.Selector.MAC); err != nil {
+				msg := fmt.Sprintf("ethernet interface %q port selector 'mac' is not a valid expression: %s", eth.Name, err.Error())
+				return errors.New(msg)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAddressPoolInfo validates that address pools are only referenced by
+// data and platform interfaces and that no pool is listed more than once.
+func validateAddressPoolInfo(obj *starlingxv1.HostProfile) error {
+	for _, iface := range commonInterfaces(&obj.Spec) {
+		if len(iface.AddressPools) == 0 {
+			continue
+		}
+
+		if iface.Class != interfaces.IFClassData && iface.Class != interfaces.IFClassPlatform {
+			msg := fmt.Sprintf("interface %q must be a data or platform interface to reference address pools", iface.Name)
+			return errors.New(msg)
+		}
+
+		for i, name := range iface.AddressPools {
+			if containsName(iface.AddressPools[:i], name) {
+				msg := fmt.Sprintf("interface %q references address pool %q more than once", iface.Name, name)
+				return errors.New(msg)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -222,10 +462,19 @@ func validateOVSAccessInfo(obj *starlingxv1.HostProfile) error {
 }
 
 func validateHostProfile(r *starlingxv1.HostProfile) error {
+	if err := starlingxv1.ValidateDeploymentScopeAnnotation(r); err != nil {
+		return err
+	}
+
 	if r.Spec.Base != nil && *r.Spec.Base == "" {
 		return errors.New("profile base name must not be empty")
 	}
 
+	err := validateInstallDeviceInfo(r)
+	if err != nil {
+		return err
+	}
+
 	if r.Spec.Memory != nil {
 		err := validateMemoryInfo(r)
 		if err != nil {
@@ -248,7 +497,24 @@ func validateHostProfile(r *starlingxv1.HostProfile) error {
 	}
 
 	if r.Spec.Interfaces != nil {
-		err := validateOVSAccessInfo(r)
+		err := validatePortInfo(r)
+		if err != nil {
+			return err
+		}
+
+		err = validateAddressPoolInfo(r)
+		if err != nil {
+			return err
+		}
+
+		err = validateOVSAccessInfo(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.Spec.Devices != nil {
+		err := validateDeviceInfo(r)
 		if err != nil {
 			return err
 		}
@@ -272,7 +538,10 @@ func (v *HostProfileCustomValidator) ValidateCreate(ctx context.Context, obj run
 		return nil, fmt.Errorf("expected a HostProfile object but got %T", obj)
 	}
 	hostprofilelog.Info("validate create", "name", hostProfile.Name)
-	return nil, validateHostProfile(hostProfile)
+	if err := validateHostProfile(hostProfile); err != nil {
+		return nil, err
+	}
+	return validateHostProfileAgainstCluster(ctx, hostProfile)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -282,7 +551,10 @@ func (v *HostProfileCustomValidator) ValidateUpdate(ctx context.Context, oldObj,
 		return nil, fmt.Errorf("expected a HostProfile object but got %T", newObj)
 	}
 	hostprofilelog.Info("validate update", "name", hostProfile.Name)
-	return nil, validateHostProfile(hostProfile)
+	if err := validateHostProfile(hostProfile); err != nil {
+		return nil, err
+	}
+	return validateHostProfileAgainstCluster(ctx, hostProfile)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -55,6 +55,48 @@ var _ = Describe("HostProfileWebhook", func() {
 		})
 	})
 
+	Describe("ValidateMemoryPolicy", func() {
+		Context("When a memory policy is applied to vswitch memory", func() {
+			It("should return an error", func() {
+				percent := 50
+				node := starlingxv1.MemoryNodeInfo{Node: 0}
+				function := starlingxv1.MemoryFunctionInfo{
+					Function: "vswitch",
+					PageSize: "1GB",
+					Policy:   &starlingxv1.MemoryAllocationPolicy{Percent: &percent},
+				}
+				err := validateMemoryFunction(node, function)
+				msg := errors.New("memory allocation policies are only supported for vm memory")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a memory policy has no attribute", func() {
+			It("should return an error", func() {
+				node := starlingxv1.MemoryNodeInfo{Node: 0}
+				function := starlingxv1.MemoryFunctionInfo{
+					Function: "vm",
+					PageSize: "1GB",
+					Policy:   &starlingxv1.MemoryAllocationPolicy{},
+				}
+				err := validateMemoryFunction(node, function)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("When a valid memory policy is present", func() {
+			It("should succeed without error", func() {
+				percent := 80
+				node := starlingxv1.MemoryNodeInfo{Node: 0}
+				function := starlingxv1.MemoryFunctionInfo{
+					Function: "vm",
+					PageSize: "1GB",
+					Policy:   &starlingxv1.MemoryAllocationPolicy{Percent: &percent},
+				}
+				err := validateMemoryFunction(node, function)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("ValidateProcessorInfo", func() {
 		Context("When no duplicate processor entries are present", func() {
 			It("should validate without error", func() {
@@ -103,6 +145,84 @@ var _ = Describe("HostProfileWebhook", func() {
 				Expect(err).To(Equal(msg))
 			})
 		})
+
+		Context("When two functions request the remaining cores of a node", func() {
+			It("should return an error", func() {
+				remaining := true
+				obj := &starlingxv1.HostProfile{
+					Spec: starlingxv1.HostProfileSpec{
+						Processors: starlingxv1.ProcessorNodeList{
+							{
+								Functions: starlingxv1.ProcessorFunctionList{
+									{
+										Function: "shared",
+										Policy:   &starlingxv1.ProcessorAllocationPolicy{Remaining: &remaining},
+									},
+									{
+										Function: "application-isolated",
+										Policy:   &starlingxv1.ProcessorAllocationPolicy{Remaining: &remaining},
+									},
+								},
+								Node: 1,
+							},
+						},
+					},
+				}
+				msg := errors.New("only one function may be allocated the remaining cores of node 1")
+				err := validateProcessorInfo(obj)
+				Expect(err).To(Equal(msg))
+			})
+		})
+
+		Context("When a processor policy includes several attributes", func() {
+			It("should return an error", func() {
+				remaining, percent := true, 50
+				node := starlingxv1.ProcessorInfo{Node: 0}
+				function := starlingxv1.ProcessorFunctionInfo{
+					Function: "application-isolated",
+					Policy:   &starlingxv1.ProcessorAllocationPolicy{Remaining: &remaining, Percent: &percent},
+				}
+				err := validateProcessorPolicy(node, function)
+				msg := errors.New("processor allocation policy for node 0 function application-isolated must include exactly one attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+
+		Context("When a processor policy is applied to the application function", func() {
+			It("should return an error", func() {
+				percent := 50
+				node := starlingxv1.ProcessorInfo{Node: 0}
+				function := starlingxv1.ProcessorFunctionInfo{
+					Function: "application",
+					Policy:   &starlingxv1.ProcessorAllocationPolicy{Percent: &percent},
+				}
+				err := validateProcessorPolicy(node, function)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("When a valid processor policy is present", func() {
+			It("should validate without error", func() {
+				cores := 20
+				obj := &starlingxv1.HostProfile{
+					Spec: starlingxv1.HostProfileSpec{
+						Processors: starlingxv1.ProcessorNodeList{
+							{
+								Functions: starlingxv1.ProcessorFunctionList{
+									{Function: "platform", Count: 2},
+									{
+										Function: "application-isolated",
+										Policy:   &starlingxv1.ProcessorAllocationPolicy{PerSocketLessPlatform: &cores},
+									},
+								},
+							},
+						},
+					},
+				}
+				err := validateProcessorInfo(obj)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
 	})
 
 	Describe("ValidatePhysicalVolumeInfo", func() {
@@ -120,11 +240,217 @@ var _ = Describe("HostProfileWebhook", func() {
 			It("should succeed without error", func() {
 				obj := &starlingxv1.PhysicalVolumeInfo{
 					Type: "randomType",
+					Path: "/dev/sda",
 				}
 				err := validatePhysicalVolumeInfo(obj)
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
+		Context("When the volume has neither a path nor a selector", func() {
+			It("should return an error", func() {
+				obj := &starlingxv1.PhysicalVolumeInfo{
+					Type: "disk",
+				}
+				err := validatePhysicalVolumeInfo(obj)
+				msg := errors.New("physical volume specifications must include a 'path' or a 'selector' attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the volume has both a path and a selector", func() {
+			It("should return an error", func() {
+				diskType := "SSD"
+				obj := &starlingxv1.PhysicalVolumeInfo{
+					Type:     "disk",
+					Path:     "/dev/sda",
+					Selector: &starlingxv1.DiskSelector{Type: &diskType},
+				}
+				err := validatePhysicalVolumeInfo(obj)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("When the volume has an empty selector", func() {
+			It("should return an error", func() {
+				obj := &starlingxv1.PhysicalVolumeInfo{
+					Type:     "disk",
+					Selector: &starlingxv1.DiskSelector{},
+				}
+				err := validatePhysicalVolumeInfo(obj)
+				msg := errors.New("physical volume disk selector must include at least one attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the volume has a selector with an inverted size range", func() {
+			It("should return an error", func() {
+				low, high := 500, 100
+				obj := &starlingxv1.PhysicalVolumeInfo{
+					Type:     "disk",
+					Selector: &starlingxv1.DiskSelector{MinSize: &low, MaxSize: &high},
+				}
+				err := validatePhysicalVolumeInfo(obj)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("When the volume is selected by disk attributes", func() {
+			It("should succeed without error", func() {
+				diskType, low := "NVME", 100
+				obj := &starlingxv1.PhysicalVolumeInfo{
+					Type:     "disk",
+					Selector: &starlingxv1.DiskSelector{Type: &diskType, MinSize: &low},
+				}
+				err := validatePhysicalVolumeInfo(obj)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ValidateDeviceInfo", func() {
+		address := "0000:b3:00.0"
+		deviceID := "0d5c"
+		Context("When a device has no address or device ID", func() {
+			It("should return an error", func() {
+				vfCount := 8
+				obj := &starlingxv1.HostProfile{
+					Spec: starlingxv1.HostProfileSpec{
+						Devices: starlingxv1.PCIDeviceList{
+							{VFCount: &vfCount},
+						},
+					},
+				}
+				err := validateDeviceInfo(obj)
+				msg := errors.New("PCI device specifications must include an 'address' or 'deviceID' attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a device is listed twice", func() {
+			It("should return an error", func() {
+				obj := &starlingxv1.HostProfile{
+					Spec: starlingxv1.HostProfileSpec{
+						Devices: starlingxv1.PCIDeviceList{
+							{DeviceID: &deviceID},
+							{DeviceID: &deviceID},
+						},
+					},
+				}
+				err := validateDeviceInfo(obj)
+				msg := errors.New("duplicate PCI device entries are not allowed")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When devices are selected by address and device ID", func() {
+			It("should succeed without error", func() {
+				obj := &starlingxv1.HostProfile{
+					Spec: starlingxv1.HostProfileSpec{
+						Devices: starlingxv1.PCIDeviceList{
+							{Address: &address},
+							{DeviceID: &deviceID},
+						},
+					},
+				}
+				err := validateDeviceInfo(obj)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ValidatePortInfo", func() {
+		driver := "i40e"
+		profile := func(port starlingxv1.EthernetPortInfo) *starlingxv1.HostProfile {
+			return &starlingxv1.HostProfile{
+				Spec: starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{
+								CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{Name: "data0"},
+								Port:                port,
+							},
+						},
+					},
+				},
+			}
+		}
+		Context("When a port has neither a name nor a selector", func() {
+			It("should return an error", func() {
+				err := validatePortInfo(profile(starlingxv1.EthernetPortInfo{}))
+				msg := errors.New("ethernet interface \"data0\" must include a port 'name' or a 'selector' attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a port has both a name and a selector", func() {
+			It("should return an error", func() {
+				err := validatePortInfo(profile(starlingxv1.EthernetPortInfo{
+					Name:     "ens1f0",
+					Selector: &starlingxv1.PortSelector{Driver: &driver},
+				}))
+				msg := errors.New("ethernet interface \"data0\" must not include both a port 'name' and a 'selector' attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a port has an empty selector", func() {
+			It("should return an error", func() {
+				err := validatePortInfo(profile(starlingxv1.EthernetPortInfo{
+					Selector: &starlingxv1.PortSelector{},
+				}))
+				msg := errors.New("ethernet interface \"data0\" port selector must include at least one attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a port selector has an invalid MAC expression", func() {
+			It("should return an error", func() {
+				mac := "3c:fd:fe:("
+				err := validatePortInfo(profile(starlingxv1.EthernetPortInfo{
+					Selector: &starlingxv1.PortSelector{MAC: &mac},
+				}))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("port selector 'mac' is not a valid expression"))
+			})
+		})
+		Context("When a port is selected by its attributes", func() {
+			It("should succeed without error", func() {
+				mac := "^3c:fd:fe:"
+				err := validatePortInfo(profile(starlingxv1.EthernetPortInfo{
+					Selector: &starlingxv1.PortSelector{MAC: &mac, Driver: &driver},
+				}))
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ValidateAddressPoolInfo", func() {
+		profile := func(class string, pools ...string) *starlingxv1.HostProfile {
+			return &starlingxv1.HostProfile{
+				Spec: starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						VLAN: starlingxv1.VLANList{
+							{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+								Name:         "data0",
+								Class:        class,
+								AddressPools: pools,
+							}},
+						},
+					},
+				},
+			}
+		}
+		Context("When a pci-sriov interface references an address pool", func() {
+			It("should return an error", func() {
+				err := validateAddressPoolInfo(profile("pci-sriov", "data-v4"))
+				msg := errors.New("interface \"data0\" must be a data or platform interface to reference address pools")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When an address pool is referenced twice", func() {
+			It("should return an error", func() {
+				err := validateAddressPoolInfo(profile("data", "data-v4", "data-v4"))
+				msg := errors.New("interface \"data0\" references address pool \"data-v4\" more than once")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a data interface references address pools", func() {
+			It("should succeed without error", func() {
+				err := validateAddressPoolInfo(profile("data", "data-v4", "data-v6"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
 	})
 
 	Describe("ValidateMemoryInfo", func() {
@@ -255,8 +581,91 @@ var _ = Describe("HostProfileWebhook", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
+		Context("When an OSD has neither a path nor a selector", func() {
+			It("should return an error", func() {
+				obj := &starlingxv1.HostProfile{
+					Spec: starlingxv1.HostProfileSpec{
+						Storage: &starlingxv1.ProfileStorageInfo{
+							OSDs: starlingxv1.OSDList{
+								{Function: "osd"},
+							},
+						},
+					},
+				}
+				err := validateStorageInfo(obj)
+				msg := errors.New("OSD specifications must include a 'path' or a 'selector' attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+	Describe("ValidateFileSystemSize", func() {
+		Context("When neither a size nor a size policy is present", func() {
+			It("should return an error", func() {
+				err := validateFileSystemSize("docker", 0, nil)
+				msg := errors.New("filesystem \"docker\" must include a 'size' or a 'sizePolicy' attribute")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a size policy requests both a percentage and all available space", func() {
+			It("should return an error", func() {
+				percent, maxAvailable := 50, true
+				policy := &starlingxv1.FileSystemSizePolicy{Percent: &percent, MaxAvailable: &maxAvailable}
+				err := validateFileSystemSize("docker", 0, policy)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("When the size policy bounds are inverted", func() {
+			It("should return an error", func() {
+				low, high := 50, 40
+				policy := &starlingxv1.FileSystemSizePolicy{Min: &low, Max: &high}
+				err := validateFileSystemSize("docker", 0, policy)
+				msg := errors.New("filesystem \"docker\" size policy 'min' must not exceed 'max'")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When a valid size policy is present", func() {
+			It("should succeed without error", func() {
+				percent, low := 50, 30
+				policy := &starlingxv1.FileSystemSizePolicy{Percent: &percent, Min: &low}
+				err := validateFileSystemSize("docker", 0, policy)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+	Describe("ValidateInstallDeviceInfo", func() {
+		Context("When the root device is given by path and by selector", func() {
+			It("should return an error", func() {
+				path, model := "/dev/sda", "Samsung SSD 860"
+				obj := &starlingxv1.HostProfile{}
+				obj.Spec.RootDevice = &path
+				obj.Spec.RootDeviceSelector = &starlingxv1.DiskSelector{Model: &model}
+				err := validateInstallDeviceInfo(obj)
+				msg := errors.New("'rootDevice' and 'rootDeviceSelector' are mutually exclusive")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("When the boot device is given by selector", func() {
+			It("should succeed without error", func() {
+				model := "Samsung SSD 860"
+				obj := &starlingxv1.HostProfile{}
+				obj.Spec.BootDeviceSelector = &starlingxv1.DiskSelector{Model: &model}
+				err := validateInstallDeviceInfo(obj)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
 	})
 	Describe("ValidateHostProfile", func() {
+		Context("when the deployment scope annotation is not supported", func() {
+			It("should fail validation with error", func() {
+				obj := &starlingxv1.HostProfile{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{starlingxv1.DeploymentScopeAnnotation: "everything"},
+					},
+				}
+				err := validateHostProfile(obj)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 		Context("When the spec base is empty", func() {
 			It("should return profile base name must not be empty error", func() {
 				size := 1
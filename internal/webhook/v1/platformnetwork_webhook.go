@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -62,7 +62,7 @@ func (v *PlatformNetworkCustomValidator) ValidateCreate(ctx context.Context, obj
 	hostlog.Info("validate create", "name", platformNetwork.Name)
 
 	platformnetworklog.Info("validate create", "name", platformNetwork.Name)
-	return nil, nil
+	return nil, starlingxv1.ValidateDeploymentScopeAnnotation(platformNetwork)
 }
 
 // TODO(sriram-gn): Identify and update validations for update of PlatformNetwork resources.
@@ -73,7 +73,7 @@ func (v *PlatformNetworkCustomValidator) ValidateUpdate(ctx context.Context, old
 		return nil, fmt.Errorf("expected a PlatformNetwork object but got %T", newObj)
 	}
 	platformnetworklog.Info("validate update", "name", platformNetwork.Name)
-	return nil, nil
+	return nil, starlingxv1.ValidateDeploymentScopeAnnotation(platformNetwork)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
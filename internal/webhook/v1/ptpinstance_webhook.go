@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022,2025 Wind River Systems, Inc. */
+/* Copyright(c) 2022,2025-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -12,6 +12,7 @@ import (
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -52,6 +53,10 @@ func (d *PtpInstanceCustomDefaulter) Default(ctx context.Context, obj runtime.Ob
 // supports the necessary validation annotations we need to do this in a webhook.  All other validation is left
 // to the system API and any errors generated by that API will be reported in the resource status and events.
 func validatePtpInstance(r *starlingxv1.PtpInstance) error {
+	if err := starlingxv1.ValidateDeploymentScopeAnnotation(r); err != nil {
+		return err
+	}
+
 	// Multiple parameters are allowed for these unicast_master_table section's parameters
 	allowedMultipleUMTSet := map[string]bool{
 		"UDPv4": true,
@@ -88,8 +93,7 @@ func validatePtpInstance(r *starlingxv1.PtpInstance) error {
 	return nil
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-ptpinstance,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=ptpinstances,versions=v1,name=vptpinstance.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-starlingx-windriver-com-v1-ptpinstance,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=ptpinstances,versions=v1,name=vptpinstance.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
 
 type PtpInstanceCustomValidator struct{}
 
@@ -122,6 +126,9 @@ func (d *PtpInstanceCustomValidator) ValidateDelete(cxt context.Context, obj run
 		return nil, fmt.Errorf("expected a PtpInstance object but got %T", obj)
 	}
 	ptpinstancelog.Info("validate delete", "name", ptpInstance.Name)
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil, nil
+
+	return nil, validateDeletion(cxt, ptpInstance, "PtpInstance",
+		func(ctx context.Context, c client.Client) ([]string, error) {
+			return findPtpInstanceConsumers(ctx, c, ptpInstance)
+		})
 }
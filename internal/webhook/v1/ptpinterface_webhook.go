@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022, 2024-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2022, 2024-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -12,6 +12,7 @@ import (
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -52,6 +53,10 @@ func (d *PtpInterfaceCustomDefaulter) Default(ctx context.Context, obj runtime.O
 // supports the necessary validation annotations we need to do this in a webhook.  All other validation is left
 // to the system API and any errors generated by that API will be reported in the resource status and events.
 func validatePtpInterface(r *starlingxv1.PtpInterface) error {
+	if err := starlingxv1.ValidateDeploymentScopeAnnotation(r); err != nil {
+		return err
+	}
+
 	present := make(map[string]bool)
 	delim := "="
 	for _, parameter := range r.Spec.InterfaceParameters {
@@ -77,8 +82,7 @@ func validatePtpInterface(r *starlingxv1.PtpInterface) error {
 	return nil
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-starlingx-windriver-com-v1-ptpinterface,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=ptpinterfaces,versions=v1,name=vptpinterface.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-starlingx-windriver-com-v1-ptpinterface,mutating=false,failurePolicy=fail,sideEffects=None,groups=starlingx.windriver.com,resources=ptpinterfaces,versions=v1,name=vptpinterface.kb.io,admissionReviewVersions=v1,timeoutSeconds=30
 
 type PtpInterfaceCustomValidator struct{}
 
@@ -111,5 +115,9 @@ func (d *PtpInterfaceCustomValidator) ValidateDelete(cxt context.Context, obj ru
 		return nil, fmt.Errorf("expected a PtpInteface object but got %T", obj)
 	}
 	ptpinterfacelog.Info("validate delete", "name", ptpInterface.Name)
-	return nil, nil
+
+	return nil, validateDeletion(cxt, ptpInterface, "PtpInterface",
+		func(ctx context.Context, c client.Client) ([]string, error) {
+			return findPtpInterfaceConsumers(ctx, c, ptpInterface)
+		})
 }
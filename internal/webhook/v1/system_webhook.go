@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -7,12 +7,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"time"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/remotelogging"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -175,6 +179,15 @@ func validateStorage(obj *starlingxv1.System) error {
 		}
 	}
 
+	if obj.Spec.Storage != nil {
+		for _, fs := range obj.Spec.Storage.FileSystems {
+			err := validateFileSystemSize(fs.Name, fs.Size, fs.SizePolicy)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -211,7 +224,85 @@ func validateCertificates(obj *starlingxv1.System) error {
 	return nil
 }
 
+// validateRemoteLogging validates that the remote logging server is either an
+// IP address or a FQDN hostname and that the certificate reference is only
+// used with the tls transport and refers to a CA certificate that is listed
+// in the system certificates.
+func validateRemoteLogging(obj *starlingxv1.System) error {
+	rl := obj.Spec.RemoteLogging
+
+	if rl.Server != nil && net.ParseIP(*rl.Server) == nil {
+		if errs := validation.IsDNS1123Subdomain(*rl.Server); len(errs) > 0 {
+			msg := fmt.Sprintf("remote logging server %q must be an IP address or a FQDN hostname", *rl.Server)
+			return errors.New(msg)
+		}
+	}
+
+	if rl.Certificate == nil {
+		return nil
+	}
+
+	if rl.Transport == nil || *rl.Transport != remotelogging.TransportTLS {
+		return errors.New("remote logging certificate is only applicable to the tls transport")
+	}
+
+	for _, c := range obj.Spec.Certificates {
+		if c.Type == starlingxv1.PlatformCACertificate && c.Secret == *rl.Certificate {
+			return nil
+		}
+	}
+
+	msg := fmt.Sprintf("remote logging certificate %q must refer to a %s certificate in the system certificates",
+		*rl.Certificate, starlingxv1.PlatformCACertificate)
+	return errors.New(msg)
+}
+
+// validateServiceParameters validates the value of each service parameter
+// that is known to the service parameter catalog.
+func validateServiceParameters(obj *starlingxv1.System) error {
+	for _, sp := range obj.Spec.ServiceParameters {
+		err := utils.ValidateServiceParameter(sp.Service, sp.Section, sp.ParamName, sp.ParamValue)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateServiceParameterRemovals returns a warning for each service
+// parameter dropped from the spec that cannot be deleted from the system.
+func validateServiceParameterRemovals(old, obj *starlingxv1.System) admission.Warnings {
+	var warnings admission.Warnings
+
+	for _, sp := range old.Spec.ServiceParameters {
+		if utils.IsServiceParameterDeletable(sp.Service, sp.Section, sp.ParamName) {
+			continue
+		}
+
+		found := false
+		for _, x := range obj.Spec.ServiceParameters {
+			if sp.IsKeyEqual(x) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			warnings = append(warnings, fmt.Sprintf(
+				"service parameter %s/%s/%s cannot be deleted from the system and is left in place",
+				sp.Service, sp.Section, sp.ParamName))
+		}
+	}
+
+	return warnings
+}
+
 func validatingSystem(r *starlingxv1.System) error {
+	if err := starlingxv1.ValidateDeploymentScopeAnnotation(r); err != nil {
+		return err
+	}
+
 	err := validateStorage(r)
 	if err != nil {
 		return err
@@ -222,6 +313,18 @@ func validatingSystem(r *starlingxv1.System) error {
 		return err
 	}
 
+	if r.Spec.RemoteLogging != nil {
+		err = validateRemoteLogging(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = validateServiceParameters(r)
+	if err != nil {
+		return err
+	}
+
 	systemlog.Info(SystemAllowedReason)
 	return nil
 }
@@ -250,7 +353,13 @@ func (v *SystemCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newO
 		return nil, fmt.Errorf("expected a System object but got %T", newObj)
 	}
 	systemlog.Info("validate update", "name", system.Name)
-	return nil, validatingSystem(system)
+	if err := validatingSystem(system); err != nil {
+		return nil, err
+	}
+	if oldSystem, ok := oldObj.(*starlingxv1.System); ok {
+		return validateServiceParameterRemovals(oldSystem, system), nil
+	}
+	return nil, nil
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -235,6 +235,130 @@ var _ = Describe("SystemWebhook", func() {
 			})
 		})
 	})
+	Describe("ValidateRemoteLogging", func() {
+		Context("when the server is a FQDN hostname", func() {
+			It("should succeed without error", func() {
+				server := "syslog.example.com"
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						RemoteLogging: &starlingxv1.RemoteLoggingInfo{Server: &server},
+					},
+				}
+				err := validateRemoteLogging(obj)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+		Context("when the server is neither an IP address nor a FQDN hostname", func() {
+			It("should return an error", func() {
+				server := "syslog_server!"
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						RemoteLogging: &starlingxv1.RemoteLoggingInfo{Server: &server},
+					},
+				}
+				err := validateRemoteLogging(obj)
+				msg := errors.New("remote logging server \"syslog_server!\" must be an IP address or a FQDN hostname")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("when a certificate is used without the tls transport", func() {
+			It("should return an error", func() {
+				transport := "udp"
+				cert := "syslog-ca"
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						RemoteLogging: &starlingxv1.RemoteLoggingInfo{Transport: &transport, Certificate: &cert},
+					},
+				}
+				err := validateRemoteLogging(obj)
+				msg := errors.New("remote logging certificate is only applicable to the tls transport")
+				Expect(err).To(Equal(msg))
+			})
+		})
+		Context("when the certificate refers to a listed CA certificate", func() {
+			It("should succeed without error", func() {
+				server := "10.10.10.1"
+				transport := "tls"
+				cert := "syslog-ca"
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						Certificates: starlingxv1.CertificateList{
+							{Type: starlingxv1.PlatformCACertificate, Secret: "syslog-ca"},
+						},
+						RemoteLogging: &starlingxv1.RemoteLoggingInfo{Server: &server, Transport: &transport, Certificate: &cert},
+					},
+				}
+				err := validateRemoteLogging(obj)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+		Context("when the certificate is not listed", func() {
+			It("should return an error", func() {
+				transport := "tls"
+				cert := "syslog-ca"
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						RemoteLogging: &starlingxv1.RemoteLoggingInfo{Transport: &transport, Certificate: &cert},
+					},
+				}
+				err := validateRemoteLogging(obj)
+				msg := errors.New("remote logging certificate \"syslog-ca\" must refer to a ssl_ca certificate in the system certificates")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+	Describe("ValidateServiceParameters", func() {
+		Context("when the values are within the catalog ranges", func() {
+			It("should succeed without error", func() {
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						ServiceParameters: starlingxv1.ServiceParameterList{
+							{Service: "platform", Section: "maintenance", ParamName: "worker_boot_timeout", ParamValue: "720"},
+							{Service: "kubernetes", Section: "kube_apiserver", ParamName: "oidc-issuer-url", ParamValue: "https://10.10.10.1:30556/dex"},
+						},
+					},
+				}
+				err := validateServiceParameters(obj)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+		Context("when a value is out of range", func() {
+			It("should return an error", func() {
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						ServiceParameters: starlingxv1.ServiceParameterList{
+							{Service: "identity", Section: "config", ParamName: "token_expiration", ParamValue: "60"},
+						},
+					},
+				}
+				err := validateServiceParameters(obj)
+				msg := errors.New("service parameter identity/config/token_expiration must be at least 3600: 60")
+				Expect(err).To(Equal(msg))
+			})
+		})
+	})
+	Describe("ValidateServiceParameterRemovals", func() {
+		Context("when parameters are dropped from the spec", func() {
+			It("should only warn about the parameters that are not deletable", func() {
+				old := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						ServiceParameters: starlingxv1.ServiceParameterList{
+							{Service: "platform", Section: "maintenance", ParamName: "worker_boot_timeout", ParamValue: "720"},
+							{Service: "kubernetes", Section: "kube_apiserver", ParamName: "oidc-issuer-url", ParamValue: "https://10.10.10.1:30556/dex"},
+						},
+					},
+				}
+				obj := &starlingxv1.System{}
+
+				v := &SystemCustomValidator{}
+				warnings, err := v.ValidateUpdate(ctx, old, obj)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(ContainSubstring("platform/maintenance/worker_boot_timeout")))
+
+				Expect(validateServiceParameterRemovals(old, old)).To(BeEmpty())
+			})
+		})
+	})
 	Describe("ValidateStorage", func() {
 		Context("when Backends is not nil and services are belonging to the backend type", func() {
 			It("should return nil error", func() {
@@ -0,0 +1,141 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package applications provides access to the platform application
+// lifecycle operations of the StarlingX system inventory API, including the
+// management of the Helm override values of each application chart.
+package applications
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the lifecycle directives accepted by the system.
+const (
+	DirectiveApply  = "apply"
+	DirectiveRemove = "remove"
+)
+
+// Defines the Helm override update flags accepted by the system.
+const (
+	OverridesReset = "reset"
+	OverridesReuse = "reuse"
+)
+
+// UploadOpts defines the attributes required to upload a new application or
+// update an existing application to a new version.
+type UploadOpts struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"app_version,omitempty"`
+	Tarball string  `json:"tarfile"`
+}
+
+// OverridesOpts defines the attributes required to set the user Helm
+// override values of an application chart.
+type OverridesOpts struct {
+	Flag   string         `json:"flag"`
+	Values OverrideValues `json:"values"`
+}
+
+// OverrideValues defines the user override values of a chart either as a
+// list of YAML documents or as a list of key=value assignments.
+type OverrideValues struct {
+	Files []string `json:"files,omitempty"`
+	Set   []string `json:"set,omitempty"`
+}
+
+// Get retrieves a specific application based on its unique name.
+func Get(c *gophercloud.ServiceClient, name string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, name), &r.Body, nil)
+	return r
+}
+
+// List retrieves all applications known to the system.
+func List(c *gophercloud.ServiceClient) (r ListResult) {
+	_, r.Err = c.Get(listURL(c), &r.Body, nil)
+	return r
+}
+
+// ListApplications is a convenience function to list and extract the
+// entire list of applications.
+func ListApplications(c *gophercloud.ServiceClient) ([]Application, error) {
+	return List(c).Extract()
+}
+
+// Upload accepts an UploadOpts struct and uploads a new application.  The
+// upload is asynchronous; the caller is expected to poll the application
+// status until it is no longer in progress.
+func Upload(c *gophercloud.ServiceClient, opts UploadOpts) (r UploadResult) {
+	reqBody, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Post(uploadURL(c), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// Update accepts an UploadOpts struct and updates an existing application to
+// the version contained in the provided tarball.
+func Update(c *gophercloud.ServiceClient, opts UploadOpts) (r UpdateResult) {
+	reqBody, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Patch(updateURL(c), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// Apply requests that an uploaded application be applied.
+func Apply(c *gophercloud.ServiceClient, name string) (r DirectiveResult) {
+	return directive(c, name, DirectiveApply)
+}
+
+// Remove requests that an applied application be removed.  The application
+// remains uploaded until it is deleted.
+func Remove(c *gophercloud.ServiceClient, name string) (r DirectiveResult) {
+	return directive(c, name, DirectiveRemove)
+}
+
+func directive(c *gophercloud.ServiceClient, name string, directive string) (r DirectiveResult) {
+	reqBody := map[string]interface{}{"values": map[string]interface{}{}}
+	_, r.Err = c.Patch(directiveURL(c, name, directive), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// Delete accepts a unique name and deletes the application associated with
+// it.  Only uploaded, or failed, applications can be deleted.
+func Delete(c *gophercloud.ServiceClient, name string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, name), nil)
+	return r
+}
+
+// GetOverrides retrieves the Helm overrides of a single application chart.
+func GetOverrides(c *gophercloud.ServiceClient, app string, chart string, namespace string) (r OverridesResult) {
+	_, r.Err = c.Get(overridesURL(c, app, chart, namespace), &r.Body, nil)
+	return r
+}
+
+// UpdateOverrides accepts an OverridesOpts struct and updates the user Helm
+// overrides of a single application chart.
+func UpdateOverrides(c *gophercloud.ServiceClient, app string, chart string, namespace string, opts OverridesOpts) (r OverridesResult) {
+	reqBody, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Patch(overridesURL(c, app, chart, namespace), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
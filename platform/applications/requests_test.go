@@ -0,0 +1,104 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package applications
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestGet(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"name": "rook-ceph", "app_version": "25.09-1", "status": "applied", "progress": "completed", "active": true}`)
+	defer done()
+
+	app, err := Get(client, "rook-ceph").Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/apps/rook-ceph" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if app.Version != "25.09-1" || app.Status != StatusApplied || !app.Active {
+		t.Errorf("unexpected application: %+v", app)
+	}
+
+	if app.InProgress() || app.Failed() {
+		t.Errorf("applied application should be stable: %+v", app)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK, `{"name": "rook-ceph", "status": "uploading"}`)
+	defer done()
+
+	name := "rook-ceph"
+	opts := UploadOpts{Name: &name, Tarball: "/usr/local/share/applications/helm/rook-ceph.tgz"}
+	app, err := Upload(client, opts).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPost || recorded.URI != "/apps" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if recorded.Body["tarfile"] != opts.Tarball || recorded.Body["name"] != name {
+		t.Errorf("unexpected request body: %v", recorded.Body)
+	}
+
+	if _, present := recorded.Body["app_version"]; present {
+		t.Errorf("unexpected version in request body: %v", recorded.Body)
+	}
+
+	if !app.InProgress() {
+		t.Errorf("uploading application should be in progress: %+v", app)
+	}
+}
+
+func TestApply(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK, `{"name": "rook-ceph", "status": "applying"}`)
+	defer done()
+
+	_, err := Apply(client, "rook-ceph").Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPatch || recorded.URI != "/apps/rook-ceph?directive=apply" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+}
+
+func TestUpdateOverrides(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"name": "rook-ceph", "namespace": "rook-ceph", "user_overrides": "replicas: 2\n"}`)
+	defer done()
+
+	opts := OverridesOpts{
+		Flag:   OverridesReset,
+		Values: OverrideValues{Files: []string{"replicas: 2\n"}},
+	}
+	overrides, err := UpdateOverrides(client, "rook-ceph", "rook-ceph-cluster", "rook-ceph", opts).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPatch ||
+		recorded.URI != "/helm_charts/rook-ceph-cluster?name=rook-ceph&namespace=rook-ceph" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if recorded.Body["flag"] != OverridesReset {
+		t.Errorf("unexpected request body: %v", recorded.Body)
+	}
+
+	if overrides.UserOverrides != "replicas: 2\n" {
+		t.Errorf("unexpected overrides: %+v", overrides)
+	}
+}
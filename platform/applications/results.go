@@ -0,0 +1,145 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package applications
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the application status values reported by the system.
+const (
+	StatusUploading    = "uploading"
+	StatusUploadFailed = "upload-failed"
+	StatusUploaded     = "uploaded"
+	StatusApplying     = "applying"
+	StatusApplyFailed  = "apply-failed"
+	StatusApplied      = "applied"
+	StatusRemoving     = "removing"
+	StatusRemoveFailed = "remove-failed"
+	StatusUpdating     = "updating"
+	StatusUpdateFailed = "update-failed"
+	StatusRecovering   = "recovering"
+)
+
+// Application represents a platform application known to the system.
+type Application struct {
+	// Name is the unique name of the application.
+	Name string `json:"name"`
+
+	// Version is the version of the application currently known to the
+	// system.
+	Version string `json:"app_version"`
+
+	// Manifest is the name of the application manifest.
+	Manifest string `json:"manifest_name"`
+
+	// Status is the current lifecycle status of the application.
+	Status string `json:"status"`
+
+	// Progress is the progress message of the last lifecycle operation.
+	Progress string `json:"progress"`
+
+	// Active indicates whether the application is currently active.
+	Active bool `json:"active"`
+}
+
+// InProgress returns whether the application is transitioning between two
+// stable states and no other operation may be requested.
+func (a *Application) InProgress() bool {
+	switch a.Status {
+	case StatusUploading, StatusApplying, StatusRemoving,
+		StatusUpdating, StatusRecovering:
+		return true
+	}
+	return false
+}
+
+// Failed returns whether the last lifecycle operation failed.
+func (a *Application) Failed() bool {
+	switch a.Status {
+	case StatusUploadFailed, StatusApplyFailed, StatusRemoveFailed,
+		StatusUpdateFailed:
+		return true
+	}
+	return false
+}
+
+// HelmOverrides represents the Helm overrides of a single chart of an
+// application.
+type HelmOverrides struct {
+	// Name is the name of the Helm chart.
+	Name string `json:"name"`
+
+	// Namespace is the namespace in which the chart is deployed.
+	Namespace string `json:"namespace"`
+
+	// UserOverrides are the user supplied override values in YAML format.
+	UserOverrides string `json:"user_overrides"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an Application
+// resource.
+func (r commonResult) Extract() (*Application, error) {
+	var s Application
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UploadResult represents the result of an upload operation.
+type UploadResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DirectiveResult represents the result of an apply or remove operation.
+type DirectiveResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of
+// Application resources.
+func (r ListResult) Extract() ([]Application, error) {
+	var s struct {
+		Applications []Application `json:"apps"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Applications, err
+}
+
+// OverridesResult represents the result of a Helm overrides get or update
+// operation.
+type OverridesResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a HelmOverrides
+// resource.
+func (r OverridesResult) Extract() (*HelmOverrides, error) {
+	var s HelmOverrides
+	err := r.ExtractInto(&s)
+	return &s, err
+}
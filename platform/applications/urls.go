@@ -0,0 +1,46 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package applications
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	resourcePath = "apps"
+	chartPath    = "helm_charts"
+)
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func getURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL(resourcePath, name)
+}
+
+func uploadURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func updateURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "update")
+}
+
+func directiveURL(c *gophercloud.ServiceClient, name string, directive string) string {
+	return c.ServiceURL(resourcePath, name) + "?directive=" + url.QueryEscape(directive)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL(resourcePath, name)
+}
+
+func overridesURL(c *gophercloud.ServiceClient, app string, chart string, namespace string) string {
+	query := url.Values{}
+	query.Set("name", app)
+	query.Set("namespace", namespace)
+	return c.ServiceURL(chartPath, chart) + "?" + query.Encode()
+}
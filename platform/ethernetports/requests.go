@@ -0,0 +1,23 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package ethernetports provides access to the hardware attributes of the
+// host Ethernet ports of the StarlingX system inventory API.  It is used to
+// select ports by their attributes rather than by their kernel names.
+package ethernetports
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// List retrieves all Ethernet ports of a host.
+func List(c *gophercloud.ServiceClient, hostID string) (r ListResult) {
+	_, r.Err = c.Get(listURL(c, hostID), &r.Body, nil)
+	return r
+}
+
+// ListPorts is a convenience function to list and extract the entire list of
+// Ethernet ports of a host.
+func ListPorts(c *gophercloud.ServiceClient, hostID string) ([]EthernetPort, error) {
+	return List(c, hostID).Extract()
+}
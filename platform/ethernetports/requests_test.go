@@ -0,0 +1,74 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package ethernetports
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestListPorts(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"ethernet_ports": [
+			{"uuid": "p1", "name": "enp24s0f0", "pciaddr": "0000:18:00.0", "mac": "3c:fd:fe:a1:b2:c0",
+			 "pvendor": "Intel Corporation [8086]", "pdevice": "Ethernet Controller X710 for 10GbE SFP+ [1572]",
+			 "driver": "i40e", "numa_node": 0, "interface_uuid": "i1"},
+			{"uuid": "p2", "name": "eno1", "pciaddr": "0000:00:1f.6", "mac": "00:11:22:33:44:55",
+			 "pvendor": "Intel Corporation", "pdevice": "Ethernet Connection (7) I219-LM",
+			 "driver": "e1000e", "numa_node": null, "interface_uuid": "i2"}
+		]}`)
+	defer done()
+
+	result, err := ListPorts(client, "h1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/ihosts/h1/ethernet_ports" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("unexpected ports: %+v", result)
+	}
+
+	if result[0].VendorID() != "8086" || result[0].DeviceID() != "1572" ||
+		result[0].NUMANode == nil || *result[0].NUMANode != 0 || result[0].InterfaceID != "i1" {
+		t.Errorf("unexpected port: %+v", result[0])
+	}
+
+	if result[1].VendorID() != "Intel Corporation" || result[1].NUMANode != nil {
+		t.Errorf("unexpected port: %+v", result[1])
+	}
+}
+
+func TestExtractPorts(t *testing.T) {
+	client, _, done := testclient.New(t, http.StatusOK,
+		`{"ethernet_ports": [
+			{"uuid": "p1", "name": "enp24s0f0", "pciaddr": "0000:18:00.0", "interface_uuid": "i1"}
+		]}`)
+	defer done()
+
+	result := List(client, "h1")
+
+	ports, err := result.ExtractPorts()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(ports) != 1 || ports[0].Name != "enp24s0f0" || ports[0].InterfaceID != "i1" {
+		t.Errorf("unexpected ports: %+v", ports)
+	}
+
+	attributes, err := result.Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(attributes) != 1 || attributes[0].PCIAddress != "0000:18:00.0" {
+		t.Errorf("unexpected port attributes: %+v", attributes)
+	}
+}
@@ -0,0 +1,92 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package ethernetports
+
+import (
+	"regexp"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/ports"
+)
+
+// pciIDExpression extracts the hexadecimal identifier which the system
+// appends to the vendor and device descriptions (e.g., "Intel Corporation
+// [8086]").
+var pciIDExpression = regexp.MustCompile(`\[([0-9a-fA-F]{4})\]\s*$`)
+
+// EthernetPort represents the hardware attributes of a host Ethernet port.
+type EthernetPort struct {
+	// ID is the unique identifier of the port.
+	ID string `json:"uuid"`
+
+	// Name is the kernel name of the port.
+	Name string `json:"name"`
+
+	// PCIAddress is the PCI address of the port.
+	PCIAddress string `json:"pciaddr"`
+
+	// MAC is the MAC address of the port.
+	MAC string `json:"mac"`
+
+	// Vendor is the description of the PCI vendor of the port.
+	Vendor string `json:"pvendor"`
+
+	// Device is the description of the PCI device of the port.
+	Device string `json:"pdevice"`
+
+	// Driver is the driver bound to the port.
+	Driver string `json:"driver"`
+
+	// NUMANode is the NUMA node to which the port is attached.
+	NUMANode *int `json:"numa_node,omitempty"`
+
+	// InterfaceID is the unique identifier of the interface associated to the
+	// port.
+	InterfaceID string `json:"interface_uuid"`
+}
+
+// pciID is a utility function which returns the identifier appended to a PCI
+// vendor or device description, or the description itself if none is found.
+func pciID(description string) string {
+	if m := pciIDExpression.FindStringSubmatch(description); m != nil {
+		return m[1]
+	}
+	return description
+}
+
+// VendorID returns the PCI vendor identifier of the port.
+func (in *EthernetPort) VendorID() string {
+	return pciID(in.Vendor)
+}
+
+// DeviceID returns the PCI device identifier of the port.
+func (in *EthernetPort) DeviceID() string {
+	return pciID(in.Device)
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of
+// EthernetPort resources.
+func (r ListResult) Extract() ([]EthernetPort, error) {
+	var s struct {
+		Ports []EthernetPort `json:"ethernet_ports"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Ports, err
+}
+
+// ExtractPorts is a function that accepts a result and extracts the list of
+// ports as returned by the inventory client so that both can be populated
+// from a single request.
+func (r ListResult) ExtractPorts() ([]ports.Port, error) {
+	var s struct {
+		Ports []ports.Port `json:"ethernet_ports"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Ports, err
+}
@@ -0,0 +1,179 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package identity provides access to the subset of the keystone identity v3
+// API required to declare platform projects, users, and role assignments.
+package identity
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ListOpts defines the filters used to look up projects and users by name.
+type ListOpts struct {
+	Name     string `q:"name"`
+	DomainID string `q:"domain_id"`
+}
+
+// ToQuery converts the list filters to a URL query string.
+func (opts ListOpts) ToQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// RoleAssignmentListOpts defines the filters used to look up the roles
+// assigned to a user on a project.
+type RoleAssignmentListOpts struct {
+	UserID    string `q:"user.id"`
+	ProjectID string `q:"scope.project.id"`
+}
+
+// ToQuery converts the list filters to a URL query string.
+func (opts RoleAssignmentListOpts) ToQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// ProjectOpts defines the attributes of a project that can be set on create
+// or update.  Only the attributes that are set are included in the request.
+type ProjectOpts struct {
+	Name        string  `json:"name,omitempty"`
+	DomainID    string  `json:"domain_id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToBody converts the project attributes to a request body.
+func (opts ProjectOpts) ToBody() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "project")
+}
+
+// UserOpts defines the attributes of a user that can be set on create or
+// update.  Only the attributes that are set are included in the request.
+type UserOpts struct {
+	Name             string  `json:"name,omitempty"`
+	DomainID         string  `json:"domain_id,omitempty"`
+	DefaultProjectID *string `json:"default_project_id,omitempty"`
+	Email            *string `json:"email,omitempty"`
+	Enabled          *bool   `json:"enabled,omitempty"`
+	Password         *string `json:"password,omitempty"`
+}
+
+// ToBody converts the user attributes to a request body.
+func (opts UserOpts) ToBody() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "user")
+}
+
+// ListProjects retrieves the projects matching the supplied filters.
+func ListProjects(c *gophercloud.ServiceClient, opts ListOpts) (r ProjectListResult) {
+	query, err := opts.ToQuery()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Get(projectsURL(c)+query, &r.Body, nil)
+	return r
+}
+
+// CreateProject accepts a ProjectOpts struct and creates a new project.
+func CreateProject(c *gophercloud.ServiceClient, opts ProjectOpts) (r ProjectResult) {
+	body, err := opts.ToBody()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Post(projectsURL(c), body, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return r
+}
+
+// UpdateProject accepts a ProjectOpts struct and updates an existing project.
+func UpdateProject(c *gophercloud.ServiceClient, id string, opts ProjectOpts) (r ProjectResult) {
+	body, err := opts.ToBody()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Patch(projectURL(c, id), body, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// ListUsers retrieves the users matching the supplied filters.
+func ListUsers(c *gophercloud.ServiceClient, opts ListOpts) (r UserListResult) {
+	query, err := opts.ToQuery()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Get(usersURL(c)+query, &r.Body, nil)
+	return r
+}
+
+// CreateUser accepts a UserOpts struct and creates a new user.
+func CreateUser(c *gophercloud.ServiceClient, opts UserOpts) (r UserResult) {
+	body, err := opts.ToBody()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Post(usersURL(c), body, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return r
+}
+
+// UpdateUser accepts a UserOpts struct and updates an existing user.
+func UpdateUser(c *gophercloud.ServiceClient, id string, opts UserOpts) (r UserResult) {
+	body, err := opts.ToBody()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Patch(userURL(c, id), body, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// ListRoles retrieves all roles.
+func ListRoles(c *gophercloud.ServiceClient) (r RoleListResult) {
+	_, r.Err = c.Get(rolesURL(c), &r.Body, nil)
+	return r
+}
+
+// ListRoleAssignments retrieves the role assignments matching the supplied
+// filters.
+func ListRoleAssignments(c *gophercloud.ServiceClient, opts RoleAssignmentListOpts) (r RoleAssignmentListResult) {
+	query, err := opts.ToQuery()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Get(roleAssignmentsURL(c)+query, &r.Body, nil)
+	return r
+}
+
+// AssignRole grants a role to a user on a project.  The operation is
+// idempotent.
+func AssignRole(c *gophercloud.ServiceClient, projectID string, userID string, roleID string) (r AssignRoleResult) {
+	_, r.Err = c.Put(assignRoleURL(c, projectID, userID, roleID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return r
+}
@@ -0,0 +1,122 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package identity
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestListUsers(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"users": [{"id": "u1", "name": "operator", "domain_id": "default",
+			"default_project_id": "p1", "email": "ops@example.com", "enabled": true}]}`)
+	defer done()
+
+	result, err := ListUsers(client, ListOpts{Name: "operator", DomainID: DefaultDomainID}).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/users?domain_id=default&name=operator" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(result) != 1 || result[0].ID != "u1" || result[0].DefaultProjectID != "p1" || !result[0].Enabled {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusCreated,
+		`{"user": {"id": "u1", "name": "operator", "domain_id": "default", "enabled": true}}`)
+	defer done()
+
+	password := "secret"
+	project := "p1"
+	opts := UserOpts{Name: "operator", DomainID: DefaultDomainID, DefaultProjectID: &project, Password: &password}
+	result, err := CreateUser(client, opts).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPost || recorded.URI != "/users" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	user, _ := recorded.Body["user"].(map[string]interface{})
+	if user["password"] != "secret" || user["default_project_id"] != "p1" {
+		t.Errorf("unexpected body: %+v", recorded.Body)
+	}
+
+	if _, ok := user["email"]; ok {
+		t.Errorf("unexpected email attribute: %+v", recorded.Body)
+	}
+
+	if result.ID != "u1" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestUpdateProject(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"project": {"id": "p1", "name": "tenant1", "description": "updated", "enabled": false}}`)
+	defer done()
+
+	description := "updated"
+	enabled := false
+	result, err := UpdateProject(client, "p1", ProjectOpts{Description: &description, Enabled: &enabled}).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPatch || recorded.URI != "/projects/p1" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	project, _ := recorded.Body["project"].(map[string]interface{})
+	if len(project) != 2 || project["enabled"] != false {
+		t.Errorf("unexpected body: %+v", recorded.Body)
+	}
+
+	if result.Description != "updated" || result.Enabled {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestRoleAssignments(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"role_assignments": [{"role": {"id": "r1"}, "user": {"id": "u1"},
+			"scope": {"project": {"id": "p1"}}}]}`)
+	defer done()
+
+	result, err := ListRoleAssignments(client, RoleAssignmentListOpts{UserID: "u1", ProjectID: "p1"}).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.URI != "/role_assignments?scope.project.id=p1&user.id=u1" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(result) != 1 || result[0].Role.ID != "r1" || result[0].Scope.Project.ID != "p1" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestAssignRole(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusNoContent, "")
+	defer done()
+
+	err := AssignRole(client, "p1", "u1", "r1").ExtractErr()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPut || recorded.URI != "/projects/p1/users/u1/roles/r1" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+}
@@ -0,0 +1,173 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package identity
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// DefaultDomainID is the unique identifier of the domain that is created
+// by keystone at installation time.
+const DefaultDomainID = "default"
+
+// Project represents a keystone project.
+type Project struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id"`
+
+	// Name is the name of the project which is unique within its domain.
+	Name string `json:"name"`
+
+	// Description is a free form description of the project.
+	Description string `json:"description"`
+
+	// DomainID is the unique identifier of the domain of the project.
+	DomainID string `json:"domain_id"`
+
+	// Enabled indicates whether the project can be used.
+	Enabled bool `json:"enabled"`
+}
+
+// User represents a keystone user.  Passwords are never returned by
+// keystone therefore they are not part of this representation.
+type User struct {
+	// ID is the unique identifier of the user.
+	ID string `json:"id"`
+
+	// Name is the name of the user which is unique within its domain.
+	Name string `json:"name"`
+
+	// DomainID is the unique identifier of the domain of the user.
+	DomainID string `json:"domain_id"`
+
+	// DefaultProjectID is the unique identifier of the project used when
+	// the user authenticates without specifying a scope.
+	DefaultProjectID string `json:"default_project_id"`
+
+	// Email is the email address of the user.
+	Email string `json:"email"`
+
+	// Enabled indicates whether the user can authenticate.
+	Enabled bool `json:"enabled"`
+}
+
+// Role represents a keystone role.
+type Role struct {
+	// ID is the unique identifier of the role.
+	ID string `json:"id"`
+
+	// Name is the name of the role.
+	Name string `json:"name"`
+}
+
+// RoleAssignment represents the assignment of a role to a user on a project.
+type RoleAssignment struct {
+	Role struct {
+		ID string `json:"id"`
+	} `json:"role"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	Scope struct {
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	} `json:"scope"`
+}
+
+// ProjectResult represents the result of a project create or update
+// operation.
+type ProjectResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Project
+// resource.
+func (r ProjectResult) Extract() (*Project, error) {
+	var s struct {
+		Project Project `json:"project"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Project, err
+}
+
+// UserResult represents the result of a user create or update operation.
+type UserResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a User resource.
+func (r UserResult) Extract() (*User, error) {
+	var s struct {
+		User User `json:"user"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.User, err
+}
+
+// ProjectListResult represents the result of a project list operation.
+type ProjectListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of
+// Project resources.
+func (r ProjectListResult) Extract() ([]Project, error) {
+	var s struct {
+		Projects []Project `json:"projects"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Projects, err
+}
+
+// UserListResult represents the result of a user list operation.
+type UserListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of User
+// resources.
+func (r UserListResult) Extract() ([]User, error) {
+	var s struct {
+		Users []User `json:"users"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Users, err
+}
+
+// RoleListResult represents the result of a role list operation.
+type RoleListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of Role
+// resources.
+func (r RoleListResult) Extract() ([]Role, error) {
+	var s struct {
+		Roles []Role `json:"roles"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Roles, err
+}
+
+// RoleAssignmentListResult represents the result of a role assignment list
+// operation.
+type RoleAssignmentListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of
+// RoleAssignment resources.
+func (r RoleAssignmentListResult) Extract() ([]RoleAssignment, error) {
+	var s struct {
+		RoleAssignments []RoleAssignment `json:"role_assignments"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleAssignments, err
+}
+
+// AssignRoleResult represents the result of a role assignment operation.
+type AssignRoleResult struct {
+	gophercloud.ErrResult
+}
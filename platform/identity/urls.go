@@ -0,0 +1,43 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package identity
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	projectsPath        = "projects"
+	usersPath           = "users"
+	rolesPath           = "roles"
+	roleAssignmentsPath = "role_assignments"
+)
+
+func projectsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(projectsPath)
+}
+
+func projectURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(projectsPath, id)
+}
+
+func usersURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(usersPath)
+}
+
+func userURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(usersPath, id)
+}
+
+func rolesURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rolesPath)
+}
+
+func roleAssignmentsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(roleAssignmentsPath)
+}
+
+func assignRoleURL(c *gophercloud.ServiceClient, projectID string, userID string, roleID string) string {
+	return c.ServiceURL(projectsPath, projectID, usersPath, userID, rolesPath, roleID)
+}
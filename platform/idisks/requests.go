@@ -0,0 +1,43 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package idisks provides access to the hardware attributes of the host disks
+// of the StarlingX system inventory API.  It is used to select disks by their
+// attributes rather than by their device paths, and to wipe disks before a
+// host is reinstalled.
+package idisks
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// List retrieves all disks of a host.
+func List(c *gophercloud.ServiceClient, hostID string) (r ListResult) {
+	_, r.Err = c.Get(listURL(c, hostID), &r.Body, nil)
+	return r
+}
+
+// ListDisks is a convenience function to list and extract the entire list of
+// disks of a host.
+func ListDisks(c *gophercloud.ServiceClient, hostID string) ([]Disk, error) {
+	return List(c, hostID).Extract()
+}
+
+// PartitionTableGPT is the partition table written to a disk when it is
+// wiped.
+const PartitionTableGPT = "gpt"
+
+// Wipe erases the partitions of a disk by replacing its partition table.  The
+// system only allows wiping a disk while its host is locked and refuses to
+// wipe the disk which holds the root filesystem.
+func Wipe(c *gophercloud.ServiceClient, id string) (r UpdateResult) {
+	patch := []map[string]interface{}{{
+		"op":    "replace",
+		"path":  "/partition_table",
+		"value": PartitionTableGPT,
+	}}
+	_, r.Err = c.Patch(updateURL(c, id), patch, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
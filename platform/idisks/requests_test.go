@@ -0,0 +1,98 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package idisks
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestListDisks(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"idisks": [
+			{"uuid": "d1", "device_node": "/dev/sda", "device_path": "/dev/disk/by-path/pci-0000:00:1f.2-ata-1.0",
+			 "device_type": "SSD", "size_mib": 488386, "serial_id": "S3Z9NB0K", "device_wwn": "0x5002538e",
+			 "capabilities": {"model_num": "Samsung SSD 860", "stor_function": "rootfs"}},
+			{"uuid": "d2", "device_node": "/dev/nvme0n1", "device_path": "/dev/disk/by-path/pci-0000:3b:00.0-nvme-1",
+			 "device_type": "NVME", "size_mib": 1907729, "serial_id": "PHLJ9", "device_wwn": null,
+			 "capabilities": {}}
+		]}`)
+	defer done()
+
+	result, err := ListDisks(client, "h1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/ihosts/h1/idisks" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("unexpected disks: %+v", result)
+	}
+
+	if result[0].DeviceType != DiskTypeSSD || result[0].SizeGiB() != 476 ||
+		result[0].Capabilities.ModelNumber != "Samsung SSD 860" || result[0].WWN != "0x5002538e" {
+		t.Errorf("unexpected disk: %+v", result[0])
+	}
+
+	if result[1].DeviceType != DiskTypeNVME || result[1].WWN != "" || result[1].Capabilities.ModelNumber != "" {
+		t.Errorf("unexpected disk: %+v", result[1])
+	}
+}
+
+func TestWipe(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"uuid": "d2", "device_node": "/dev/sdb", "device_type": "HDD", "size_mib": 953869}`)
+	defer done()
+
+	disk, err := Wipe(client, "d2").Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPatch || recorded.URI != "/idisks/d2" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(recorded.Patch) != 1 || recorded.Patch[0]["op"] != "replace" ||
+		recorded.Patch[0]["path"] != "/partition_table" || recorded.Patch[0]["value"] != PartitionTableGPT {
+		t.Errorf("unexpected patch: %+v", recorded.Patch)
+	}
+
+	if disk.ID != "d2" || disk.DeviceNode != "/dev/sdb" {
+		t.Errorf("unexpected disk: %+v", disk)
+	}
+}
+
+func TestExtractDisks(t *testing.T) {
+	client, _, done := testclient.New(t, http.StatusOK,
+		`{"idisks": [
+			{"uuid": "d1", "device_node": "/dev/sda", "device_type": "SSD", "size_mib": 488386}
+		]}`)
+	defer done()
+
+	result := List(client, "h1")
+
+	disks, err := result.ExtractDisks()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(disks) != 1 || disks[0].DeviceNode != "/dev/sda" {
+		t.Errorf("unexpected disks: %+v", disks)
+	}
+
+	attributes, err := result.Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(attributes) != 1 || attributes[0].DeviceType != DiskTypeSSD {
+		t.Errorf("unexpected disk attributes: %+v", attributes)
+	}
+}
@@ -0,0 +1,97 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package idisks
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/disks"
+)
+
+// Disk types reported by the system.
+const (
+	DiskTypeHDD  = "HDD"
+	DiskTypeSSD  = "SSD"
+	DiskTypeNVME = "NVME"
+)
+
+// Capabilities represents the optional attributes of a disk.
+type Capabilities struct {
+	// ModelNumber is the model reported by the disk.
+	ModelNumber string `json:"model_num,omitempty"`
+}
+
+// Disk represents the hardware attributes of a host disk.
+type Disk struct {
+	// ID is the unique identifier of the disk.
+	ID string `json:"uuid"`
+
+	// DeviceNode is the device node of the disk (e.g., /dev/sda).
+	DeviceNode string `json:"device_node"`
+
+	// DevicePath is the persistent device path of the disk.
+	DevicePath string `json:"device_path"`
+
+	// DeviceType is the technology of the disk (i.e., HDD, SSD, or NVME).
+	DeviceType string `json:"device_type"`
+
+	// Size is the size of the disk in MiB.
+	Size int `json:"size_mib"`
+
+	// SerialID is the serial number of the disk.
+	SerialID string `json:"serial_id"`
+
+	// WWN is the world wide name of the disk.
+	WWN string `json:"device_wwn"`
+
+	// Capabilities are the optional attributes of the disk.
+	Capabilities Capabilities `json:"capabilities"`
+}
+
+// SizeGiB returns the size of the disk in GiB rounded down to the nearest
+// GiB.
+func (in *Disk) SizeGiB() int {
+	return in.Size / 1024
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Disk resource.
+func (r commonResult) Extract() (*Disk, error) {
+	var s Disk
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of Disk
+// resources.
+func (r ListResult) Extract() ([]Disk, error) {
+	var s struct {
+		Disks []Disk `json:"idisks"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Disks, err
+}
+
+// ExtractDisks is a function that accepts a result and extracts the list of
+// disks as returned by the inventory client so that both can be populated
+// from a single request.
+func (r ListResult) ExtractDisks() ([]disks.Disk, error) {
+	var s struct {
+		Disks []disks.Disk `json:"idisks"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Disks, err
+}
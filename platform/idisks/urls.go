@@ -0,0 +1,21 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package idisks
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	resourcePath = "idisks"
+	hostPath     = "ihosts"
+)
+
+func listURL(c *gophercloud.ServiceClient, hostID string) string {
+	return c.ServiceURL(hostPath, hostID, resourcePath)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
@@ -0,0 +1,34 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package imemory provides access to the huge page capacity of the host
+// memory resources of the StarlingX system inventory API.  It is used to
+// resolve memory allocation policies.
+package imemory
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// List retrieves all memory resources of a host.
+func List(c *gophercloud.ServiceClient, hostID string) (r ListResult) {
+	_, r.Err = c.Get(listURL(c, hostID), &r.Body, nil)
+	return r
+}
+
+// ListMemory is a convenience function to list and extract the entire list of
+// memory resources of a host.
+func ListMemory(c *gophercloud.ServiceClient, hostID string) ([]Memory, error) {
+	return List(c, hostID).Extract()
+}
+
+// FindMemory returns the memory resource of a NUMA node.
+func FindMemory(memories []Memory, node int) (*Memory, bool) {
+	for i := range memories {
+		if memories[i].Node == node {
+			return &memories[i], true
+		}
+	}
+
+	return nil, false
+}
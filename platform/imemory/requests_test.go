@@ -0,0 +1,66 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package imemory
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestListMemory(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"imemorys": [
+			{"uuid": "m0", "numa_node": 0, "vm_hugepages_possible_2M": 40000, "vm_hugepages_possible_1G": 78},
+			{"uuid": "m1", "numa_node": 1, "vm_hugepages_possible_2M": 42000, "vm_hugepages_possible_1G": 82}
+		]}`)
+	defer done()
+
+	result, err := ListMemory(client, "h1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/ihosts/h1/imemorys" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	m, ok := FindMemory(result, 1)
+	if !ok || m.ID != "m1" || m.VM2MHugepagesPossible != 42000 || m.VM1GHugepagesPossible != 82 {
+		t.Errorf("unexpected memory: %+v", result)
+	}
+
+	if _, ok := FindMemory(result, 2); ok {
+		t.Errorf("unexpected memory for node 2")
+	}
+}
+
+func TestExtractMemory(t *testing.T) {
+	client, _, done := testclient.New(t, http.StatusOK,
+		`{"imemorys": [
+			{"uuid": "m0", "numa_node": 0, "vm_hugepages_possible_2M": 40000, "vm_hugepages_possible_1G": 78}
+		]}`)
+	defer done()
+
+	result := List(client, "h1")
+
+	memories, err := result.ExtractMemory()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(memories) != 1 || memories[0].ID != "m0" {
+		t.Errorf("unexpected memory: %+v", memories)
+	}
+
+	capacity, err := result.Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(capacity) != 1 || capacity[0].VM1GHugepagesPossible != 78 {
+		t.Errorf("unexpected memory capacity: %+v", capacity)
+	}
+}
@@ -0,0 +1,53 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package imemory
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/memory"
+)
+
+// Memory represents the huge page capacity of the memory of a single NUMA
+// node.
+type Memory struct {
+	// ID is the unique identifier of the memory resource.
+	ID string `json:"uuid"`
+
+	// Node is the NUMA node of the memory resource.
+	Node int `json:"numa_node"`
+
+	// VM2MHugepagesPossible is the number of 2MiB pages that the node can
+	// hold for the vm function.
+	VM2MHugepagesPossible int `json:"vm_hugepages_possible_2M"`
+
+	// VM1GHugepagesPossible is the number of 1GiB pages that the node can
+	// hold for the vm function.
+	VM1GHugepagesPossible int `json:"vm_hugepages_possible_1G"`
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of Memory
+// resources.
+func (r ListResult) Extract() ([]Memory, error) {
+	var s struct {
+		Memory []Memory `json:"imemorys"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Memory, err
+}
+
+// ExtractMemory is a function that accepts a result and extracts the list of
+// memory resources as returned by the inventory client so that both can be
+// populated from a single request.
+func (r ListResult) ExtractMemory() ([]memory.Memory, error) {
+	var s struct {
+		Memory []memory.Memory `json:"imemorys"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Memory, err
+}
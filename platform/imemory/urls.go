@@ -0,0 +1,17 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package imemory
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	resourcePath = "imemorys"
+	hostPath     = "ihosts"
+)
+
+func listURL(c *gophercloud.ServiceClient, hostID string) string {
+	return c.ServiceURL(hostPath, hostID, resourcePath)
+}
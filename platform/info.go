@@ -11,6 +11,12 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/licenses"
 	"github.com/pkg/errors"
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/ethernetports"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/idisks"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/imemory"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/lvgs"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/pcidevices"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/remotelogging"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/addresses"
@@ -59,6 +65,7 @@ type HostInfo struct {
 	Labels                []labels.Label
 	CPU                   []cpus.CPU
 	Memory                []memory.Memory
+	MemoryCapacity        []imemory.Memory
 	Monitors              []cephmonitors.CephMonitor
 	Networks              []networks.Network
 	DataNetworks          []datanetworks.DataNetwork
@@ -66,19 +73,24 @@ type HostInfo struct {
 	InterfaceDataNetworks []interfaceDataNetworks.InterfaceDataNetwork
 	Pools                 []addresspools.AddressPool
 	Ports                 []ports.Port
+	PortAttributes        []ethernetports.EthernetPort
 	Interfaces            []interfaces.Interface
 	Addresses             []addresses.Address
 	Routes                []routes.Route
 	Disks                 []disks.Disk
+	DiskAttributes        []idisks.Disk
 	Partitions            []partitions.DiskPartition
 	VolumeGroups          []volumegroups.VolumeGroup
+	VolumeGroupUsage      []lvgs.VolumeGroup
 	PhysicalVolumes       []physicalvolumes.PhysicalVolume
 	OSDs                  []osds.OSD
 	Clusters              []clusters.Cluster
 	StorageTiers          map[string]*storagetiers.StorageTier
+	ClusterTiers          map[string][]storagetiers.StorageTier
 	FileSystems           []hostFilesystems.FileSystem
 	PTPInstances          []ptpinstances.PTPInstance
 	PTPInterfaces         []ptpinterfaces.PTPInterface
+	PCIDevices            []pcidevices.PCIDevice
 }
 
 type SystemInfo struct {
@@ -87,10 +99,13 @@ type SystemInfo struct {
 	DNS               *dns.DNS
 	NTP               *ntp.NTP
 	PTP               *ptp.PTP
+	RemoteLogging     *remotelogging.RemoteLogging
 	Certificates      []certificates.Certificate
 	ServiceParameters []serviceparameters.ServiceParameter
 	StorageBackends   []storagebackends.StorageBackend
 	FileSystems       []controllerFilesystems.FileSystem
+	Clusters          []clusters.Cluster
+	StorageTiers      []storagetiers.StorageTier
 	License           *licenses.License
 }
 
@@ -128,6 +143,17 @@ func (in *SystemInfo) PopulateSystemInfo(client *gophercloud.ServiceClient) erro
 		return err
 	}
 
+	in.RemoteLogging, err = remotelogging.GetSystemRemoteLogging(client, result.ID)
+	if err != nil {
+		// Remote logging is optional; a system without a remote logging
+		// configuration is reported as having none.
+		if _, ok := err.(gophercloud.ErrResourceNotFound); !ok {
+			err = errors.Wrap(err, "failed to get remote logging info")
+			return err
+		}
+		in.RemoteLogging = nil
+	}
+
 	// TODO(alegacy): The system API does not provide a differentiation of
 	//  of certificates by system id therefore we take the entire list.
 	in.Certificates, err = certificates.ListCertificates(client)
@@ -154,6 +180,11 @@ func (in *SystemInfo) PopulateSystemInfo(client *gophercloud.ServiceClient) erro
 		return err
 	}
 
+	err = in.PopulateStorageTiers(client)
+	if err != nil {
+		return err
+	}
+
 	in.License, err = licenses.Get(client).Extract()
 	if err != nil {
 		if !strings.Contains(err.Error(), "License file not found") {
@@ -190,11 +221,61 @@ func (in *HostInfo) PopulateSystemPartitions(client *gophercloud.ServiceClient)
 	return nil
 }
 
-// getSystemPartitions augments the list of partitions that were retrieved using
-// the List API with additional partitions that were created by the system
-// and are not visible from the List API.
+// PopulateStorageTiers retrieves the storage clusters and the storage tiers
+// of each cluster.
+func (in *SystemInfo) PopulateStorageTiers(client *gophercloud.ServiceClient) error {
+	var err error
+
+	in.Clusters, err = clusters.ListClusters(client)
+	if err != nil {
+		err = errors.Wrap(err, "failed to list system storage clusters")
+		return err
+	}
+
+	result := make([]storagetiers.StorageTier, 0)
+	for _, c := range in.Clusters {
+		list, err := storagetiers.ListTiers(client, c.ID)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to list storage tiers for cluster: %s", c.ID)
+			return err
+		}
+
+		result = append(result, list...)
+	}
+
+	in.StorageTiers = result
+
+	return nil
+}
+
+// FindClusterByName is a utility function that attempts to find a storage
+// cluster by its name.
+func (in *SystemInfo) FindClusterByName(name string) *clusters.Cluster {
+	for _, c := range in.Clusters {
+		if c.Name == name {
+			return &c
+		}
+	}
+	return nil
+}
+
+// FindStorageTier is a utility function that attempts to find a storage tier
+// by its name within a specific cluster.
+func (in *SystemInfo) FindStorageTier(clusterID string, name string) *storagetiers.StorageTier {
+	for _, t := range in.StorageTiers {
+		if t.ClusterID == clusterID && t.Name == name {
+			return &t
+		}
+	}
+	return nil
+}
+
+// PopulateStorageTiers retrieves the storage tiers of each storage cluster
+// and indexes them by cluster name.  The default tier of each cluster is
+// also recorded separately since most OSDs are bound to it.
 func (in *HostInfo) PopulateStorageTiers(client *gophercloud.ServiceClient) error {
 	tiersByCluster := make(map[string]*storagetiers.StorageTier)
+	allByCluster := make(map[string][]storagetiers.StorageTier)
 	results, err := clusters.ListClusters(client)
 	if err != nil {
 		err = errors.Wrap(err, "failed to list system storage clusters")
@@ -202,17 +283,19 @@ func (in *HostInfo) PopulateStorageTiers(client *gophercloud.ServiceClient) erro
 	}
 
 	for _, c := range results {
-		tiers, err := storagetiers.ListTiers(client, c.ID)
+		list, err := storagetiers.ListTiers(client, c.ID)
 		if err != nil {
 			err = errors.Wrapf(err, "failed to list storage tiers for cluster: %s", c.ID)
 			return err
 		}
 
-		for _, t := range tiers {
+		for _, t := range list {
 			if t.Name == storagetiers.StorageTierName {
 				tiersByCluster[c.Name] = &t
 			}
 		}
+
+		allByCluster[c.Name] = list
 	}
 
 	if len(tiersByCluster) > 0 {
@@ -221,6 +304,12 @@ func (in *HostInfo) PopulateStorageTiers(client *gophercloud.ServiceClient) erro
 		in.StorageTiers = nil
 	}
 
+	if len(allByCluster) > 0 {
+		in.ClusterTiers = allByCluster
+	} else {
+		in.ClusterTiers = nil
+	}
+
 	return nil
 }
 
@@ -257,12 +346,19 @@ func (in *HostInfo) PopulateHostInfo(client *gophercloud.ServiceClient, hostid s
 		return err
 	}
 
-	in.Memory, err = memory.ListMemory(client, hostid)
+	memoryList := imemory.List(client, hostid)
+	in.Memory, err = memoryList.ExtractMemory()
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list memory for host %s", hostid)
 		return err
 	}
 
+	in.MemoryCapacity, err = memoryList.Extract()
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list memory capacity for host %s", hostid)
+		return err
+	}
+
 	in.Monitors, err = cephmonitors.ListCephMonitors(client)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list Ceph monitors for host %s", hostid)
@@ -299,12 +395,19 @@ func (in *HostInfo) PopulateHostInfo(client *gophercloud.ServiceClient, hostid s
 		return err
 	}
 
-	in.Ports, err = ports.ListPorts(client, hostid)
+	portList := ethernetports.List(client, hostid)
+	in.Ports, err = portList.ExtractPorts()
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list ports for host %s", hostid)
 		return err
 	}
 
+	in.PortAttributes, err = portList.Extract()
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list port attributes for host %s", hostid)
+		return err
+	}
+
 	in.Interfaces, err = interfaces.ListInterfaces(client, hostid)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list interfaces for host %s", hostid)
@@ -323,24 +426,38 @@ func (in *HostInfo) PopulateHostInfo(client *gophercloud.ServiceClient, hostid s
 		return err
 	}
 
-	in.Disks, err = disks.ListDisks(client, hostid)
+	diskList := idisks.List(client, hostid)
+	in.Disks, err = diskList.ExtractDisks()
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list disks for host %s", hostid)
 		return err
 	}
 
+	in.DiskAttributes, err = diskList.Extract()
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list disk attributes for host %s", hostid)
+		return err
+	}
+
 	in.Partitions, err = partitions.ListPartitions(client, hostid)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list partitions for host %s", hostid)
 		return err
 	}
 
-	in.VolumeGroups, err = volumegroups.ListVolumeGroups(client, hostid)
+	groups := lvgs.List(client, hostid)
+	in.VolumeGroups, err = groups.ExtractVolumeGroups()
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list volume groups for host %s", hostid)
 		return err
 	}
 
+	in.VolumeGroupUsage, err = groups.Extract()
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list volume group usage for host %s", hostid)
+		return err
+	}
+
 	in.PhysicalVolumes, err = physicalvolumes.ListPhysicalVolumes(client, hostid)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to list physical volumes for host %s", hostid)
@@ -391,6 +508,12 @@ func (in *HostInfo) PopulateHostInfo(client *gophercloud.ServiceClient, hostid s
 		return err
 	}
 
+	in.PCIDevices, err = pcidevices.ListPCIDevices(client, hostid)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to list PCI devices for host %s", hostid)
+		return err
+	}
+
 	return nil
 }
 
@@ -537,6 +660,16 @@ func (in *HostInfo) FindVolumeGroup(name string) (*volumegroups.VolumeGroup, boo
 	return nil, false
 }
 
+// VolumeGroupAvailableSize is a utility function that returns the space in
+// GiB that is not yet allocated within a volume group.
+func (in *HostInfo) VolumeGroupAvailableSize(name string) int {
+	if vg, ok := lvgs.FindVolumeGroup(in.VolumeGroupUsage, name); ok {
+		return vg.AvailableSize()
+	}
+
+	return 0
+}
+
 // findPartition is a utility function that attempts to find a system partition
 // by its unique uuid value.
 func (in *HostInfo) FindPartition(uuid string) (*partitions.DiskPartition, bool) {
@@ -574,6 +707,35 @@ func (in *HostInfo) FindClusterNameByTier(id string) (string, bool) {
 	return "", false
 }
 
+// FindStorageTier is a utility function that attempts to find a storage tier
+// by its name within a specific cluster.
+func (in *HostInfo) FindStorageTier(clusterName string, name string) *storagetiers.StorageTier {
+	for _, t := range in.ClusterTiers[clusterName] {
+		if t.Name == name {
+			return &t
+		}
+	}
+
+	if name == storagetiers.StorageTierName {
+		return in.StorageTiers[clusterName]
+	}
+
+	return nil
+}
+
+// FindStorageTierByID does a reverse lookup in the cluster tier lists and
+// returns the name of the cluster and of the tier associated to the tier id.
+func (in *HostInfo) FindStorageTierByID(id string) (string, string, bool) {
+	for k, v := range in.ClusterTiers {
+		for _, t := range v {
+			if t.ID == id {
+				return k, t.Name, true
+			}
+		}
+	}
+	return "", "", false
+}
+
 func (in *HostInfo) FindClusterByName(name string) *clusters.Cluster {
 	for _, c := range in.Clusters {
 		if c.Name == name {
@@ -878,6 +1040,38 @@ func (in *HostInfo) CountCPUByFunction(node int, function string) int {
 	return count
 }
 
+// CountCPUByNode is a utility function which counts the number of physical
+// cores of a processor node/socket.
+func (in *HostInfo) CountCPUByNode(node int) int {
+	count := 0
+	for _, c := range in.CPU {
+		if c.Thread == 0 && c.Processor == node {
+			count++
+		}
+	}
+
+	return count
+}
+
+// HugepagesPossible is a utility function which returns the number of huge
+// pages of a given size in MiB that the memory of a processor node/socket can
+// hold for the vm function.
+func (in *HostInfo) HugepagesPossible(node int, size int) int {
+	m, ok := imemory.FindMemory(in.MemoryCapacity, node)
+	if !ok {
+		return 0
+	}
+
+	switch size {
+	case 2:
+		return m.VM2MHugepagesPossible
+	case 1024:
+		return m.VM1GHugepagesPossible
+	}
+
+	return 0
+}
+
 // FindAddressPoolByName is a utility function which examines the list of system
 // address pools and returns a reference to a pool which matches the network
 // name provided.
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/routes"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagetiers"
 )
 
 func TestFindRouteUUID_AmbiguousGateway(t *testing.T) {
@@ -55,3 +56,28 @@ func TestFindRouteUUID_AmbiguousGateway(t *testing.T) {
 		t.Errorf("expected second route ID to be uuid-route-2, got %s", route2.ID)
 	}
 }
+
+func TestFindStorageTier(t *testing.T) {
+	host := HostInfo{
+		ClusterTiers: map[string][]storagetiers.StorageTier{
+			"ceph_cluster": {
+				{ID: "uuid-tier-1", Name: storagetiers.StorageTierName},
+				{ID: "uuid-tier-2", Name: "gold"},
+			},
+		},
+	}
+
+	tier := host.FindStorageTier("ceph_cluster", "gold")
+	if tier == nil || tier.ID != "uuid-tier-2" {
+		t.Errorf("expected tier uuid-tier-2, got %+v", tier)
+	}
+
+	if tier := host.FindStorageTier("other_cluster", "gold"); tier != nil {
+		t.Errorf("expected no tier in unknown cluster, got %+v", tier)
+	}
+
+	cluster, name, found := host.FindStorageTierByID("uuid-tier-2")
+	if !found || cluster != "ceph_cluster" || name != "gold" {
+		t.Errorf("unexpected reverse lookup result: %q %q %v", cluster, name, found)
+	}
+}
@@ -0,0 +1,96 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package testclient provides a service client backed by an HTTP test server
+// so that the request builders of the platform packages can be unit tested
+// without a running system.
+package testclient
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Request records the last request received by the test server.
+type Request struct {
+	Method string
+	URI    string
+
+	// Body is the decoded request body when it is a JSON object.
+	Body map[string]interface{}
+
+	// Patch is the decoded request body when it is a list of JSON patch
+	// operations.
+	Patch []map[string]interface{}
+
+	// Fields and Files are the values and file contents of a multipart form
+	// request body.
+	Fields map[string]string
+	Files  map[string]string
+}
+
+// record stores the attributes of a request.  A body which is neither JSON
+// nor a multipart form is reported as a test error.
+func (r *Request) record(t *testing.T, req *http.Request) {
+	r.Method = req.Method
+	r.URI = req.URL.RequestURI()
+	r.Body, r.Patch = nil, nil
+	r.Fields, r.Files = map[string]string{}, map[string]string{}
+
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("unexpected multipart request body: %s", err)
+			return
+		}
+		for k, v := range req.MultipartForm.Value {
+			r.Fields[k] = v[0]
+		}
+		for k, v := range req.MultipartForm.File {
+			f, _ := v[0].Open()
+			data, _ := io.ReadAll(f)
+			r.Files[k] = string(data)
+		}
+		return
+	}
+
+	data, _ := io.ReadAll(req.Body)
+	if len(data) == 0 {
+		return
+	}
+
+	if err := json.Unmarshal(data, &r.Body); err == nil {
+		return
+	}
+
+	if err := json.Unmarshal(data, &r.Patch); err != nil {
+		t.Errorf("unexpected request body: %s", data)
+	}
+}
+
+// New returns a service client whose requests are all answered with the same
+// status and response body, the request last received, and a function which
+// stops the test server.
+func New(t *testing.T, status int, response string) (*gophercloud.ServiceClient, *Request, func()) {
+	recorded := &Request{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		recorded.record(t, req)
+		if response != "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, response)
+	}))
+
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/",
+	}
+
+	return client, recorded, server.Close
+}
@@ -0,0 +1,73 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package iusers provides access to the platform user operations of the
+// StarlingX system inventory API.  It is used to configure the password
+// aging policy of the sysadmin account.
+package iusers
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// UserOpts defines the attributes of the platform user configuration that
+// can be updated.  Only the attributes that are set are included in the
+// update request.
+type UserOpts struct {
+	PasswdExpiryDays *int `json:"passwd_expiry_days,omitempty"`
+}
+
+// ToPatch converts the update attributes to the list of JSON patch
+// operations expected by the system API.  The trailing "apply" action
+// requests that the new configuration be applied immediately.
+func (opts UserOpts) ToPatch() []map[string]interface{} {
+	patch := make([]map[string]interface{}, 0)
+
+	if opts.PasswdExpiryDays != nil {
+		patch = append(patch, map[string]interface{}{
+			"op":    "replace",
+			"path":  "/passwd_expiry_days",
+			"value": *opts.PasswdExpiryDays,
+		})
+	}
+
+	patch = append(patch, map[string]interface{}{
+		"op":    "replace",
+		"path":  "/action",
+		"value": "apply",
+	})
+
+	return patch
+}
+
+// List retrieves all platform user configurations.
+func List(c *gophercloud.ServiceClient) (r ListResult) {
+	_, r.Err = c.Get(listURL(c), &r.Body, nil)
+	return r
+}
+
+// GetSystemUser is a convenience function to retrieve the platform user
+// configuration of a specific system.
+func GetSystemUser(c *gophercloud.ServiceClient, systemID string) (*User, error) {
+	list, err := List(c).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range list {
+		if u.SystemID == systemID {
+			return &u, nil
+		}
+	}
+
+	return nil, gophercloud.ErrResourceNotFound{Name: systemID, ResourceType: "iuser"}
+}
+
+// Update accepts a UserOpts struct and updates an existing platform user
+// configuration.
+func Update(c *gophercloud.ServiceClient, id string, opts UserOpts) (r UpdateResult) {
+	_, r.Err = c.Patch(updateURL(c, id), opts.ToPatch(), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
@@ -0,0 +1,61 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package iusers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestGetSystemUser(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"iusers": [{"uuid": "u1", "root_sig": "abc", "passwd_expiry_days": 90, "isystem_uuid": "s1"}]}`)
+	defer done()
+
+	result, err := GetSystemUser(client, "s1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/iuser" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if result.ID != "u1" || result.PasswdExpiryDays != 90 {
+		t.Errorf("unexpected user: %+v", result)
+	}
+
+	_, err = GetSystemUser(client, "s2")
+	if err == nil {
+		t.Errorf("expected an error for an unknown system")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"uuid": "u1", "passwd_expiry_days": 45, "isystem_uuid": "s1"}`)
+	defer done()
+
+	days := 45
+	result, err := Update(client, "u1", UserOpts{PasswdExpiryDays: &days}).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPatch || recorded.URI != "/iuser/u1" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(recorded.Patch) != 2 ||
+		recorded.Patch[0]["path"] != "/passwd_expiry_days" || recorded.Patch[0]["value"] != float64(45) ||
+		recorded.Patch[1]["path"] != "/action" || recorded.Patch[1]["value"] != "apply" {
+		t.Errorf("unexpected request body: %v", recorded.Patch)
+	}
+
+	if result.PasswdExpiryDays != 45 {
+		t.Errorf("unexpected user: %+v", result)
+	}
+}
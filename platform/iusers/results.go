@@ -0,0 +1,52 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package iusers
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// User represents the platform (sysadmin) user configuration of a system.
+type User struct {
+	// ID is the unique identifier of the user configuration.
+	ID string `json:"uuid"`
+
+	// RootSig is the signature of the current sysadmin password.
+	RootSig string `json:"root_sig"`
+
+	// PasswdExpiryDays is the number of days after which the sysadmin
+	// password expires.
+	PasswdExpiryDays int `json:"passwd_expiry_days"`
+
+	// SystemID is the unique identifier of the system to which the
+	// configuration belongs.
+	SystemID string `json:"isystem_uuid"`
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a User resource.
+func (r UpdateResult) Extract() (*User, error) {
+	var s User
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of User
+// resources.
+func (r ListResult) Extract() ([]User, error) {
+	var s struct {
+		Users []User `json:"iusers"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Users, err
+}
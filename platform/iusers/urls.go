@@ -0,0 +1,20 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package iusers
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	resourcePath = "iuser"
+)
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
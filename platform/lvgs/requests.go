@@ -0,0 +1,34 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package lvgs provides access to the space usage of the host volume groups
+// of the StarlingX system inventory API.  It is used to resolve relative file
+// system sizes against the space left in a volume group.
+package lvgs
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// List retrieves all volume groups of a host.
+func List(c *gophercloud.ServiceClient, hostID string) (r ListResult) {
+	_, r.Err = c.Get(listURL(c, hostID), &r.Body, nil)
+	return r
+}
+
+// ListVolumeGroups is a convenience function to list and extract the entire
+// list of volume groups of a host.
+func ListVolumeGroups(c *gophercloud.ServiceClient, hostID string) ([]VolumeGroup, error) {
+	return List(c, hostID).Extract()
+}
+
+// FindVolumeGroup is a utility function that returns the volume group with
+// a given name from a list of volume groups.
+func FindVolumeGroup(groups []VolumeGroup, name string) (*VolumeGroup, bool) {
+	for i := range groups {
+		if groups[i].Name == name {
+			return &groups[i], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,78 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package lvgs
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestListVolumeGroups(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"ilvgs": [
+			{"uuid": "g1", "lvm_vg_name": "cgts-vg", "lvm_vg_size": 214748364800,
+			 "lvm_vg_total_pe": 6400, "lvm_vg_free_pe": 1600},
+			{"uuid": "g2", "lvm_vg_name": "nova-local", "lvm_vg_size": 0,
+			 "lvm_vg_total_pe": 0, "lvm_vg_free_pe": 0}
+		]}`)
+	defer done()
+
+	result, err := ListVolumeGroups(client, "h1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/ihosts/h1/ilvgs" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	vg, ok := FindVolumeGroup(result, "cgts-vg")
+	if !ok || vg.ID != "g1" {
+		t.Fatalf("unexpected volume groups: %+v", result)
+	}
+
+	if size := vg.AvailableSize(); size != 50 {
+		t.Errorf("unexpected available size: %d", size)
+	}
+
+	vg, ok = FindVolumeGroup(result, "nova-local")
+	if !ok || vg.AvailableSize() != 0 {
+		t.Errorf("unexpected volume group: %+v", vg)
+	}
+
+	if _, ok := FindVolumeGroup(result, "missing"); ok {
+		t.Errorf("unexpected volume group found")
+	}
+}
+
+func TestExtractVolumeGroups(t *testing.T) {
+	client, _, done := testclient.New(t, http.StatusOK,
+		`{"ilvgs": [
+			{"uuid": "g1", "lvm_vg_name": "cgts-vg", "lvm_vg_size": 214748364800,
+			 "lvm_vg_total_pe": 6400, "lvm_vg_free_pe": 1600}
+		]}`)
+	defer done()
+
+	result := List(client, "h1")
+
+	groups, err := result.ExtractVolumeGroups()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(groups) != 1 || groups[0].ID != "g1" {
+		t.Errorf("unexpected volume groups: %+v", groups)
+	}
+
+	usage, err := result.Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(usage) != 1 || usage[0].AvailableSize() != 50 {
+		t.Errorf("unexpected volume group usage: %+v", usage)
+	}
+}
@@ -0,0 +1,67 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package lvgs
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/volumegroups"
+)
+
+// gibibyte is the number of bytes in a GiB which is the unit used for all
+// file system sizes.
+const gibibyte = 1024 * 1024 * 1024
+
+// VolumeGroup represents the space usage of a host volume group.
+type VolumeGroup struct {
+	// ID is the unique identifier of the volume group.
+	ID string `json:"uuid"`
+
+	// Name is the name of the volume group.
+	Name string `json:"lvm_vg_name"`
+
+	// Size is the total size of the volume group in bytes.
+	Size int64 `json:"lvm_vg_size"`
+
+	// TotalPE is the total number of physical extents of the volume group.
+	TotalPE int64 `json:"lvm_vg_total_pe"`
+
+	// FreePE is the number of physical extents not allocated to any logical
+	// volume.
+	FreePE int64 `json:"lvm_vg_free_pe"`
+}
+
+// AvailableSize returns the unallocated space of the volume group in GiB
+// rounded down to the nearest GiB.
+func (in *VolumeGroup) AvailableSize() int {
+	if in.TotalPE <= 0 {
+		return 0
+	}
+	return int(in.Size * in.FreePE / in.TotalPE / gibibyte)
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of
+// VolumeGroup resources.
+func (r ListResult) Extract() ([]VolumeGroup, error) {
+	var s struct {
+		VolumeGroups []VolumeGroup `json:"ilvgs"`
+	}
+	err := r.ExtractInto(&s)
+	return s.VolumeGroups, err
+}
+
+// ExtractVolumeGroups is a function that accepts a result and extracts the
+// list of volume groups as returned by the inventory client so that both can
+// be populated from a single request.
+func (r ListResult) ExtractVolumeGroups() ([]volumegroups.VolumeGroup, error) {
+	var s struct {
+		VolumeGroups []volumegroups.VolumeGroup `json:"ilvgs"`
+	}
+	err := r.ExtractInto(&s)
+	return s.VolumeGroups, err
+}
@@ -0,0 +1,80 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package pcidevices provides access to the host PCI device operations of
+// the StarlingX system inventory API.  It is used to enable devices such as
+// FEC accelerators and to configure their drivers and virtual functions.
+package pcidevices
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// PCIDeviceOpts defines the attributes of a PCI device that can be updated.
+// Only the attributes that are set are included in the update request.
+type PCIDeviceOpts struct {
+	Name     *string `json:"name,omitempty"`
+	Enabled  *bool   `json:"enabled,omitempty"`
+	Driver   *string `json:"driver,omitempty"`
+	VFCount  *int    `json:"sriov_numvfs,omitempty"`
+	VFDriver *string `json:"sriov_vf_driver,omitempty"`
+}
+
+// ToPatch converts the update attributes to the list of JSON patch
+// operations expected by the system API.
+func (opts PCIDeviceOpts) ToPatch() []map[string]interface{} {
+	patch := make([]map[string]interface{}, 0)
+
+	add := func(path string, value interface{}) {
+		patch = append(patch, map[string]interface{}{
+			"op":    "replace",
+			"path":  "/" + path,
+			"value": value,
+		})
+	}
+
+	if opts.Name != nil {
+		add("name", *opts.Name)
+	}
+	if opts.Enabled != nil {
+		add("enabled", *opts.Enabled)
+	}
+	if opts.Driver != nil {
+		add("driver", *opts.Driver)
+	}
+	if opts.VFCount != nil {
+		add("sriov_numvfs", *opts.VFCount)
+	}
+	if opts.VFDriver != nil {
+		add("sriov_vf_driver", *opts.VFDriver)
+	}
+
+	return patch
+}
+
+// Get retrieves a specific PCI device based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return r
+}
+
+// List retrieves all PCI devices of a host.
+func List(c *gophercloud.ServiceClient, hostID string) (r ListResult) {
+	_, r.Err = c.Get(listURL(c, hostID), &r.Body, nil)
+	return r
+}
+
+// ListPCIDevices is a convenience function to list and extract the entire
+// list of PCI devices of a host.
+func ListPCIDevices(c *gophercloud.ServiceClient, hostID string) ([]PCIDevice, error) {
+	return List(c, hostID).Extract()
+}
+
+// Update accepts a PCIDeviceOpts struct and updates an existing PCI device.
+// The system only allows updating a device while its host is locked.
+func Update(c *gophercloud.ServiceClient, id string, opts PCIDeviceOpts) (r UpdateResult) {
+	_, r.Err = c.Patch(updateURL(c, id), opts.ToPatch(), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
@@ -0,0 +1,69 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package pcidevices
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestListPCIDevices(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"pci_devices": [
+			{"uuid": "d1", "pciaddr": "0000:b3:00.0", "pdevice_id": "0d5c", "enabled": true,
+			 "driver": "igb_uio", "sriov_totalvfs": 16, "sriov_numvfs": 8, "sriov_vf_driver": "vfio"},
+			{"uuid": "d2", "pciaddr": "0000:00:1f.0", "pdevice_id": "a1c1", "enabled": true,
+			 "driver": null, "sriov_totalvfs": null, "sriov_numvfs": null, "sriov_vf_driver": null}
+		]}`)
+	defer done()
+
+	result, err := ListPCIDevices(client, "h1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/ihosts/h1/pci_devices" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("unexpected devices: %+v", result)
+	}
+
+	if result[0].VFCount == nil || *result[0].VFCount != 8 || *result[0].Driver != "igb_uio" {
+		t.Errorf("unexpected device: %+v", result[0])
+	}
+
+	if result[1].Driver != nil || result[1].VFCount != nil {
+		t.Errorf("unexpected device: %+v", result[1])
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK, `{"uuid": "d1", "enabled": true, "sriov_numvfs": 8}`)
+	defer done()
+
+	enabled, count := true, 8
+	opts := PCIDeviceOpts{Enabled: &enabled, VFCount: &count}
+	device, err := Update(client, "d1", opts).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPatch || recorded.URI != "/pci_devices/d1" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(recorded.Patch) != 2 ||
+		recorded.Patch[0]["path"] != "/enabled" || recorded.Patch[0]["value"] != true ||
+		recorded.Patch[1]["path"] != "/sriov_numvfs" || recorded.Patch[1]["value"] != float64(8) {
+		t.Errorf("unexpected request body: %v", recorded.Patch)
+	}
+
+	if device.VFCount == nil || *device.VFCount != 8 {
+		t.Errorf("unexpected device: %+v", device)
+	}
+}
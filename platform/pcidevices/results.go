@@ -0,0 +1,88 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package pcidevices
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// PCIDevice represents a PCI device of a host.
+type PCIDevice struct {
+	// ID is the unique identifier of the device.
+	ID string `json:"uuid"`
+
+	// HostID is the unique identifier of the host to which the device
+	// belongs.
+	HostID string `json:"host_uuid"`
+
+	// Name is the name of the device.
+	Name string `json:"name"`
+
+	// Address is the PCI address of the device.
+	Address string `json:"pciaddr"`
+
+	// ClassID is the PCI class identifier of the device.
+	ClassID string `json:"pclass_id"`
+
+	// VendorID is the PCI vendor identifier of the device.
+	VendorID string `json:"pvendor_id"`
+
+	// DeviceID is the PCI device identifier of the device.
+	DeviceID string `json:"pdevice_id"`
+
+	// NUMANode is the NUMA node to which the device is attached.
+	NUMANode int `json:"numa_node"`
+
+	// Enabled indicates whether the device is available for use.
+	Enabled bool `json:"enabled"`
+
+	// Driver is the driver bound to the physical function.
+	Driver *string `json:"driver,omitempty"`
+
+	// TotalVFs is the maximum number of virtual functions supported.
+	TotalVFs *int `json:"sriov_totalvfs,omitempty"`
+
+	// VFCount is the number of virtual functions configured.
+	VFCount *int `json:"sriov_numvfs,omitempty"`
+
+	// VFDriver is the driver bound to the virtual functions.
+	VFDriver *string `json:"sriov_vf_driver,omitempty"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a PCIDevice
+// resource.
+func (r commonResult) Extract() (*PCIDevice, error) {
+	var s PCIDevice
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of
+// PCIDevice resources.
+func (r ListResult) Extract() ([]PCIDevice, error) {
+	var s struct {
+		Devices []PCIDevice `json:"pci_devices"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Devices, err
+}
@@ -0,0 +1,26 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package ptpnotifications provides access to the O-RAN compliant v2 API of
+// the StarlingX PTP notification service.  It is used to query the current
+// synchronization state of the PTP, GNSS and OS clocks of a host.
+package ptpnotifications
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ResourceAddress returns the address of a synchronization resource of a
+// node (e.g., /./controller-0/sync/ptp-status/lock-state).
+func ResourceAddress(node string, resource string) string {
+	return "/./" + node + resource
+}
+
+// GetCurrentState retrieves the current state of a synchronization resource
+// of a node.  The service answers with a 404 error when the resource is not
+// tracked on the node (e.g., the GNSS state of a host without a GNSS
+// receiver).
+func GetCurrentState(c *gophercloud.ServiceClient, node string, resource string) (r GetResult) {
+	_, r.Err = c.Get(currentStateURL(c, node, resource), &r.Body, nil)
+	return r
+}
@@ -0,0 +1,77 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package ptpnotifications
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestGetCurrentState(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"id": "e1", "specversion": "1.0", "source": "/sync/ptp-status/lock-state",
+		  "type": "event.sync.ptp-status.ptp-state-change", "time": "2026-10-18T10:00:00.000000Z",
+		  "data": {"version": "1.0", "values": [
+			{"data_type": "notification", "ResourceAddress": "/./controller-0/ptp1/sync/ptp-status/lock-state",
+			 "value_type": "enumeration", "value": "LOCKED"},
+			{"data_type": "metric", "ResourceAddress": "/./controller-0/ptp1/sync/ptp-status/lock-state",
+			 "value_type": "decimal64.3", "value": -12.5},
+			{"data_type": "notification", "ResourceAddress": "/./controller-0/ptp2/sync/ptp-status/lock-state",
+			 "value_type": "enumeration", "value": "FREERUN"}
+		  ]}}`)
+	defer done()
+
+	state, err := GetCurrentState(client, "controller-0", ResourceLockState).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet ||
+		recorded.URI != "/ocloudNotifications/v2/./controller-0/sync/ptp-status/lock-state/CurrentState" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	values := state.Values("controller-0", "ptp1", DataTypeNotification)
+	if len(values) != 1 || values[0].String() != LockStateLocked {
+		t.Errorf("unexpected notification values: %+v", values)
+	}
+
+	values = state.Values("controller-0", "ptp1", DataTypeMetric)
+	if len(values) != 1 || values[0].String() != "-12.5" {
+		t.Errorf("unexpected metric values: %+v", values)
+	}
+
+	values = state.Values("controller-0", "ptp2", DataTypeNotification)
+	if len(values) != 1 || values[0].String() != LockStateFreerun {
+		t.Errorf("unexpected notification values: %+v", values)
+	}
+}
+
+func TestValueInstance(t *testing.T) {
+	value := Value{ResourceAddress: "/./worker-0/sync/gnss-status/gnss-sync-status"}
+	if name := value.Instance("worker-0"); name != "" {
+		t.Errorf("unexpected instance: %s", name)
+	}
+
+	value = Value{ResourceAddress: "/./worker-0/ts2phc1/sync/gnss-status/gnss-sync-status", Value: "6"}
+	if name := value.Instance("worker-0"); name != "ts2phc1" {
+		t.Errorf("unexpected instance: %s", name)
+	}
+	if value.String() != "6" {
+		t.Errorf("unexpected value: %s", value.String())
+	}
+}
+
+func TestGetCurrentStateNotFound(t *testing.T) {
+	client, _, done := testclient.New(t, http.StatusNotFound, `{"detail": "resource not found"}`)
+	defer done()
+
+	_, err := GetCurrentState(client, "worker-0", ResourceGNSSSyncStatus).Extract()
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Errorf("expected a not found error: %v", err)
+	}
+}
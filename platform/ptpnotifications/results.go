@@ -0,0 +1,133 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package ptpnotifications
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the synchronization resources tracked by the notification service.
+const (
+	ResourceLockState        = "/sync/ptp-status/lock-state"
+	ResourceClockClass       = "/sync/ptp-status/clock-class"
+	ResourceGNSSSyncStatus   = "/sync/gnss-status/gnss-sync-status"
+	ResourceOSClockSyncState = "/sync/sync-status/os-clock-sync-state"
+)
+
+// Defines the types of the values reported for a resource.
+const (
+	DataTypeNotification = "notification"
+	DataTypeMetric       = "metric"
+)
+
+// Defines the synchronization states of the PTP and OS clocks.
+const (
+	LockStateLocked   = "LOCKED"
+	LockStateHoldover = "HOLDOVER"
+	LockStateFreerun  = "FREERUN"
+)
+
+// Defines the GNSS synchronization state of a receiver locked to its
+// satellites.
+const GNSSStateSynchronized = "SYNCHRONIZED"
+
+// Value represents a single value of a resource state.  A state carries a
+// notification value holding the state itself and may carry a metric value
+// (e.g., the offset from the time source in nanoseconds).
+type Value struct {
+	// DataType is the kind of value (i.e., notification or metric).
+	DataType string `json:"data_type"`
+
+	// ResourceAddress is the address of the resource which reported the
+	// value.  It includes the name of the instance when several instances
+	// of a service run on the node.
+	ResourceAddress string `json:"ResourceAddress"`
+
+	// ValueType is the encoding of the value (e.g., enumeration,
+	// decimal64.3).
+	ValueType string `json:"value_type"`
+
+	// Value is the value itself.  The service reports enumerations as
+	// strings and metrics either as strings or numbers.
+	Value interface{} `json:"value"`
+}
+
+// String returns the value formatted as a string.
+func (in *Value) String() string {
+	switch v := in.Value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+	return ""
+}
+
+// Instance returns the name of the instance which reported the value, or an
+// empty string if the address does not include one.
+func (in *Value) Instance(node string) string {
+	address := strings.TrimPrefix(in.ResourceAddress, ResourceAddress(node, "/"))
+	index := strings.Index(address, "/sync/")
+	if index <= 0 {
+		return ""
+	}
+	return address[:index]
+}
+
+// CurrentState represents the current state of a synchronization resource
+// formatted as a cloud event.
+type CurrentState struct {
+	// ID is the unique identifier of the event.
+	ID string `json:"id"`
+
+	// Type is the type of the event.
+	Type string `json:"type"`
+
+	// Source is the resource which generated the event.
+	Source string `json:"source"`
+
+	// Time is the time at which the state was last updated.
+	Time string `json:"time"`
+
+	// Data holds the values of the state.
+	Data struct {
+		Version string  `json:"version"`
+		Values  []Value `json:"values"`
+	} `json:"data"`
+}
+
+// Values returns the values of the state of a given type which apply to a
+// given instance.  Values which do not name an instance apply to every
+// instance of the node.
+func (in *CurrentState) Values(node string, instance string, dataType string) []Value {
+	result := make([]Value, 0)
+	for _, v := range in.Data.Values {
+		if v.DataType != dataType {
+			continue
+		}
+		if name := v.Instance(node); name != "" && name != instance {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a CurrentState
+// resource.
+func (r GetResult) Extract() (*CurrentState, error) {
+	var s CurrentState
+	err := r.ExtractInto(&s)
+	return &s, err
+}
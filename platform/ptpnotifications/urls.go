@@ -0,0 +1,23 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package ptpnotifications
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	rootPath         = "ocloudNotifications"
+	versionPath      = "v2"
+	currentStatePath = "CurrentState"
+)
+
+func currentStateURL(c *gophercloud.ServiceClient, node string, resource string) string {
+	parts := []string{rootPath, versionPath}
+	parts = append(parts, strings.Split(strings.Trim(ResourceAddress(node, resource), "/"), "/")...)
+	parts = append(parts, currentStatePath)
+	return c.ServiceURL(parts...)
+}
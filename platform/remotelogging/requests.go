@@ -0,0 +1,92 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package remotelogging provides access to the remote logging operations of
+// the StarlingX system inventory API.  It is used to configure the forwarding
+// of the platform logs to a remote syslog server.
+package remotelogging
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// RemoteLoggingOpts defines the attributes of the remote logging
+// configuration that can be updated.  Only the attributes that are set are
+// included in the update request.
+type RemoteLoggingOpts struct {
+	IPAddress *string `json:"ip_address,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+	Transport *string `json:"transport,omitempty"`
+	Port      *int    `json:"port,omitempty"`
+}
+
+// ToPatch converts the update attributes to the list of JSON patch
+// operations expected by the system API.  The trailing "apply" action
+// requests that the new configuration be applied immediately.
+func (opts RemoteLoggingOpts) ToPatch() []map[string]interface{} {
+	patch := make([]map[string]interface{}, 0)
+
+	add := func(path string, value interface{}) {
+		patch = append(patch, map[string]interface{}{
+			"op":    "replace",
+			"path":  "/" + path,
+			"value": value,
+		})
+	}
+
+	if opts.IPAddress != nil {
+		add("ip_address", *opts.IPAddress)
+	}
+	if opts.Enabled != nil {
+		add("enabled", *opts.Enabled)
+	}
+	if opts.Transport != nil {
+		add("transport", *opts.Transport)
+	}
+	if opts.Port != nil {
+		add("port", *opts.Port)
+	}
+
+	add("action", "apply")
+
+	return patch
+}
+
+// Get retrieves a specific remote logging configuration based on its unique
+// ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return r
+}
+
+// List retrieves all remote logging configurations.
+func List(c *gophercloud.ServiceClient) (r ListResult) {
+	_, r.Err = c.Get(listURL(c), &r.Body, nil)
+	return r
+}
+
+// GetSystemRemoteLogging is a convenience function to retrieve the remote
+// logging configuration of a specific system.
+func GetSystemRemoteLogging(c *gophercloud.ServiceClient, systemID string) (*RemoteLogging, error) {
+	list, err := List(c).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range list {
+		if r.SystemID == systemID {
+			return &r, nil
+		}
+	}
+
+	return nil, gophercloud.ErrResourceNotFound{Name: systemID, ResourceType: "remotelogging"}
+}
+
+// Update accepts a RemoteLoggingOpts struct and updates an existing remote
+// logging configuration.
+func Update(c *gophercloud.ServiceClient, id string, opts RemoteLoggingOpts) (r UpdateResult) {
+	_, r.Err = c.Patch(updateURL(c, id), opts.ToPatch(), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
@@ -0,0 +1,65 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package remotelogging
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestGetSystemRemoteLogging(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"remoteloggings": [
+			{"uuid": "r1", "ip_address": "10.10.10.1", "enabled": true, "transport": "tcp",
+			 "port": 514, "isystem_uuid": "s1"}
+		]}`)
+	defer done()
+
+	result, err := GetSystemRemoteLogging(client, "s1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/remotelogging" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if result.ID != "r1" || !result.Enabled || result.Transport != TransportTCP || result.Port != 514 {
+		t.Errorf("unexpected remote logging: %+v", result)
+	}
+
+	_, err = GetSystemRemoteLogging(client, "s2")
+	if err == nil {
+		t.Errorf("expected an error for an unknown system")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"uuid": "r1", "ip_address": "10.10.10.2", "enabled": true, "transport": "udp", "port": 514}`)
+	defer done()
+
+	address := "10.10.10.2"
+	opts := RemoteLoggingOpts{IPAddress: &address}
+	result, err := Update(client, "r1", opts).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPatch || recorded.URI != "/remotelogging/r1" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(recorded.Patch) != 2 ||
+		recorded.Patch[0]["path"] != "/ip_address" || recorded.Patch[0]["value"] != address ||
+		recorded.Patch[1]["path"] != "/action" || recorded.Patch[1]["value"] != "apply" {
+		t.Errorf("unexpected request body: %v", recorded.Patch)
+	}
+
+	if result.IPAddress != address {
+		t.Errorf("unexpected remote logging: %+v", result)
+	}
+}
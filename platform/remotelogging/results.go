@@ -0,0 +1,75 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package remotelogging
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the transport protocols supported by the system.
+const (
+	TransportUDP = "udp"
+	TransportTCP = "tcp"
+	TransportTLS = "tls"
+)
+
+// RemoteLogging represents the remote logging (syslog forwarding)
+// configuration of a system.
+type RemoteLogging struct {
+	// ID is the unique identifier of the remote logging configuration.
+	ID string `json:"uuid"`
+
+	// IPAddress is the address of the remote syslog server.
+	IPAddress string `json:"ip_address"`
+
+	// Enabled indicates whether logs are forwarded to the remote server.
+	Enabled bool `json:"enabled"`
+
+	// Transport is the protocol used to forward logs.
+	Transport string `json:"transport"`
+
+	// Port is the port of the remote syslog server.
+	Port int `json:"port"`
+
+	// SystemID is the unique identifier of the system to which the
+	// configuration belongs.
+	SystemID string `json:"isystem_uuid"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a RemoteLogging
+// resource.
+func (r commonResult) Extract() (*RemoteLogging, error) {
+	var s RemoteLogging
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of
+// RemoteLogging resources.
+func (r ListResult) Extract() ([]RemoteLogging, error) {
+	var s struct {
+		RemoteLoggings []RemoteLogging `json:"remoteloggings"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RemoteLoggings, err
+}
@@ -0,0 +1,87 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package strategies provides access to the orchestration strategies of the
+// StarlingX VIM API which are not covered by the systemconfigupdate package.
+// It is used to orchestrate software deployments and Kubernetes upgrades
+// across all hosts of a system.
+package strategies
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the strategy types supported by this package.
+const (
+	SoftwareDeploy    = "sw-deploy"
+	KubernetesUpgrade = "kube-upgrade"
+)
+
+// Defines the strategy actions supported by the VIM.
+const (
+	ActionApplyAll = "apply-all"
+	ActionAbort    = "abort"
+)
+
+// CreateOpts defines the attributes of a new orchestration strategy.  The
+// Release attribute only applies to software deployment strategies while the
+// ToVersion attribute only applies to Kubernetes upgrade strategies.
+type CreateOpts struct {
+	ControllerApplyType    string `json:"controller-apply-type,omitempty"`
+	StorageApplyType       string `json:"storage-apply-type,omitempty"`
+	WorkerApplyType        string `json:"worker-apply-type,omitempty"`
+	MaxParallelWorkerHosts *int   `json:"max-parallel-worker-hosts,omitempty"`
+	DefaultInstanceAction  string `json:"default-instance-action,omitempty"`
+	AlarmRestrictions      string `json:"alarm-restrictions,omitempty"`
+	Release                string `json:"release,omitempty"`
+	ToVersion              string `json:"to-version,omitempty"`
+}
+
+// ActionOpts defines the attributes of a strategy action request.
+type ActionOpts struct {
+	Action string `json:"action"`
+}
+
+// Create accepts a CreateOpts struct and requests that the VIM build a new
+// strategy of the specified type.
+func Create(c *gophercloud.ServiceClient, kind string, opts CreateOpts) (r CreateResult) {
+	reqBody, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Post(strategyURL(c, kind), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
+
+// Show retrieves the current strategy of the specified type.
+func Show(c *gophercloud.ServiceClient, kind string) (r ShowResult) {
+	_, r.Err = c.Get(strategyURL(c, kind), &r.Body, nil)
+	return r
+}
+
+// Action accepts an ActionOpts struct and requests that the specified action
+// be executed against the current strategy of the specified type.
+func Action(c *gophercloud.ServiceClient, kind string, opts ActionOpts) (r ActionResult) {
+	reqBody, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Post(actionURL(c, kind), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r
+}
+
+// Delete deletes the current strategy of the specified type.
+func Delete(c *gophercloud.ServiceClient, kind string) (r DeleteResult) {
+	_, r.Err = c.Delete(strategyURL(c, kind), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return r
+}
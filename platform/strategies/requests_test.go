@@ -0,0 +1,101 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package strategies
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestShow(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"strategy": {"uuid": "s1", "name": "sw-deploy", "state": "applying",
+			"current-phase": "apply",
+			"apply-phase": {"phase-name": "apply", "current-stage": 2, "total-stages": 5,
+				"completion-percentage": 40, "result": "inprogress", "reason": ""}}}`)
+	defer done()
+
+	result, err := Show(client, SoftwareDeploy).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/api/orchestration/sw-deploy/strategy" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if result == nil || result.ID != "s1" || result.State != "applying" ||
+		result.ApplyPhase.CurrentStage != 2 || result.ApplyPhase.CompletionPercentage != 40 {
+		t.Errorf("unexpected strategy: %+v", result)
+	}
+}
+
+func TestShowNoStrategy(t *testing.T) {
+	client, _, done := testclient.New(t, http.StatusOK, `{"strategy": null}`)
+	defer done()
+
+	result, err := Show(client, KubernetesUpgrade).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if result != nil {
+		t.Errorf("unexpected strategy: %+v", result)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"strategy": {"uuid": "s1", "name": "kube-upgrade", "state": "building"}}`)
+	defer done()
+
+	workers := 2
+	opts := CreateOpts{
+		WorkerApplyType:        "parallel",
+		MaxParallelWorkerHosts: &workers,
+		AlarmRestrictions:      "relaxed",
+		ToVersion:              "v1.29.2",
+	}
+	result, err := Create(client, KubernetesUpgrade, opts).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPost || recorded.URI != "/api/orchestration/kube-upgrade/strategy" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if recorded.Body["to-version"] != "v1.29.2" || recorded.Body["max-parallel-worker-hosts"] != float64(2) {
+		t.Errorf("unexpected request body: %v", recorded.Body)
+	}
+
+	if _, ok := recorded.Body["release"]; ok {
+		t.Errorf("unexpected release attribute: %v", recorded.Body)
+	}
+
+	if result == nil || result.State != "building" {
+		t.Errorf("unexpected strategy: %+v", result)
+	}
+}
+
+func TestAction(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"strategy": {"uuid": "s1", "name": "sw-deploy", "state": "applying"}}`)
+	defer done()
+
+	_, err := Action(client, SoftwareDeploy, ActionOpts{Action: ActionApplyAll}).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPost || recorded.URI != "/api/orchestration/sw-deploy/strategy/actions" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if recorded.Body["action"] != ActionApplyAll {
+		t.Errorf("unexpected request body: %v", recorded.Body)
+	}
+}
@@ -0,0 +1,91 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package strategies
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Phase represents the progress of a single phase (build, apply, abort) of
+// an orchestration strategy.
+type Phase struct {
+	// Name is the name of the phase.
+	Name string `json:"phase-name"`
+
+	// CurrentStage is the index of the stage currently being executed.
+	CurrentStage int `json:"current-stage"`
+
+	// TotalStages is the number of stages of the phase.
+	TotalStages int `json:"total-stages"`
+
+	// CompletionPercentage is the completion percentage of the phase.
+	CompletionPercentage int `json:"completion-percentage"`
+
+	// Result is the result of the phase (e.g., success, failed).
+	Result string `json:"result"`
+
+	// Reason is the reason reported for a failed phase.
+	Reason string `json:"reason"`
+}
+
+// Strategy represents an orchestration strategy of the VIM.
+type Strategy struct {
+	// ID is the unique identifier of the strategy.
+	ID string `json:"uuid"`
+
+	// Name is the name of the strategy type.
+	Name string `json:"name"`
+
+	// State is the current state of the strategy (e.g., building,
+	// ready-to-apply, applying, applied).
+	State string `json:"state"`
+
+	// CurrentPhase is the name of the phase currently being executed.
+	CurrentPhase string `json:"current-phase"`
+
+	// BuildPhase is the progress of the build phase.
+	BuildPhase Phase `json:"build-phase"`
+
+	// ApplyPhase is the progress of the apply phase.
+	ApplyPhase Phase `json:"apply-phase"`
+
+	// AbortPhase is the progress of the abort phase.
+	AbortPhase Phase `json:"abort-phase"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Strategy
+// resource.  The VIM returns an empty strategy rather than an error when no
+// strategy of the requested type exists therefore a nil strategy is returned
+// in that case.
+func (r commonResult) Extract() (*Strategy, error) {
+	var s struct {
+		Strategy *Strategy `json:"strategy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Strategy, err
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// ShowResult represents the result of a show operation.
+type ShowResult struct {
+	commonResult
+}
+
+// ActionResult represents the result of a strategy action operation.
+type ActionResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
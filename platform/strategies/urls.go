@@ -0,0 +1,23 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package strategies
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	rootPath     = "api"
+	resourcePath = "orchestration"
+	strategyPath = "strategy"
+	actionsPath  = "actions"
+)
+
+func strategyURL(c *gophercloud.ServiceClient, kind string) string {
+	return c.ServiceURL(rootPath, resourcePath, kind, strategyPath)
+}
+
+func actionURL(c *gophercloud.ServiceClient, kind string) string {
+	return c.ServiceURL(rootPath, resourcePath, kind, strategyPath, actionsPath)
+}
@@ -0,0 +1,240 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package subclouds provides access to the subcloud operations of the
+// StarlingX distributed cloud manager (dcmanager) API.  It is used by a
+// system controller to add, manage, configure, and back up its subclouds.
+package subclouds
+
+import (
+	"bytes"
+	"encoding/base64"
+	"mime/multipart"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// formBuilder is a utility type which accumulates the fields and files of a
+// multipart form request body.  The dcmanager API expects files (e.g.,
+// bootstrap values) to be uploaded as form files rather than JSON.
+type formBuilder struct {
+	body   bytes.Buffer
+	writer *multipart.Writer
+	err    error
+}
+
+func newFormBuilder() *formBuilder {
+	f := &formBuilder{}
+	f.writer = multipart.NewWriter(&f.body)
+	return f
+}
+
+func (f *formBuilder) field(name string, value string) {
+	if f.err == nil && value != "" {
+		f.err = f.writer.WriteField(name, value)
+	}
+}
+
+func (f *formBuilder) password(name string, value string) {
+	if value != "" {
+		f.field(name, base64.StdEncoding.EncodeToString([]byte(value)))
+	}
+}
+
+func (f *formBuilder) file(name string, content string) {
+	if f.err != nil || content == "" {
+		return
+	}
+
+	w, err := f.writer.CreateFormFile(name, name+".yaml")
+	if err == nil {
+		_, err = w.Write([]byte(content))
+	}
+	f.err = err
+}
+
+// build completes the form and returns the request body and content type.
+func (f *formBuilder) build() (*bytes.Buffer, string, error) {
+	if f.err != nil {
+		return nil, "", f.err
+	}
+
+	if err := f.writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return &f.body, f.writer.FormDataContentType(), nil
+}
+
+// AddOpts defines the attributes of a new subcloud.  The bootstrap values,
+// install values, and deploy config are YAML documents.
+type AddOpts struct {
+	Name             string
+	BootstrapAddress string
+	BootstrapValues  string
+	InstallValues    string
+	DeployConfig     string
+	SysadminPassword string
+	BMCPassword      string
+	Group            string
+	Release          string
+	Description      string
+	Location         string
+}
+
+// ToForm converts the add attributes to a multipart form request body.
+func (opts AddOpts) ToForm() (*bytes.Buffer, string, error) {
+	f := newFormBuilder()
+	f.field("name", opts.Name)
+	f.field("bootstrap-address", opts.BootstrapAddress)
+	f.field("group_id", opts.Group)
+	f.field("release", opts.Release)
+	f.field("description", opts.Description)
+	f.field("location", opts.Location)
+	f.password("sysadmin_password", opts.SysadminPassword)
+	f.password("bmc_password", opts.BMCPassword)
+	f.file("bootstrap_values", opts.BootstrapValues)
+	f.file("install_values", opts.InstallValues)
+	f.file("deploy_config", opts.DeployConfig)
+	return f.build()
+}
+
+// UpdateOpts defines the attributes of a subcloud that can be updated.  Only
+// the attributes that are set are included in the update request.
+type UpdateOpts struct {
+	ManagementState *string
+	Description     *string
+	Location        *string
+}
+
+// ToForm converts the update attributes to a multipart form request body.
+func (opts UpdateOpts) ToForm() (*bytes.Buffer, string, error) {
+	f := newFormBuilder()
+	if opts.ManagementState != nil {
+		f.field("management-state", *opts.ManagementState)
+	}
+	if opts.Description != nil {
+		f.field("description", *opts.Description)
+	}
+	if opts.Location != nil {
+		f.field("location", *opts.Location)
+	}
+	return f.build()
+}
+
+// ConfigureOpts defines the attributes of a subcloud deploy configure
+// request.
+type ConfigureOpts struct {
+	DeployConfig     string
+	SysadminPassword string
+}
+
+// ToForm converts the configure attributes to a multipart form request body.
+func (opts ConfigureOpts) ToForm() (*bytes.Buffer, string, error) {
+	f := newFormBuilder()
+	f.password("sysadmin_password", opts.SysadminPassword)
+	f.file("deploy_config", opts.DeployConfig)
+	return f.build()
+}
+
+// BackupOpts defines the attributes of a subcloud backup request.
+type BackupOpts struct {
+	Subcloud         string
+	LocalOnly        bool
+	RegistryImages   bool
+	BackupValues     string
+	SysadminPassword string
+}
+
+// ToBody converts the backup attributes to the request body expected by the
+// dcmanager API.
+func (opts BackupOpts) ToBody() map[string]interface{} {
+	body := map[string]interface{}{
+		"subcloud":          opts.Subcloud,
+		"local_only":        strconv.FormatBool(opts.LocalOnly),
+		"registry_images":   strconv.FormatBool(opts.RegistryImages),
+		"sysadmin_password": base64.StdEncoding.EncodeToString([]byte(opts.SysadminPassword)),
+	}
+	if opts.BackupValues != "" {
+		body["backup_values"] = opts.BackupValues
+	}
+	return body
+}
+
+// formRequest is a utility function which sends a multipart form request.
+func formRequest(c *gophercloud.ServiceClient, method string, url string, body *bytes.Buffer, contentType string, result *gophercloud.Result) {
+	_, result.Err = c.Request(method, url, &gophercloud.RequestOpts{
+		RawBody:      body,
+		MoreHeaders:  map[string]string{"Content-Type": contentType},
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+}
+
+// Get retrieves a specific subcloud based on its name or unique ID.
+func Get(c *gophercloud.ServiceClient, name string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, name), &r.Body, nil)
+	return r
+}
+
+// List retrieves all subclouds of the system controller.
+func List(c *gophercloud.ServiceClient) (r ListResult) {
+	_, r.Err = c.Get(listURL(c), &r.Body, nil)
+	return r
+}
+
+// Add accepts an AddOpts struct and adds a new subcloud.  The subcloud is
+// installed, bootstrapped, and deployed asynchronously.
+func Add(c *gophercloud.ServiceClient, opts AddOpts) (r AddResult) {
+	body, contentType, err := opts.ToForm()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	formRequest(c, "POST", addURL(c), body, contentType, &r.Result)
+	return r
+}
+
+// Update accepts an UpdateOpts struct and updates an existing subcloud.
+func Update(c *gophercloud.ServiceClient, name string, opts UpdateOpts) (r UpdateResult) {
+	body, contentType, err := opts.ToForm()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	formRequest(c, "PATCH", updateURL(c, name), body, contentType, &r.Result)
+	return r
+}
+
+// Configure accepts a ConfigureOpts struct and re-runs the deploy configure
+// phase of an existing subcloud with a new deploy config.
+func Configure(c *gophercloud.ServiceClient, name string, opts ConfigureOpts) (r ConfigureResult) {
+	body, contentType, err := opts.ToForm()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	formRequest(c, "PATCH", configureURL(c, name), body, contentType, &r.Result)
+	return r
+}
+
+// Delete deletes an existing subcloud.  The subcloud must be unmanaged.
+func Delete(c *gophercloud.ServiceClient, name string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, name), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return r
+}
+
+// CreateBackup accepts a BackupOpts struct and requests a new backup of a
+// subcloud.  The backup is created asynchronously.
+func CreateBackup(c *gophercloud.ServiceClient, opts BackupOpts) (r BackupResult) {
+	_, r.Err = c.Post(backupURL(c), opts.ToBody(), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r
+}
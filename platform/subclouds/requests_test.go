@@ -0,0 +1,106 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package subclouds
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestGet(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"id": 3, "name": "subcloud1", "management-state": "unmanaged", "availability-status": "online",
+		  "deploy-status": "complete", "backup-status": "backing-up", "region-name": "2ec93dfb654846909efe61d1b39dd2ce"}`)
+	defer done()
+
+	result, err := Get(client, "subcloud1").Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodGet || recorded.URI != "/subclouds/subcloud1" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if result.ID != 3 || result.ManagementState != ManagementUnmanaged || result.RegionName == "" {
+		t.Errorf("unexpected subcloud: %+v", result)
+	}
+
+	if result.DeployInProgress() || result.DeployFailed() || !result.BackupInProgress() {
+		t.Errorf("unexpected subcloud state: %+v", result)
+	}
+}
+
+func TestDeployStatus(t *testing.T) {
+	for status, expected := range map[string][2]bool{
+		"bootstrapping":    {true, false},
+		"bootstrap-failed": {false, true},
+		"install-aborted":  {false, true},
+		"complete":         {false, false},
+		"not-deployed":     {false, false},
+	} {
+		s := Subcloud{DeployStatus: status}
+		if s.DeployInProgress() != expected[0] || s.DeployFailed() != expected[1] {
+			t.Errorf("unexpected classification of deploy status %s", status)
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"id": 3, "name": "subcloud1", "deploy-status": "pre-install"}`)
+	defer done()
+
+	opts := AddOpts{
+		Name:             "subcloud1",
+		BootstrapAddress: "10.10.10.12",
+		BootstrapValues:  "system_mode: simplex\n",
+		SysadminPassword: "secret",
+	}
+	result, err := Add(client, opts).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPost || recorded.URI != "/subclouds" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if recorded.Fields["bootstrap-address"] != "10.10.10.12" ||
+		recorded.Fields["sysadmin_password"] != base64.StdEncoding.EncodeToString([]byte("secret")) ||
+		recorded.Files["bootstrap_values"] != "system_mode: simplex\n" {
+		t.Errorf("unexpected request form: %v %v", recorded.Fields, recorded.Files)
+	}
+
+	if _, ok := recorded.Files["deploy_config"]; ok {
+		t.Errorf("unexpected deploy config: %v", recorded.Files)
+	}
+
+	if result.DeployStatus != "pre-install" {
+		t.Errorf("unexpected subcloud: %+v", result)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"id": 3, "name": "subcloud1", "management-state": "managed"}`)
+	defer done()
+
+	state := ManagementManaged
+	_, err := Update(client, "subcloud1", UpdateOpts{ManagementState: &state}).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPatch || recorded.URI != "/subclouds/subcloud1" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if len(recorded.Fields) != 1 || recorded.Fields["management-state"] != ManagementManaged {
+		t.Errorf("unexpected request form: %v", recorded.Fields)
+	}
+}
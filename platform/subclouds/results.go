@@ -0,0 +1,154 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package subclouds
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Defines the management states of a subcloud.
+const (
+	ManagementManaged   = "managed"
+	ManagementUnmanaged = "unmanaged"
+)
+
+// Defines the availability states of a subcloud.
+const (
+	AvailabilityOnline  = "online"
+	AvailabilityOffline = "offline"
+)
+
+// Defines the deploy states of a subcloud which are not transient.
+const (
+	DeployStatusComplete    = "complete"
+	DeployStatusNotDeployed = "not-deployed"
+	DeployStatusSecondary   = "secondary"
+)
+
+// Defines the backup states of a subcloud which are transient.
+const (
+	BackupStatusPreBackup = "pre-backup"
+	BackupStatusBackingUp = "backing-up"
+)
+
+// Subcloud represents a subcloud of a distributed cloud system controller.
+type Subcloud struct {
+	// ID is the unique identifier of the subcloud.
+	ID int `json:"id"`
+
+	// Name is the unique name of the subcloud.
+	Name string `json:"name"`
+
+	// Description is a free form description of the subcloud.
+	Description string `json:"description"`
+
+	// Location is a free form location of the subcloud.
+	Location string `json:"location"`
+
+	// SoftwareVersion is the software release running on the subcloud.
+	SoftwareVersion string `json:"software-version"`
+
+	// ManagementState is whether the subcloud is managed by the system
+	// controller.
+	ManagementState string `json:"management-state"`
+
+	// AvailabilityStatus is whether the subcloud is reachable by the system
+	// controller.
+	AvailabilityStatus string `json:"availability-status"`
+
+	// DeployStatus is the progress of the subcloud deployment.
+	DeployStatus string `json:"deploy-status"`
+
+	// BackupStatus is the progress of the last subcloud backup.
+	BackupStatus string `json:"backup-status"`
+
+	// BackupDatetime is the time of the last subcloud backup.
+	BackupDatetime string `json:"backup-datetime"`
+
+	// GroupID is the unique identifier of the subcloud group.
+	GroupID int `json:"group_id"`
+
+	// RegionName is the name of the keystone region of the subcloud.
+	RegionName string `json:"region-name"`
+}
+
+// DeployFailed determines whether the last deployment operation failed.
+func (in *Subcloud) DeployFailed() bool {
+	return strings.HasSuffix(in.DeployStatus, "-failed") ||
+		strings.HasSuffix(in.DeployStatus, "-aborted")
+}
+
+// DeployInProgress determines whether a deployment operation is still
+// running.
+func (in *Subcloud) DeployInProgress() bool {
+	switch in.DeployStatus {
+	case DeployStatusComplete, DeployStatusNotDeployed, DeployStatusSecondary:
+		return false
+	}
+	return !in.DeployFailed()
+}
+
+// BackupInProgress determines whether a backup operation is still running.
+func (in *Subcloud) BackupInProgress() bool {
+	return in.BackupStatus == BackupStatusPreBackup || in.BackupStatus == BackupStatusBackingUp
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Subcloud
+// resource.
+func (r commonResult) Extract() (*Subcloud, error) {
+	var s Subcloud
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// AddResult represents the result of an add operation.
+type AddResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// ConfigureResult represents the result of a deploy configure operation.
+type ConfigureResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// BackupResult represents the result of a backup create operation.
+type BackupResult struct {
+	gophercloud.ErrResult
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of
+// Subcloud resources.
+func (r ListResult) Extract() ([]Subcloud, error) {
+	var s struct {
+		Subclouds []Subcloud `json:"subclouds"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Subclouds, err
+}
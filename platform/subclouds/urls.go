@@ -0,0 +1,43 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package subclouds
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	resourcePath = "subclouds"
+	backupPath   = "subcloud-backup"
+	deployPath   = "phased-subcloud-deploy"
+	configPath   = "configure"
+)
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func getURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL(resourcePath, name)
+}
+
+func addURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func updateURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL(resourcePath, name)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL(resourcePath, name)
+}
+
+func configureURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL(deployPath, name, configPath)
+}
+
+func backupURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(backupPath)
+}
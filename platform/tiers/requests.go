@@ -0,0 +1,49 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+// Package tiers complements the storage tier operations of the inventory
+// client, which can only list the tiers of a cluster, with the ability to
+// create and delete the additional tiers of a Ceph cluster.  Tiers are read
+// with the inventory client's storagetiers package.
+package tiers
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// TypeCeph defines the only storage tier type supported by the system.
+const TypeCeph = "ceph"
+
+// StorageTierOpts defines the attributes required to create a new storage
+// tier.
+type StorageTierOpts struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	ClusterID string `json:"cluster_uuid"`
+}
+
+// Create accepts a StorageTierOpts struct and creates a new storage tier.
+func Create(c *gophercloud.ServiceClient, opts StorageTierOpts) (r CreateResult) {
+	if opts.Type == "" {
+		opts.Type = TypeCeph
+	}
+
+	reqBody, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	_, r.Err = c.Post(createURL(c), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+
+	return r
+}
+
+// Delete accepts a unique ID and deletes the storage tier associated with it.
+// The system refuses to delete a tier that still has OSDs bound to it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return r
+}
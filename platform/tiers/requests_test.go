@@ -0,0 +1,60 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package tiers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagetiers"
+	"github.com/wind-river/cloud-platform-deployment-manager/platform/internal/testclient"
+)
+
+func TestCreate(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusOK,
+		`{"uuid": "2", "name": "gold", "type": "ceph", "status": "defined", "cluster_uuid": "c1"}`)
+	defer done()
+
+	tier, err := Create(client, StorageTierOpts{Name: "gold", ClusterID: "c1"}).Extract()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodPost || recorded.URI != "/storage_tiers" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+
+	if recorded.Body["name"] != "gold" || recorded.Body["cluster_uuid"] != "c1" ||
+		recorded.Body["type"] != TypeCeph {
+		t.Errorf("unexpected request body: %v", recorded.Body)
+	}
+
+	if tier.ID != "2" || InUse(tier) {
+		t.Errorf("unexpected tier: %+v", tier)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	client, recorded, done := testclient.New(t, http.StatusNoContent, "")
+	defer done()
+
+	err := Delete(client, "2").ExtractErr()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if recorded.Method != http.MethodDelete || recorded.URI != "/storage_tiers/2" {
+		t.Errorf("unexpected request: %s %s", recorded.Method, recorded.URI)
+	}
+}
+
+func TestInUse(t *testing.T) {
+	if !InUse(&storagetiers.StorageTier{Status: StatusInUse}) {
+		t.Errorf("tier with OSDs should be in use")
+	}
+
+	if InUse(&storagetiers.StorageTier{Status: "defined"}) {
+		t.Errorf("tier without OSDs should not be in use")
+	}
+}
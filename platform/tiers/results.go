@@ -0,0 +1,37 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package tiers
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/storagetiers"
+)
+
+// StatusInUse defines the status reported by the system for a storage tier
+// which has OSDs bound to it.
+const StatusInUse = "in-use"
+
+// InUse returns whether a storage tier has OSDs bound to it and therefore
+// cannot be deleted.
+func InUse(t *storagetiers.StorageTier) bool {
+	return t.Status == StatusInUse
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a StorageTier
+// resource.
+func (r CreateResult) Extract() (*storagetiers.StorageTier, error) {
+	var s storagetiers.StorageTier
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,18 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package tiers
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+const resourcePath = "storage_tiers"
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}